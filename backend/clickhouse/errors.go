@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -674,6 +675,17 @@ func ErrorMatchesQuery(errorObject *model2.BackendErrorObjectInput, filters list
 	return matchesQuery(errorObject, BackendErrorObjectInputConfig, filters, listener.OperatorAnd)
 }
 
+// CompileErrorQuery compiles a search query into a predicate on errors.
+func CompileErrorQuery(query string) Predicate[model2.BackendErrorObjectInput] {
+	return compileQuery[model2.BackendErrorObjectInput](BackendErrorObjectInputConfig, parser.Parse(query, BackendErrorObjectInputConfig))
+}
+
+// ErrorValue returns the value of a search key for an error, such as service_name or environment.
+func ErrorValue(errorObject *model2.BackendErrorObjectInput, key string) string {
+	value, _ := getRowValue(reflect.ValueOf(*errorObject), BackendErrorObjectInputConfig, key)
+	return value
+}
+
 func (client *Client) ReadErrorsMetrics(ctx context.Context, projectID int, params modelInputs.QueryInput, column string, metricTypes []modelInputs.MetricAggregator, groupBy []string, nBuckets *int, bucketBy string, bucketWindow *int, limit *int, limitAggregator *modelInputs.MetricAggregator, limitColumn *string) (*modelInputs.MetricsBuckets, error) {
 	return client.ReadMetrics(ctx, ReadMetricsInput{
 		SampleableConfig: ErrorsSampleableTableConfig,
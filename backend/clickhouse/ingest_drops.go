@@ -0,0 +1,198 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/util"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/openlyinc/pointy"
+	e "github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+)
+
+const IngestDropsTable = "ingest_drops"
+
+const (
+	IngestDropKeyProduct = "product"
+	IngestDropKeyReason  = "reason"
+)
+
+var ingestDropKeysToColumns = map[string]string{
+	IngestDropKeyProduct:                            "Product",
+	IngestDropKeyReason:                             "Reason",
+	string(modelInputs.ReservedTraceKeyServiceName): "ServiceName",
+	string(modelInputs.ReservedTraceKeyTimestamp):   "Timestamp",
+}
+
+// each row counts the items of a product dropped for a reason, so counts sum the rows rather than counting them
+var ingestDropsTableConfig = model.TableConfig{
+	TableName:          IngestDropsTable,
+	BodyColumn:         "Product",
+	SampleFactorColumn: pointy.String("Count"),
+	KeysToColumns:      ingestDropKeysToColumns,
+	ReservedKeys:       lo.Keys(ingestDropKeysToColumns),
+}
+
+// IngestDropsSampleableTableConfig does not sample since drops are already aggregated per minute
+var IngestDropsSampleableTableConfig = SampleableTableConfig{
+	tableConfig:         ingestDropsTableConfig,
+	samplingTableConfig: ingestDropsTableConfig,
+	useSampling: func(d time.Duration) bool {
+		return false
+	},
+}
+
+// IngestDropRow is the number of items of a product dropped at ingest for a reason.
+type IngestDropRow struct {
+	ProjectId   uint32
+	Timestamp   time.Time
+	Product     string
+	Reason      string
+	ServiceName string
+	Count       uint64
+}
+
+func (client *Client) BatchWriteIngestDropRows(ctx context.Context, rows []*IngestDropRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	span, _ := util.StartSpanFromContext(ctx, "clickhouse.BatchWriteIngestDropRows")
+	span.SetAttribute("BatchSize", len(rows))
+	defer span.Finish()
+
+	batch, err := client.conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s", IngestDropsTable))
+	if err != nil {
+		return e.Wrap(err, "failed to create ingest drops batch")
+	}
+
+	for _, row := range rows {
+		if err := batch.AppendStruct(row); err != nil {
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (client *Client) ReadIngestDropsMetrics(ctx context.Context, projectID int, params modelInputs.QueryInput, column string, metricTypes []modelInputs.MetricAggregator, groupBy []string, nBuckets *int, bucketBy string, bucketWindow *int, limit *int, limitAggregator *modelInputs.MetricAggregator, limitColumn *string) (*modelInputs.MetricsBuckets, error) {
+	return client.ReadMetrics(ctx, ReadMetricsInput{
+		SampleableConfig: IngestDropsSampleableTableConfig,
+		ProjectIDs:       []int{projectID},
+		Params:           params,
+		Column:           column,
+		MetricTypes:      metricTypes,
+		GroupBy:          groupBy,
+		BucketCount:      nBuckets,
+		BucketWindow:     bucketWindow,
+		BucketBy:         bucketBy,
+		Limit:            limit,
+		LimitAggregator:  limitAggregator,
+		LimitColumn:      limitColumn,
+	})
+}
+
+// ReadWorkspaceIngestDrops returns the number of items of a product dropped by the projects of a workspace, by reason.
+func (client *Client) ReadWorkspaceIngestDrops(ctx context.Context, projectIDs []int, product modelInputs.ProductType, startDate time.Time, endDate time.Time) ([]*modelInputs.IngestDrop, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sql, args := sb.
+		Select("Reason, sum(Count)").
+		From(IngestDropsTable).
+		Where(sb.In("ProjectId", projectIDs)).
+		Where(sb.Equal("Product", product.String())).
+		Where(sb.Between("Timestamp", startDate, endDate)).
+		GroupBy("Reason").
+		OrderBy("Reason").
+		BuildWithFlavor(sqlbuilder.ClickHouse)
+
+	rows, err := client.conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	drops := []*modelInputs.IngestDrop{}
+	for rows.Next() {
+		var reason string
+		var count uint64
+		if err := rows.Scan(&reason, &count); err != nil {
+			return nil, err
+		}
+		drops = append(drops, &modelInputs.IngestDrop{
+			Reason: modelInputs.IngestReason(reason),
+			Count:  count,
+		})
+	}
+
+	return drops, rows.Err()
+}
+
+func (client *Client) IngestDropsKeys(ctx context.Context, projectID int, startDate time.Time, endDate time.Time, query *string, typeArg *modelInputs.KeyType) ([]*modelInputs.QueryKey, error) {
+	if typeArg != nil && *typeArg != modelInputs.KeyTypeString {
+		return []*modelInputs.QueryKey{}, nil
+	}
+
+	keys := []*modelInputs.QueryKey{}
+	for _, key := range []string{IngestDropKeyProduct, IngestDropKeyReason, string(modelInputs.ReservedTraceKeyServiceName)} {
+		if query != nil && !strings.Contains(key, strings.ToLower(*query)) {
+			continue
+		}
+		keys = append(keys, &modelInputs.QueryKey{Name: key, Type: modelInputs.KeyTypeString})
+	}
+	return keys, nil
+}
+
+func (client *Client) IngestDropsKeyValues(ctx context.Context, projectID int, keyName string, startDate time.Time, endDate time.Time, query *string, limit *int) ([]string, error) {
+	column, ok := ingestDropKeysToColumns[keyName]
+	if !ok {
+		return nil, fmt.Errorf("unknown column %s", keyName)
+	}
+
+	limitCount := 10
+	if limit != nil {
+		limitCount = *limit
+	}
+
+	searchQuery := ""
+	if query != nil {
+		searchQuery = *query
+	}
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sql, args := sb.
+		Select(fmt.Sprintf("toString(%s)", column)).
+		From(IngestDropsTable).
+		Where(sb.Equal("ProjectId", projectID)).
+		Where(sb.Between("Timestamp", startDate, endDate)).
+		Where(fmt.Sprintf("toString(%s) ILIKE %s", column, sb.Var("%"+searchQuery+"%"))).
+		Where(fmt.Sprintf("toString(%s) <> ''", column)).
+		GroupBy("1").
+		OrderBy("sum(Count) DESC").
+		Limit(limitCount).
+		BuildWithFlavor(sqlbuilder.ClickHouse)
+
+	rows, err := client.conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
+
+func (client *Client) IngestDropsLogLines(ctx context.Context, projectID int, params modelInputs.QueryInput) ([]*modelInputs.LogLine, error) {
+	return logLines(ctx, client, ingestDropsTableConfig, projectID, params)
+}
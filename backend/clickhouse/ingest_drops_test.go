@@ -0,0 +1,50 @@
+package clickhouse
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/openlyinc/pointy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadIngestDrops(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	rows := []*IngestDropRow{
+		{ProjectId: 1, Timestamp: now, Product: modelInputs.ProductTypeLogs.String(), Reason: modelInputs.IngestReasonFilter.String(), ServiceName: "api", Count: 10},
+		{ProjectId: 1, Timestamp: now, Product: modelInputs.ProductTypeLogs.String(), Reason: modelInputs.IngestReasonFilter.String(), ServiceName: "worker", Count: 5},
+		{ProjectId: 1, Timestamp: now, Product: modelInputs.ProductTypeLogs.String(), Reason: modelInputs.IngestReasonQuota.String(), ServiceName: "api", Count: 3},
+		{ProjectId: 1, Timestamp: now, Product: modelInputs.ProductTypeTraces.String(), Reason: modelInputs.IngestReasonSample.String(), ServiceName: "api", Count: 7},
+		{ProjectId: 2, Timestamp: now, Product: modelInputs.ProductTypeLogs.String(), Reason: modelInputs.IngestReasonRate.String(), ServiceName: "api", Count: 100},
+	}
+	assert.NoError(t, client.BatchWriteIngestDropRows(ctx, rows))
+
+	drops, err := client.ReadWorkspaceIngestDrops(ctx, []int{1}, modelInputs.ProductTypeLogs, now.Add(-time.Hour), now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, []*modelInputs.IngestDrop{
+		{Reason: modelInputs.IngestReasonFilter, Count: 15},
+		{Reason: modelInputs.IngestReasonQuota, Count: 3},
+	}, drops)
+
+	// counts sum the dropped items of each row
+	metrics, err := client.ReadIngestDropsMetrics(ctx, 1, modelInputs.QueryInput{
+		Query:     "product=Logs",
+		DateRange: makeDateWithinRange(now),
+	}, "", []modelInputs.MetricAggregator{modelInputs.MetricAggregatorCount}, []string{"service_name"}, pointy.Int(1), modelInputs.MetricBucketByNone.String(), nil, nil, nil, nil)
+	assert.NoError(t, err)
+	counts := map[string]float64{}
+	for _, bucket := range metrics.Buckets {
+		counts[bucket.Group[0]] = *bucket.MetricValue
+	}
+	assert.Equal(t, map[string]float64{"api": 13, "worker": 5}, counts)
+
+	values, err := client.IngestDropsKeyValues(ctx, 1, "reason", now.Add(-time.Hour), now.Add(time.Hour), nil, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Filter", "Quota", "Sample"}, values)
+}
@@ -30,6 +30,9 @@ type LogRow struct {
 	Body            string
 	LogAttributes   map[string]string
 	Environment     string
+	ResourceFields
+	// SampleFactor is the number of logs the row stands for, the inverse of the rate it was sampled at
+	SampleFactor float64
 }
 
 func NewLogRow(timestamp time.Time, projectID uint32, opts ...LogRowOption) *LogRow {
@@ -47,6 +50,7 @@ func NewLogRow(timestamp time.Time, projectID uint32, opts ...LogRowOption) *Log
 		UUID:           uuid.New().String(),
 		SeverityText:   makeLogLevel("INFO").String(),
 		SeverityNumber: int32(log.InfoLevel),
+		SampleFactor:   1,
 	}
 
 	for _, opt := range opts {
@@ -60,6 +64,25 @@ func (l *LogRow) Cursor() string {
 	return encodeCursor(l.Timestamp, l.UUID)
 }
 
+// GetLog returns the graph model of a log that has not been written yet.
+func GetLog(logRow *LogRow) *modelInputs.Log {
+	source := string(logRow.Source)
+	return &modelInputs.Log{
+		Timestamp:       logRow.Timestamp,
+		Level:           makeLogLevel(logRow.SeverityText),
+		Message:         logRow.Body,
+		LogAttributes:   expandJSON(withResourceAttributes(logRow.LogAttributes, logRow.ResourceFields)),
+		TraceID:         &logRow.TraceId,
+		SpanID:          &logRow.SpanId,
+		SecureSessionID: &logRow.SecureSessionId,
+		Source:          &source,
+		ServiceName:     &logRow.ServiceName,
+		ServiceVersion:  &logRow.ServiceVersion,
+		Environment:     &logRow.Environment,
+		ProjectID:       int(logRow.ProjectId),
+	}
+}
+
 type LogRowOption func(*LogRow)
 
 func WithTraceID(traceID string) LogRowOption {
@@ -131,6 +154,12 @@ func WithEnvironment(environment string) LogRowOption {
 	}
 }
 
+func WithResourceFields(resourceFields ResourceFields) LogRowOption {
+	return func(l *LogRow) {
+		l.ResourceFields = resourceFields
+	}
+}
+
 func makeLogLevel(severityText string) modelInputs.LogLevel {
 	switch strings.ToLower(severityText) {
 	case "console.error":
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"reflect"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/parser"
 	"github.com/highlight-run/highlight/backend/parser/listener"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/util"
@@ -27,7 +29,7 @@ const LogsSamplingTable = "logs_sampling"
 const LogKeysTable = "log_keys"
 const LogKeyValuesTable = "log_key_values"
 
-var logKeysToColumns = map[string]string{
+var logKeysToColumns = withResourceFieldKeys(map[string]string{
 	string(modelInputs.ReservedLogKeyLevel):           "SeverityText",
 	string(modelInputs.ReservedLogKeySecureSessionID): "SecureSessionId",
 	string(modelInputs.ReservedLogKeySpanID):          "SpanId",
@@ -38,7 +40,7 @@ var logKeysToColumns = map[string]string{
 	string(modelInputs.ReservedLogKeyEnvironment):     "Environment",
 	string(modelInputs.ReservedLogKeyMessage):         "Body",
 	string(modelInputs.ReservedLogKeyTimestamp):       "Timestamp",
-}
+})
 
 // These keys show up as recommendations, but with no recommended values due to high cardinality
 var defaultLogKeys = []*modelInputs.QueryKey{
@@ -48,18 +50,19 @@ var defaultLogKeys = []*modelInputs.QueryKey{
 	{Name: string(modelInputs.ReservedLogKeyMessage), Type: modelInputs.KeyTypeString},
 }
 
-var reservedLogKeys = lo.Map(modelInputs.AllReservedLogKey, func(key modelInputs.ReservedLogKey, _ int) string {
+var reservedLogKeys = append(lo.Map(modelInputs.AllReservedLogKey, func(key modelInputs.ReservedLogKey, _ int) string {
 	return string(key)
-})
+}), resourceFieldReservedKeys...)
 
 var LogsTableConfig = model.TableConfig{
-	TableName:        LogsTable,
-	KeysToColumns:    logKeysToColumns,
-	ReservedKeys:     reservedLogKeys,
-	BodyColumn:       "Body",
-	SeverityColumn:   "SeverityText",
-	AttributesColumn: "LogAttributes",
-	SelectColumns: []string{
+	TableName:          LogsTable,
+	KeysToColumns:      logKeysToColumns,
+	ReservedKeys:       reservedLogKeys,
+	BodyColumn:         "Body",
+	SeverityColumn:     "SeverityText",
+	AttributesColumn:   "LogAttributes",
+	SampleFactorColumn: pointy.String("SampleFactor"),
+	SelectColumns: append([]string{
 		"ProjectId",
 		"Timestamp",
 		"UUID",
@@ -73,15 +76,16 @@ var LogsTableConfig = model.TableConfig{
 		"ServiceName",
 		"ServiceVersion",
 		"Environment",
-	},
+	}, resourceFieldColumns...),
 }
 
 var logsSamplingTableConfig = model.TableConfig{
-	TableName:        fmt.Sprintf("%s SAMPLE %d", LogsSamplingTable, SamplingRows),
-	KeysToColumns:    logKeysToColumns,
-	ReservedKeys:     reservedLogKeys,
-	BodyColumn:       "Body",
-	AttributesColumn: "LogAttributes",
+	TableName:          fmt.Sprintf("%s SAMPLE %d", LogsSamplingTable, SamplingRows),
+	KeysToColumns:      logKeysToColumns,
+	ReservedKeys:       reservedLogKeys,
+	BodyColumn:         "Body",
+	AttributesColumn:   "LogAttributes",
+	SampleFactorColumn: pointy.String("SampleFactor"),
 }
 
 var LogsSampleableTableConfig = SampleableTableConfig{
@@ -101,6 +105,10 @@ func (client *Client) BatchWriteLogRows(ctx context.Context, logRows []*LogRow)
 		if len(l.UUID) == 0 {
 			l.UUID = uuid.New().String()
 		}
+		// logs queued before the sample factor was recorded were not sampled
+		if l.SampleFactor == 0 {
+			l.SampleFactor = 1
+		}
 		return l
 	})
 
@@ -153,6 +161,7 @@ func (client *Client) ReadLogs(ctx context.Context, projectID int, params modelI
 			ServiceVersion  string
 			Environment     string
 			ProjectId       uint32
+			ResourceFields
 		}
 		if err := rows.ScanStruct(&result); err != nil {
 			return nil, err
@@ -164,7 +173,7 @@ func (client *Client) ReadLogs(ctx context.Context, projectID int, params modelI
 				Timestamp:       result.Timestamp,
 				Level:           makeLogLevel(result.SeverityText),
 				Message:         result.Body,
-				LogAttributes:   expandJSON(result.LogAttributes),
+				LogAttributes:   expandJSON(withResourceAttributes(result.LogAttributes, result.ResourceFields)),
 				TraceID:         &result.TraceId,
 				SpanID:          &result.SpanId,
 				SecureSessionID: &result.SecureSessionId,
@@ -239,6 +248,7 @@ func (client *Client) ReadSessionLogs(ctx context.Context, projectID int, params
 			ServiceVersion  string
 			Environment     string
 			ProjectId       uint32
+			ResourceFields
 		}
 		if err := rows.ScanStruct(&result); err != nil {
 			return nil, err
@@ -250,7 +260,7 @@ func (client *Client) ReadSessionLogs(ctx context.Context, projectID int, params
 				Timestamp:       result.Timestamp,
 				Level:           makeLogLevel(result.SeverityText),
 				Message:         result.Body,
-				LogAttributes:   expandJSON(result.LogAttributes),
+				LogAttributes:   expandJSON(withResourceAttributes(result.LogAttributes, result.ResourceFields)),
 				TraceID:         &result.TraceId,
 				SpanID:          &result.SpanId,
 				SecureSessionID: &result.SecureSessionId,
@@ -356,7 +366,7 @@ func (client *Client) ReadLogsHistogram(ctx context.Context, projectID int, para
 	if params.DateRange.EndDate.Sub(params.DateRange.StartDate) >= 24*time.Hour {
 		fromSb, _, err = makeSelectBuilder(
 			logsSamplingTableConfig,
-			[]string{bucketIdxExpr, "toUInt64(round(count() * any(_sample_factor)))", "any(_sample_factor)"},
+			[]string{bucketIdxExpr, "toUInt64(round(sum(SampleFactor) * any(_sample_factor)))", "any(_sample_factor)"},
 			[]int{projectID},
 			params,
 			Pagination{CountOnly: true},
@@ -364,7 +374,7 @@ func (client *Client) ReadLogsHistogram(ctx context.Context, projectID int, para
 	} else {
 		fromSb, _, err = makeSelectBuilder(
 			LogsTableConfig,
-			[]string{bucketIdxExpr, "count()", "1.0"},
+			[]string{bucketIdxExpr, "toUInt64(round(sum(SampleFactor)))", "1.0"},
 			[]int{projectID},
 			params,
 			Pagination{CountOnly: true},
@@ -511,6 +521,17 @@ func LogMatchesQuery(logRow *LogRow, filters listener.Filters) bool {
 	return matchesQuery(logRow, LogsTableConfig, filters, listener.OperatorAnd)
 }
 
+// CompileLogQuery compiles a search query into a predicate on logs.
+func CompileLogQuery(query string) Predicate[LogRow] {
+	return compileQuery[LogRow](LogsTableConfig, parser.Parse(query, LogsTableConfig))
+}
+
+// LogValue returns the value of a search key for a log, such as service_name or a log attribute.
+func LogValue(logRow *LogRow, key string) string {
+	value, _ := getRowValue(reflect.ValueOf(*logRow), LogsTableConfig, key)
+	return value
+}
+
 func (client *Client) LogsLogLines(ctx context.Context, projectID int, params modelInputs.QueryInput) ([]*modelInputs.LogLine, error) {
 	return logLines(ctx, client, LogsTableConfig, projectID, params)
 }
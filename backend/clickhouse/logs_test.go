@@ -46,6 +46,12 @@ func setupTest(tb testing.TB) (*Client, func(tb testing.TB)) {
 
 		err = client.conn.Exec(context.Background(), fmt.Sprintf("TRUNCATE TABLE %s", TracesSamplingTable))
 		assert.NoError(tb, err)
+
+		err = client.conn.Exec(context.Background(), fmt.Sprintf("TRUNCATE TABLE %s", MetricsTable))
+		assert.NoError(tb, err)
+
+		err = client.conn.Exec(context.Background(), fmt.Sprintf("TRUNCATE TABLE %s", IngestDropsTable))
+		assert.NoError(tb, err)
 	}
 }
 
@@ -1037,6 +1043,36 @@ func TestReadLogsWithEnvironmentFilter(t *testing.T) {
 	assert.Len(t, payload.Edges, 2)
 }
 
+func TestReadLogsWithResourceFieldFilter(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now()
+	rows := []*LogRow{
+		NewLogRow(now, 1),
+		NewLogRow(now, 1, WithResourceFields(ResourceFields{K8sNamespace: "payments", K8sPod: "payments-api-1"})),
+		NewLogRow(now, 1, WithResourceFields(ResourceFields{K8sNamespace: "checkout", K8sPod: "checkout-api-1"})),
+	}
+
+	assert.NoError(t, client.BatchWriteLogRows(ctx, rows))
+
+	payload, err := client.ReadLogs(ctx, 1, modelInputs.QueryInput{
+		DateRange: makeDateWithinRange(now),
+		Query:     "k8s.namespace=payments",
+	}, Pagination{})
+	assert.NoError(t, err)
+	assert.Len(t, payload.Edges, 1)
+	assert.Equal(t, "payments-api-1", payload.Edges[0].Node.LogAttributes["k8s"].(map[string]interface{})["pod"].(map[string]interface{})["name"])
+
+	payload, err = client.ReadLogs(ctx, 1, modelInputs.QueryInput{
+		DateRange: makeDateWithinRange(now),
+		Query:     "k8s.pod.name:*-api-1",
+	}, Pagination{})
+	assert.NoError(t, err)
+	assert.Len(t, payload.Edges, 2)
+}
+
 func TestReadLogsWithMultipleFilters(t *testing.T) {
 	ctx := context.Background()
 	client, teardown := setupTest(t)
@@ -1333,6 +1369,55 @@ func Test_LogMatchesQuery(t *testing.T) {
 	assert.False(t, matches)
 }
 
+func Test_CompileLogQuery(t *testing.T) {
+	logRows := []LogRow{
+		{},
+		{Body: "hello world", ServiceName: "all", SeverityText: "info", LogAttributes: map[string]string{"os.type": "linux", "resource_name": "worker.kafka.process"}},
+		{Body: "hello world", ServiceName: "all", SeverityText: "debug", LogAttributes: map[string]string{"os.type": "linux", "resource_name": "public-graph"}},
+		{Body: "goodbye", ServiceName: "api", SeverityText: "info", LogAttributes: map[string]string{"os.type": "windows", "resource_name": "worker.kafka.process"}},
+	}
+	for _, query := range []string{
+		"hello os.type:linux resource_name:worker.* service_name:all",
+		"service_name:all OR service_name:api",
+		"-level:debug",
+		`resource_name=/worker\..*/ os.type!=windows`,
+	} {
+		matches := CompileLogQuery(query)
+		filters := parser.Parse(query, LogsTableConfig)
+		for _, logRow := range logRows {
+			assert.Equal(t, LogMatchesQuery(&logRow, filters), matches(&logRow), "%s: %+v", query, logRow)
+		}
+	}
+}
+
+func Test_LogValue(t *testing.T) {
+	logRow := LogRow{
+		ServiceName:   "worker",
+		LogAttributes: map[string]string{"os.type": "linux"},
+	}
+	assert.Equal(t, "worker", LogValue(&logRow, "service_name"))
+	assert.Equal(t, "linux", LogValue(&logRow, "os.type"))
+}
+
+func Test_LogMatchesQuery_ResourceFields(t *testing.T) {
+	logRow := LogRow{
+		ResourceFields: ResourceFields{K8sNamespace: "payments", ContainerId: "b202eacdb71a"},
+		LogAttributes:  map[string]string{"os.type": "linux"},
+	}
+	for query, expected := range map[string]bool{
+		"k8s.namespace=payments":           true,
+		"k8s.namespace.name=payments":      true,
+		"k8s.namespace=checkout":           false,
+		"container.id:b202* os.type:linux": true,
+		"-k8s.namespace:payments":          false,
+		"k8s.deployment=payments":          false,
+	} {
+		assert.Equal(t, expected, CompileLogQuery(query)(&logRow), query)
+		assert.Equal(t, expected, LogMatchesQuery(&logRow, parser.Parse(query, LogsTableConfig)), query)
+	}
+	assert.Equal(t, "payments", LogValue(&logRow, "k8s.namespace"))
+}
+
 func Test_LogMatchesQuery_Body(t *testing.T) {
 	for _, body := range []string{
 		"hello world a test",
@@ -2,6 +2,8 @@ package clickhouse
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
@@ -69,8 +71,10 @@ func (client *Client) AggregateMetricStates(ctx context.Context, metricId string
 		selectCols = append(selectCols, "avgMerge(AvgState) as Value")
 	case modelInputs.MetricAggregatorMax:
 		selectCols = append(selectCols, "maxMerge(MaxState) as Value")
-	case modelInputs.MetricAggregatorSum:
+	case modelInputs.MetricAggregatorSum, modelInputs.MetricAggregatorIncrease:
 		selectCols = append(selectCols, "sumMerge(SumState) as Value")
+	case modelInputs.MetricAggregatorRate:
+		selectCols = append(selectCols, fmt.Sprintf("sumMerge(SumState) / %f as Value", math.Max(interval.Seconds(), 1)))
 	case modelInputs.MetricAggregatorP50:
 		selectCols = append(selectCols, "quantileMerge(.5)(P50State) as Value")
 	case modelInputs.MetricAggregatorP90:
@@ -0,0 +1,150 @@
+package clickhouse
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MetricTypeGauge                = "Gauge"
+	MetricTypeSum                  = "Sum"
+	MetricTypeHistogram            = "Histogram"
+	MetricTypeExponentialHistogram = "ExponentialHistogram"
+	MetricTypeSummary              = "Summary"
+)
+
+const (
+	MetricTemporalityUnspecified = "Unspecified"
+	MetricTemporalityDelta       = "Delta"
+	MetricTemporalityCumulative  = "Cumulative"
+)
+
+type MetricRow struct {
+	Timestamp       time.Time
+	UUID            string
+	ProjectId       uint32
+	ServiceName     string
+	ServiceVersion  string
+	Environment     string
+	MetricName      string
+	MetricType      string
+	Temporality     string
+	IsMonotonic     bool
+	Unit            string
+	Attributes      map[string]string
+	StartTimestamp  time.Time
+	Value           float64
+	Count           uint64
+	Sum             float64
+	Min             float64
+	Max             float64
+	BucketCounts    []uint64
+	ExplicitBounds  []float64
+	SecureSessionId string
+	TraceId         string
+	SpanId          string
+}
+
+func NewMetricRow(timestamp time.Time, projectID int) *MetricRow {
+	return &MetricRow{
+		Timestamp:      timestamp,
+		UUID:           uuid.New().String(),
+		ProjectId:      uint32(projectID),
+		MetricType:     MetricTypeGauge,
+		Temporality:    MetricTemporalityUnspecified,
+		Attributes:     map[string]string{},
+		BucketCounts:   []uint64{},
+		ExplicitBounds: []float64{},
+	}
+}
+
+func (m *MetricRow) WithServiceName(serviceName string) *MetricRow {
+	m.ServiceName = serviceName
+	return m
+}
+
+func (m *MetricRow) WithServiceVersion(serviceVersion string) *MetricRow {
+	m.ServiceVersion = serviceVersion
+	return m
+}
+
+func (m *MetricRow) WithEnvironment(environment string) *MetricRow {
+	m.Environment = environment
+	return m
+}
+
+func (m *MetricRow) WithMetricName(metricName string) *MetricRow {
+	m.MetricName = truncateValue(metricName)
+	return m
+}
+
+func (m *MetricRow) WithMetricType(metricType string) *MetricRow {
+	m.MetricType = metricType
+	return m
+}
+
+func (m *MetricRow) WithTemporality(temporality string, isMonotonic bool) *MetricRow {
+	m.Temporality = temporality
+	m.IsMonotonic = isMonotonic
+	return m
+}
+
+func (m *MetricRow) WithUnit(unit string) *MetricRow {
+	m.Unit = unit
+	return m
+}
+
+func (m *MetricRow) WithAttributes(attributes map[string]string) *MetricRow {
+	m.Attributes = make(map[string]string, len(attributes))
+	for k, v := range attributes {
+		m.Attributes[k] = truncateValue(v)
+	}
+	return m
+}
+
+func (m *MetricRow) WithStartTimestamp(startTimestamp time.Time) *MetricRow {
+	m.StartTimestamp = startTimestamp
+	return m
+}
+
+func (m *MetricRow) WithValue(value float64) *MetricRow {
+	m.Value = value
+	return m
+}
+
+// WithAggregate records a pre-aggregated data point (histogram or summary).
+// The value of the row is set to the mean of the observations.
+func (m *MetricRow) WithAggregate(count uint64, sum, min, max float64) *MetricRow {
+	m.Count = count
+	m.Sum = sum
+	m.Min = min
+	m.Max = max
+	if count > 0 {
+		m.Value = sum / float64(count)
+	}
+	return m
+}
+
+// WithBuckets records histogram buckets using the otel explicit bounds convention,
+// where len(bucketCounts) == len(explicitBounds) + 1.
+func (m *MetricRow) WithBuckets(bucketCounts []uint64, explicitBounds []float64) *MetricRow {
+	m.BucketCounts = bucketCounts
+	m.ExplicitBounds = explicitBounds
+	return m
+}
+
+func (m *MetricRow) WithSecureSessionId(sessionId string) *MetricRow {
+	m.SecureSessionId = sessionId
+	return m
+}
+
+func (m *MetricRow) WithTraceId(traceId string) *MetricRow {
+	m.TraceId = traceId
+	return m
+}
+
+func (m *MetricRow) WithSpanId(spanId string) *MetricRow {
+	m.SpanId = spanId
+	return m
+}
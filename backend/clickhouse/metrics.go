@@ -7,41 +7,127 @@ import (
 
 	"github.com/aws/smithy-go/ptr"
 	"github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/util"
 	"github.com/openlyinc/pointy"
+	e "github.com/pkg/errors"
+	"github.com/samber/lo"
 
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 )
 
+const MetricsTable = "metrics"
 const MetricNamesTable = "trace_metrics"
+const MetricKeysTable = "metric_keys"
+const MetricKeyValuesTable = "metric_key_values"
 
-var metricsTableConfig = model.TableConfig{
-	AttributesColumn: TracesTableNoDefaultConfig.AttributesColumn,
-	BodyColumn:       TracesTableNoDefaultConfig.BodyColumn,
-	MetricColumn:     ptr.String("MetricValue"),
-	KeysToColumns:    TracesTableNoDefaultConfig.KeysToColumns,
-	ReservedKeys:     TracesTableNoDefaultConfig.ReservedKeys,
-	SelectColumns:    TracesTableNoDefaultConfig.SelectColumns,
-	TableName:        TracesTableNoDefaultConfig.TableName,
+var metricKeysToColumns = map[string]string{
+	string(modelInputs.ReservedTraceKeyMetricName):      "MetricName",
+	string(modelInputs.ReservedTraceKeyMetricValue):     "Value",
+	string(modelInputs.ReservedTraceKeyServiceName):     "ServiceName",
+	string(modelInputs.ReservedTraceKeyServiceVersion):  "ServiceVersion",
+	string(modelInputs.ReservedTraceKeyEnvironment):     "Environment",
+	string(modelInputs.ReservedTraceKeySecureSessionID): "SecureSessionId",
+	string(modelInputs.ReservedTraceKeyTraceID):         "TraceId",
+	string(modelInputs.ReservedTraceKeySpanID):          "SpanId",
+	string(modelInputs.ReservedTraceKeyTimestamp):       "Timestamp",
+	"metric_type": "MetricType",
+	"temporality": "Temporality",
 }
 
-var metricsSamplingTableConfig = model.TableConfig{
-	AttributesColumn: metricsTableConfig.AttributesColumn,
-	BodyColumn:       metricsTableConfig.BodyColumn,
-	MetricColumn:     metricsTableConfig.MetricColumn,
-	KeysToColumns:    metricsTableConfig.KeysToColumns,
-	ReservedKeys:     metricsTableConfig.ReservedKeys,
-	SelectColumns:    metricsTableConfig.SelectColumns,
-	TableName:        fmt.Sprintf("%s SAMPLE %d", TracesSamplingTable, SamplingRows),
+var metricColumns = []string{
+	"Timestamp",
+	"UUID",
+	"ProjectId",
+	"ServiceName",
+	"ServiceVersion",
+	"Environment",
+	"MetricName",
+	"MetricType",
+	"Temporality",
+	"Attributes",
+	"Value",
+	"Count",
+	"Sum",
+	"BucketCounts",
+	"ExplicitBounds",
+	"SecureSessionId",
+	"TraceId",
+	"SpanId",
 }
 
+// metricSeriesWindow orders the data points of each series of a metric.
+const metricSeriesWindow = "OVER (PARTITION BY ProjectId, MetricName, ServiceName, cityHash64(Attributes) ORDER BY Timestamp ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)"
+
+// metricDeltaExpr is the increase of a metric since the previous data point of the same series.
+// Cumulative counters are reset when the value decreases, in which case the new value is the increase.
+var metricDeltaExpr = fmt.Sprintf(
+	"if(Temporality = '%[1]s', if(Value < %[2]s, Value, Value - %[2]s), Value)",
+	MetricTemporalityCumulative,
+	"lagInFrame(Value, 1, Value) "+metricSeriesWindow,
+)
+
+// metricHistogramBucketsExpr is the value of each histogram bucket of a data point.
+// A bucket is represented by its midpoint, the outermost buckets by their finite bound.
+// Non-histogram data points are represented by their value.
+const metricHistogramBucketsExpr = `if(empty(BucketCounts), [Value], arrayMap(i -> if(i = 1, ExplicitBounds[1], if(i > length(ExplicitBounds), ExplicitBounds[length(ExplicitBounds)], (ExplicitBounds[i - 1] + ExplicitBounds[i]) / 2)), arrayEnumerate(BucketCounts)))`
+
+// metricHistogramCountsExpr is the number of observations of each histogram bucket of a data point, with a count of 1 for
+// non-histogram data points. Cumulative histograms are converted to the observations since the previous data point of the
+// series, so that every observation is only counted once. Like counters, they are reset when a bucket count decreases.
+var metricHistogramCountsExpr = fmt.Sprintf(
+	"if(empty(BucketCounts), [toUInt64(1)], if(Temporality = '%[1]s' AND length(%[2]s) = length(BucketCounts) AND arrayAll((c, p) -> c >= p, BucketCounts, %[2]s), arrayMap((c, p) -> c - p, BucketCounts, %[2]s), BucketCounts))",
+	MetricTemporalityCumulative,
+	"lagInFrame(BucketCounts, 1, BucketCounts) "+metricSeriesWindow,
+)
+
+var metricsTableConfig = model.TableConfig{
+	TableName:              MetricsTable,
+	AttributesColumn:       "Attributes",
+	BodyColumn:             "MetricName",
+	MetricColumn:           ptr.String("Value"),
+	MetricDeltaColumn:      ptr.String(metricDeltaExpr),
+	HistogramBucketsColumn: ptr.String(metricHistogramBucketsExpr),
+	HistogramCountsColumn:  ptr.String(metricHistogramCountsExpr),
+	KeysToColumns:          metricKeysToColumns,
+	ReservedKeys:           lo.Keys(metricKeysToColumns),
+	SelectColumns:          metricColumns,
+}
+
+// MetricsSampleableTableConfig does not sample since metrics are already aggregated by the exporters
 var MetricsSampleableTableConfig = SampleableTableConfig{
 	tableConfig:         metricsTableConfig,
-	samplingTableConfig: metricsSamplingTableConfig,
+	samplingTableConfig: metricsTableConfig,
 	useSampling: func(d time.Duration) bool {
-		return d >= time.Hour
+		return false
 	},
 }
 
+func (client *Client) BatchWriteMetricRows(ctx context.Context, metricRows []*MetricRow) error {
+	if len(metricRows) == 0 {
+		return nil
+	}
+
+	span, _ := util.StartSpanFromContext(ctx, util.KafkaBatchWorkerOp, util.ResourceName("worker.kafka.batched.flushMetrics.prepareRows"))
+	span.SetAttribute("BatchSize", len(metricRows))
+
+	batch, err := client.conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s", MetricsTable))
+	if err != nil {
+		span.Finish(err)
+		return e.Wrap(err, "failed to create metrics batch")
+	}
+
+	for _, metricRow := range metricRows {
+		err = batch.AppendStruct(metricRow)
+		if err != nil {
+			span.Finish(err)
+			return err
+		}
+	}
+	span.Finish()
+
+	return batch.Send()
+}
+
 func (client *Client) ReadEventMetrics(ctx context.Context, projectID int, params modelInputs.QueryInput, column string, metricTypes []modelInputs.MetricAggregator, groupBy []string, nBuckets *int, bucketBy string, bucketWindow *int, limit *int, limitAggregator *modelInputs.MetricAggregator, limitColumn *string) (*modelInputs.MetricsBuckets, error) {
 	params.Query = params.Query + " " + modelInputs.ReservedTraceKeyMetricName.String() + "=" + column
 	return client.ReadMetrics(ctx, ReadMetricsInput{
@@ -61,7 +147,6 @@ func (client *Client) ReadEventMetrics(ctx context.Context, projectID int, param
 }
 
 func (client *Client) ReadWorkspaceMetricCounts(ctx context.Context, projectIDs []int, params modelInputs.QueryInput) (*modelInputs.MetricsBuckets, error) {
-	params.Query = params.Query + " " + modelInputs.ReservedTraceKeyMetricValue.String() + " exists"
 	// 12 buckets - 12 months in a year, or 12 weeks in a quarter
 	return client.ReadMetrics(ctx, ReadMetricsInput{
 		SampleableConfig: MetricsSampleableTableConfig,
@@ -84,11 +169,11 @@ func (client *Client) MetricsKeys(ctx context.Context, projectID int, startDate
 		return metricKeys, nil
 	}
 
-	return client.TracesKeys(ctx, projectID, startDate, endDate, query, typeArg)
+	return KeysAggregated(ctx, client, MetricKeysTable, projectID, startDate, endDate, query, typeArg, nil)
 }
 
 func (client *Client) MetricsKeyValues(ctx context.Context, projectID int, keyName string, startDate time.Time, endDate time.Time, query *string, limit *int) ([]string, error) {
-	return KeyValuesAggregated(ctx, client, TraceKeyValuesTable, projectID, keyName, startDate, endDate, query, limit, nil)
+	return KeyValuesAggregated(ctx, client, MetricKeyValuesTable, projectID, keyName, startDate, endDate, query, limit, nil)
 }
 
 func (client *Client) MetricsLogLines(ctx context.Context, projectID int, params modelInputs.QueryInput) ([]*modelInputs.LogLine, error) {
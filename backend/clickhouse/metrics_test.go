@@ -0,0 +1,115 @@
+package clickhouse
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/openlyinc/pointy"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchWriteMetricRows(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now()
+
+	rows := []*MetricRow{
+		NewMetricRow(now, 1).WithMetricName("memory").WithValue(1024),
+		NewMetricRow(now, 1).WithMetricName("latency").WithMetricType(MetricTypeHistogram).
+			WithAggregate(4, 100, 5, 60).WithBuckets([]uint64{1, 2, 1}, []float64{10, 50}),
+	}
+
+	assert.NoError(t, client.BatchWriteMetricRows(ctx, rows))
+}
+
+func TestMetricRowWithAttributes(t *testing.T) {
+	long := strings.Repeat("a", TraceAttributeValueLengthLimit+1)
+	attributes := map[string]string{"host": long}
+	row := NewMetricRow(time.Now(), 1).WithAttributes(attributes)
+	assert.Equal(t, long, attributes["host"])
+	assert.Equal(t, long[:TraceAttributeValueLengthLimit]+"...", row.Attributes["host"])
+}
+
+func TestReadEventMetricsCounter(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now()
+	var rows []*MetricRow
+	// the counter is reset between the second and third data point
+	for idx, value := range []float64{10, 20, 5, 15} {
+		rows = append(rows, NewMetricRow(now.Add(time.Duration(idx-3)*time.Minute), 1).
+			WithMetricName("requests").
+			WithMetricType(MetricTypeSum).
+			WithTemporality(MetricTemporalityCumulative, true).
+			WithValue(value))
+	}
+	assert.NoError(t, client.BatchWriteMetricRows(ctx, rows))
+
+	metrics, err := client.ReadEventMetrics(ctx, 1, modelInputs.QueryInput{
+		DateRange: makeDateWithinRange(now),
+	}, "requests", []modelInputs.MetricAggregator{modelInputs.MetricAggregatorIncrease, modelInputs.MetricAggregatorRate}, nil, pointy.Int(1), modelInputs.MetricBucketByNone.String(), nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Buckets, 2)
+	for _, bucket := range metrics.Buckets {
+		switch bucket.MetricType {
+		case modelInputs.MetricAggregatorIncrease:
+			assert.Equal(t, 25., *bucket.MetricValue)
+		case modelInputs.MetricAggregatorRate:
+			assert.InDelta(t, 25./(2*time.Hour).Seconds(), *bucket.MetricValue, 1e-6)
+		}
+	}
+}
+
+func TestReadEventMetricsHistogramPercentile(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now()
+	rows := []*MetricRow{
+		NewMetricRow(now, 1).WithMetricName("latency").WithMetricType(MetricTypeHistogram).
+			WithAggregate(4, 100, 5, 60).WithBuckets([]uint64{1, 2, 1}, []float64{10, 50}),
+	}
+	assert.NoError(t, client.BatchWriteMetricRows(ctx, rows))
+
+	metrics, err := client.ReadEventMetrics(ctx, 1, modelInputs.QueryInput{
+		DateRange: makeDateWithinRange(now),
+	}, "latency", []modelInputs.MetricAggregator{modelInputs.MetricAggregatorP50}, nil, pointy.Int(1), modelInputs.MetricBucketByNone.String(), nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Buckets, 1)
+	// buckets are represented by their midpoint, so the median falls in the (10, 50] bucket
+	assert.Equal(t, 30., *metrics.Buckets[0].MetricValue)
+}
+
+func TestReadEventMetricsCumulativeHistogramPercentile(t *testing.T) {
+	ctx := context.Background()
+	client, teardown := setupTest(t)
+	defer teardown(t)
+
+	now := time.Now()
+	rows := []*MetricRow{
+		NewMetricRow(now.Add(-time.Minute), 1).WithMetricName("latency").WithMetricType(MetricTypeHistogram).
+			WithTemporality(MetricTemporalityCumulative, false).
+			WithAggregate(10, 50, 1, 9).WithBuckets([]uint64{10, 0, 0}, []float64{10, 50}),
+		NewMetricRow(now, 1).WithMetricName("latency").WithMetricType(MetricTypeHistogram).
+			WithTemporality(MetricTemporalityCumulative, false).
+			WithAggregate(14, 290, 1, 70).WithBuckets([]uint64{10, 0, 4}, []float64{10, 50}),
+	}
+	assert.NoError(t, client.BatchWriteMetricRows(ctx, rows))
+
+	metrics, err := client.ReadEventMetrics(ctx, 1, modelInputs.QueryInput{
+		DateRange: makeDateWithinRange(now),
+	}, "latency", []modelInputs.MetricAggregator{modelInputs.MetricAggregatorP50}, nil, pointy.Int(1), modelInputs.MetricBucketByNone.String(), nil, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, metrics.Buckets, 1)
+	// only the observations since the previous data point count, which all fall in the last bucket
+	assert.Equal(t, 50., *metrics.Buckets[0].MetricValue)
+}
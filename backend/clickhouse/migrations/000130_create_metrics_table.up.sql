@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS metrics (
+    `Timestamp` DateTime64(9),
+    `UUID` UUID,
+    `ProjectId` UInt32,
+    `ServiceName` LowCardinality(String),
+    `ServiceVersion` String,
+    `Environment` LowCardinality(String),
+    `MetricName` LowCardinality(String),
+    `MetricType` LowCardinality(String),
+    `Temporality` LowCardinality(String),
+    `IsMonotonic` Bool,
+    `Unit` LowCardinality(String),
+    `Attributes` Map(LowCardinality(String), String),
+    `StartTimestamp` DateTime64(9),
+    `Value` Float64,
+    `Count` UInt64,
+    `Sum` Float64,
+    `Min` Float64,
+    `Max` Float64,
+    `BucketCounts` Array(UInt64),
+    `ExplicitBounds` Array(Float64),
+    `SecureSessionId` String,
+    `TraceId` String,
+    `SpanId` String,
+    INDEX idx_attr_key mapKeys(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1,
+    INDEX idx_attr_value mapValues(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1
+) ENGINE = MergeTree PARTITION BY toStartOfDay(Timestamp)
+ORDER BY (ProjectId, MetricName, toStartOfHour(Timestamp), ServiceName, Timestamp)
+TTL toDateTime(Timestamp) + toIntervalDay(30) SETTINGS index_granularity = 8192,
+    ttl_only_drop_parts = 1,
+    allow_experimental_block_number_column = true;
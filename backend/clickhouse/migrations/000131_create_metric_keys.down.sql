@@ -0,0 +1,7 @@
+DROP VIEW IF EXISTS metric_names_mv;
+DROP VIEW IF EXISTS metric_keys_mv;
+DROP VIEW IF EXISTS metric_type_mv;
+DROP VIEW IF EXISTS metric_service_name_mv;
+DROP VIEW IF EXISTS metric_attributes_mv;
+DROP TABLE IF EXISTS metric_keys;
+DROP TABLE IF EXISTS metric_key_values;
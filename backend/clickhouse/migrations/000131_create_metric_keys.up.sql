@@ -0,0 +1,126 @@
+CREATE TABLE IF NOT EXISTS metric_key_values (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Value` String,
+    `Count` UInt64
+) ENGINE = SummingMergeTree
+ORDER BY (ProjectId, Key, Day, Value) TTL Day + toIntervalDay(31);
+
+CREATE TABLE IF NOT EXISTS metric_keys (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Type` LowCardinality(String),
+    `Count` UInt64
+) ENGINE = SummingMergeTree
+ORDER BY (ProjectId, Key, Day) TTL Day + toIntervalDay(31);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS metric_attributes_mv TO metric_key_values (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Value` String,
+    `Count` UInt64
+) AS
+SELECT ProjectId,
+    arrayJoin(Attributes).1 AS Key,
+    toStartOfDay(Timestamp) AS Day,
+    arrayJoin(Attributes).2 AS Value,
+    count() AS Count
+FROM metrics
+WHERE (
+        Key NOT IN (
+            'metric_name',
+            'metric_type',
+            'temporality',
+            'service_name',
+            'service_version',
+            'environment',
+            'secure_session_id',
+            'span_id',
+            'trace_id'
+        )
+    )
+    AND (Value != '')
+GROUP BY ProjectId,
+    Key,
+    Day,
+    Value;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS metric_service_name_mv TO metric_key_values (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Value` String,
+    `Count` UInt64
+) AS
+SELECT ProjectId,
+    'service_name' AS Key,
+    toStartOfDay(Timestamp) AS Day,
+    ServiceName AS Value,
+    count() AS Count
+FROM metrics
+WHERE (ServiceName != '')
+GROUP BY ProjectId,
+    Key,
+    Day,
+    Value;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS metric_type_mv TO metric_key_values (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Value` String,
+    `Count` UInt64
+) AS
+SELECT ProjectId,
+    'metric_type' AS Key,
+    toStartOfDay(Timestamp) AS Day,
+    MetricType AS Value,
+    count() AS Count
+FROM metrics
+GROUP BY ProjectId,
+    Key,
+    Day,
+    Value;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS metric_keys_mv TO metric_keys (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Count` UInt64,
+    `Type` String
+) AS
+SELECT ProjectId,
+    Key,
+    Day,
+    sum(Count) AS Count,
+    if(
+        isNull(toFloat64OrNull(Value)),
+        'String',
+        'Numeric'
+    ) AS Type
+FROM metric_key_values
+GROUP BY ProjectId,
+    Key,
+    Day,
+    isNull(toFloat64OrNull(Value));
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS metric_names_mv TO trace_metrics (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Type` LowCardinality(String),
+    `Count` UInt64
+) AS
+SELECT ProjectId,
+    MetricName AS Key,
+    toStartOfDay(Timestamp) AS Day,
+    'Numeric' AS Type,
+    count() AS Count
+FROM metrics
+WHERE MetricName != ''
+GROUP BY ProjectId,
+    Key,
+    Day;
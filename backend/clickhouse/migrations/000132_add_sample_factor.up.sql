@@ -0,0 +1,56 @@
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS SampleFactor Float64 DEFAULT 1;
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS SampleFactor Float64 DEFAULT 1;
+
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS SampleFactor Float64 DEFAULT 1;
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS SampleFactor Float64 DEFAULT 1;
+
+DROP VIEW IF EXISTS logs_sampling_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS logs_sampling_mv TO logs_sampling (
+    `Timestamp` DateTime,
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `TraceFlags` UInt32,
+    `SeverityText` LowCardinality(String),
+    `SeverityNumber` Int32,
+    `ServiceName` LowCardinality(String),
+    `Body` String,
+    `LogAttributes` Map(LowCardinality(String), String),
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `Source` String,
+    `ServiceVersion` String,
+    `SampleFactor` Float64
+) AS
+SELECT *
+FROM logs;
+
+DROP VIEW IF EXISTS traces_sampling_new_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS traces_sampling_new_mv TO traces_sampling_new (
+    `Timestamp` DateTime64(9),
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `ParentSpanId` String,
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `TraceState` String,
+    `SpanName` LowCardinality(String),
+    `SpanKind` LowCardinality(String),
+    `Duration` Int64,
+    `ServiceName` LowCardinality(String),
+    `ServiceVersion` String,
+    `TraceAttributes` Map(LowCardinality(String), String),
+    `StatusCode` LowCardinality(String),
+    `StatusMessage` String,
+    `Events.Timestamp` Array(DateTime64(9)),
+    `Events.Name` Array(LowCardinality(String)),
+    `Events.Attributes` Array(Map(LowCardinality(String), String)),
+    `Links.TraceId` Array(String),
+    `Links.SpanId` Array(String),
+    `Links.TraceState` Array(String),
+    `Links.Attributes` Array(Map(LowCardinality(String), String)),
+    `SampleFactor` Float64
+) AS
+SELECT *
+FROM traces;
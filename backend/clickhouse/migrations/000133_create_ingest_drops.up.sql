@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS ingest_drops (
+    `ProjectId` UInt32,
+    `Timestamp` DateTime,
+    `Product` LowCardinality(String),
+    `Reason` LowCardinality(String),
+    `ServiceName` LowCardinality(String),
+    `Count` UInt64
+) ENGINE = SummingMergeTree
+ORDER BY (ProjectId, Timestamp, Product, Reason, ServiceName) TTL Timestamp + toIntervalDay(90);
@@ -0,0 +1,109 @@
+DROP VIEW IF EXISTS log_resource_fields_mv;
+DROP VIEW IF EXISTS trace_resource_fields_mv;
+
+DROP VIEW IF EXISTS logs_sampling_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS logs_sampling_mv TO logs_sampling (
+    `Timestamp` DateTime,
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `TraceFlags` UInt32,
+    `SeverityText` LowCardinality(String),
+    `SeverityNumber` Int32,
+    `ServiceName` LowCardinality(String),
+    `Body` String,
+    `LogAttributes` Map(LowCardinality(String), String),
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `Source` String,
+    `ServiceVersion` String,
+    `SampleFactor` Float64
+) AS
+SELECT *
+FROM logs;
+
+DROP VIEW IF EXISTS traces_sampling_new_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS traces_sampling_new_mv TO traces_sampling_new (
+    `Timestamp` DateTime64(9),
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `ParentSpanId` String,
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `TraceState` String,
+    `SpanName` LowCardinality(String),
+    `SpanKind` LowCardinality(String),
+    `Duration` Int64,
+    `ServiceName` LowCardinality(String),
+    `ServiceVersion` String,
+    `TraceAttributes` Map(LowCardinality(String), String),
+    `StatusCode` LowCardinality(String),
+    `StatusMessage` String,
+    `Events.Timestamp` Array(DateTime64(9)),
+    `Events.Name` Array(LowCardinality(String)),
+    `Events.Attributes` Array(Map(LowCardinality(String), String)),
+    `Links.TraceId` Array(String),
+    `Links.SpanId` Array(String),
+    `Links.TraceState` Array(String),
+    `Links.Attributes` Array(Map(LowCardinality(String), String)),
+    `SampleFactor` Float64
+) AS
+SELECT *
+FROM traces;
+
+ALTER TABLE logs DROP INDEX IF EXISTS idx_k8s_namespace;
+ALTER TABLE logs DROP INDEX IF EXISTS idx_k8s_deployment;
+ALTER TABLE logs DROP INDEX IF EXISTS idx_k8s_node;
+ALTER TABLE logs DROP INDEX IF EXISTS idx_k8s_pod;
+ALTER TABLE logs DROP INDEX IF EXISTS idx_container_id;
+ALTER TABLE logs DROP COLUMN IF EXISTS K8sCluster;
+ALTER TABLE logs DROP COLUMN IF EXISTS K8sNamespace;
+ALTER TABLE logs DROP COLUMN IF EXISTS K8sDeployment;
+ALTER TABLE logs DROP COLUMN IF EXISTS K8sNode;
+ALTER TABLE logs DROP COLUMN IF EXISTS K8sPod;
+ALTER TABLE logs DROP COLUMN IF EXISTS ContainerId;
+ALTER TABLE logs DROP COLUMN IF EXISTS CloudProvider;
+ALTER TABLE logs DROP COLUMN IF EXISTS CloudRegion;
+
+ALTER TABLE logs_sampling DROP INDEX IF EXISTS idx_k8s_namespace;
+ALTER TABLE logs_sampling DROP INDEX IF EXISTS idx_k8s_deployment;
+ALTER TABLE logs_sampling DROP INDEX IF EXISTS idx_k8s_node;
+ALTER TABLE logs_sampling DROP INDEX IF EXISTS idx_k8s_pod;
+ALTER TABLE logs_sampling DROP INDEX IF EXISTS idx_container_id;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS K8sCluster;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS K8sNamespace;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS K8sDeployment;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS K8sNode;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS K8sPod;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS ContainerId;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS CloudProvider;
+ALTER TABLE logs_sampling DROP COLUMN IF EXISTS CloudRegion;
+
+ALTER TABLE traces DROP INDEX IF EXISTS idx_k8s_namespace;
+ALTER TABLE traces DROP INDEX IF EXISTS idx_k8s_deployment;
+ALTER TABLE traces DROP INDEX IF EXISTS idx_k8s_node;
+ALTER TABLE traces DROP INDEX IF EXISTS idx_k8s_pod;
+ALTER TABLE traces DROP INDEX IF EXISTS idx_container_id;
+ALTER TABLE traces DROP COLUMN IF EXISTS K8sCluster;
+ALTER TABLE traces DROP COLUMN IF EXISTS K8sNamespace;
+ALTER TABLE traces DROP COLUMN IF EXISTS K8sDeployment;
+ALTER TABLE traces DROP COLUMN IF EXISTS K8sNode;
+ALTER TABLE traces DROP COLUMN IF EXISTS K8sPod;
+ALTER TABLE traces DROP COLUMN IF EXISTS ContainerId;
+ALTER TABLE traces DROP COLUMN IF EXISTS CloudProvider;
+ALTER TABLE traces DROP COLUMN IF EXISTS CloudRegion;
+
+ALTER TABLE traces_sampling_new DROP INDEX IF EXISTS idx_k8s_namespace;
+ALTER TABLE traces_sampling_new DROP INDEX IF EXISTS idx_k8s_deployment;
+ALTER TABLE traces_sampling_new DROP INDEX IF EXISTS idx_k8s_node;
+ALTER TABLE traces_sampling_new DROP INDEX IF EXISTS idx_k8s_pod;
+ALTER TABLE traces_sampling_new DROP INDEX IF EXISTS idx_container_id;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS K8sCluster;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS K8sNamespace;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS K8sDeployment;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS K8sNode;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS K8sPod;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS ContainerId;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS CloudProvider;
+ALTER TABLE traces_sampling_new DROP COLUMN IF EXISTS CloudRegion;
@@ -0,0 +1,176 @@
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS K8sCluster LowCardinality(String) DEFAULT LogAttributes['k8s.cluster.name'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS K8sNamespace LowCardinality(String) DEFAULT LogAttributes['k8s.namespace.name'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS K8sDeployment LowCardinality(String) DEFAULT LogAttributes['k8s.deployment.name'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS K8sNode LowCardinality(String) DEFAULT LogAttributes['k8s.node.name'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS K8sPod String DEFAULT LogAttributes['k8s.pod.name'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS ContainerId String DEFAULT LogAttributes['container.id'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS CloudProvider LowCardinality(String) DEFAULT LogAttributes['cloud.provider'];
+ALTER TABLE logs ADD COLUMN IF NOT EXISTS CloudRegion LowCardinality(String) DEFAULT LogAttributes['cloud.region'];
+ALTER TABLE logs ADD INDEX IF NOT EXISTS idx_k8s_namespace K8sNamespace TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs ADD INDEX IF NOT EXISTS idx_k8s_deployment K8sDeployment TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs ADD INDEX IF NOT EXISTS idx_k8s_node K8sNode TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs ADD INDEX IF NOT EXISTS idx_k8s_pod K8sPod TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs ADD INDEX IF NOT EXISTS idx_container_id ContainerId TYPE bloom_filter GRANULARITY 1;
+
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS K8sCluster LowCardinality(String) DEFAULT LogAttributes['k8s.cluster.name'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS K8sNamespace LowCardinality(String) DEFAULT LogAttributes['k8s.namespace.name'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS K8sDeployment LowCardinality(String) DEFAULT LogAttributes['k8s.deployment.name'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS K8sNode LowCardinality(String) DEFAULT LogAttributes['k8s.node.name'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS K8sPod String DEFAULT LogAttributes['k8s.pod.name'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS ContainerId String DEFAULT LogAttributes['container.id'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS CloudProvider LowCardinality(String) DEFAULT LogAttributes['cloud.provider'];
+ALTER TABLE logs_sampling ADD COLUMN IF NOT EXISTS CloudRegion LowCardinality(String) DEFAULT LogAttributes['cloud.region'];
+ALTER TABLE logs_sampling ADD INDEX IF NOT EXISTS idx_k8s_namespace K8sNamespace TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs_sampling ADD INDEX IF NOT EXISTS idx_k8s_deployment K8sDeployment TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs_sampling ADD INDEX IF NOT EXISTS idx_k8s_node K8sNode TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs_sampling ADD INDEX IF NOT EXISTS idx_k8s_pod K8sPod TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE logs_sampling ADD INDEX IF NOT EXISTS idx_container_id ContainerId TYPE bloom_filter GRANULARITY 1;
+
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS K8sCluster LowCardinality(String) DEFAULT TraceAttributes['k8s.cluster.name'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS K8sNamespace LowCardinality(String) DEFAULT TraceAttributes['k8s.namespace.name'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS K8sDeployment LowCardinality(String) DEFAULT TraceAttributes['k8s.deployment.name'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS K8sNode LowCardinality(String) DEFAULT TraceAttributes['k8s.node.name'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS K8sPod String DEFAULT TraceAttributes['k8s.pod.name'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS ContainerId String DEFAULT TraceAttributes['container.id'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS CloudProvider LowCardinality(String) DEFAULT TraceAttributes['cloud.provider'];
+ALTER TABLE traces ADD COLUMN IF NOT EXISTS CloudRegion LowCardinality(String) DEFAULT TraceAttributes['cloud.region'];
+ALTER TABLE traces ADD INDEX IF NOT EXISTS idx_k8s_namespace K8sNamespace TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces ADD INDEX IF NOT EXISTS idx_k8s_deployment K8sDeployment TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces ADD INDEX IF NOT EXISTS idx_k8s_node K8sNode TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces ADD INDEX IF NOT EXISTS idx_k8s_pod K8sPod TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces ADD INDEX IF NOT EXISTS idx_container_id ContainerId TYPE bloom_filter GRANULARITY 1;
+
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS K8sCluster LowCardinality(String) DEFAULT TraceAttributes['k8s.cluster.name'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS K8sNamespace LowCardinality(String) DEFAULT TraceAttributes['k8s.namespace.name'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS K8sDeployment LowCardinality(String) DEFAULT TraceAttributes['k8s.deployment.name'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS K8sNode LowCardinality(String) DEFAULT TraceAttributes['k8s.node.name'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS K8sPod String DEFAULT TraceAttributes['k8s.pod.name'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS ContainerId String DEFAULT TraceAttributes['container.id'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS CloudProvider LowCardinality(String) DEFAULT TraceAttributes['cloud.provider'];
+ALTER TABLE traces_sampling_new ADD COLUMN IF NOT EXISTS CloudRegion LowCardinality(String) DEFAULT TraceAttributes['cloud.region'];
+ALTER TABLE traces_sampling_new ADD INDEX IF NOT EXISTS idx_k8s_namespace K8sNamespace TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces_sampling_new ADD INDEX IF NOT EXISTS idx_k8s_deployment K8sDeployment TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces_sampling_new ADD INDEX IF NOT EXISTS idx_k8s_node K8sNode TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces_sampling_new ADD INDEX IF NOT EXISTS idx_k8s_pod K8sPod TYPE bloom_filter GRANULARITY 1;
+ALTER TABLE traces_sampling_new ADD INDEX IF NOT EXISTS idx_container_id ContainerId TYPE bloom_filter GRANULARITY 1;
+
+DROP VIEW IF EXISTS logs_sampling_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS logs_sampling_mv TO logs_sampling (
+    `Timestamp` DateTime,
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `TraceFlags` UInt32,
+    `SeverityText` LowCardinality(String),
+    `SeverityNumber` Int32,
+    `ServiceName` LowCardinality(String),
+    `Body` String,
+    `LogAttributes` Map(LowCardinality(String), String),
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `Source` String,
+    `ServiceVersion` String,
+    `SampleFactor` Float64,
+    `K8sCluster` LowCardinality(String),
+    `K8sNamespace` LowCardinality(String),
+    `K8sDeployment` LowCardinality(String),
+    `K8sNode` LowCardinality(String),
+    `K8sPod` String,
+    `ContainerId` String,
+    `CloudProvider` LowCardinality(String),
+    `CloudRegion` LowCardinality(String)
+) AS
+SELECT *
+FROM logs;
+
+DROP VIEW IF EXISTS traces_sampling_new_mv;
+CREATE MATERIALIZED VIEW IF NOT EXISTS traces_sampling_new_mv TO traces_sampling_new (
+    `Timestamp` DateTime64(9),
+    `UUID` UUID,
+    `TraceId` String,
+    `SpanId` String,
+    `ParentSpanId` String,
+    `ProjectId` UInt32,
+    `SecureSessionId` String,
+    `TraceState` String,
+    `SpanName` LowCardinality(String),
+    `SpanKind` LowCardinality(String),
+    `Duration` Int64,
+    `ServiceName` LowCardinality(String),
+    `ServiceVersion` String,
+    `TraceAttributes` Map(LowCardinality(String), String),
+    `StatusCode` LowCardinality(String),
+    `StatusMessage` String,
+    `Events.Timestamp` Array(DateTime64(9)),
+    `Events.Name` Array(LowCardinality(String)),
+    `Events.Attributes` Array(Map(LowCardinality(String), String)),
+    `Links.TraceId` Array(String),
+    `Links.SpanId` Array(String),
+    `Links.TraceState` Array(String),
+    `Links.Attributes` Array(Map(LowCardinality(String), String)),
+    `SampleFactor` Float64,
+    `K8sCluster` LowCardinality(String),
+    `K8sNamespace` LowCardinality(String),
+    `K8sDeployment` LowCardinality(String),
+    `K8sNode` LowCardinality(String),
+    `K8sPod` String,
+    `ContainerId` String,
+    `CloudProvider` LowCardinality(String),
+    `CloudRegion` LowCardinality(String)
+) AS
+SELECT *
+FROM traces;
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS log_resource_fields_mv TO log_attributes (
+    `ProjectId` UInt32,
+    `Key` String,
+    `LogTimestamp` DateTime,
+    `LogUUID` UUID,
+    `Value` String
+) AS
+SELECT ProjectId AS ProjectId,
+    ResourceField.1 AS Key,
+    Timestamp AS LogTimestamp,
+    UUID AS LogUUID,
+    ResourceField.2 AS Value
+FROM logs ARRAY
+    JOIN [
+        ('k8s.cluster', toString(K8sCluster)),
+        ('k8s.namespace', toString(K8sNamespace)),
+        ('k8s.deployment', toString(K8sDeployment)),
+        ('k8s.node', toString(K8sNode)),
+        ('k8s.pod', toString(K8sPod)),
+        ('container.id', toString(ContainerId)),
+        ('cloud.provider', toString(CloudProvider)),
+        ('cloud.region', toString(CloudRegion))
+    ] AS ResourceField
+WHERE (Value != '');
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS trace_resource_fields_mv TO trace_key_values (
+    `ProjectId` Int32,
+    `Key` LowCardinality(String),
+    `Day` DateTime,
+    `Value` String,
+    `Count` UInt64
+) AS
+SELECT ProjectId,
+    ResourceField.1 AS Key,
+    toStartOfDay(Timestamp) AS Day,
+    ResourceField.2 AS Value,
+    count() AS Count
+FROM traces ARRAY
+    JOIN [
+        ('k8s.cluster', toString(K8sCluster)),
+        ('k8s.namespace', toString(K8sNamespace)),
+        ('k8s.deployment', toString(K8sDeployment)),
+        ('k8s.node', toString(K8sNode)),
+        ('k8s.pod', toString(K8sPod)),
+        ('container.id', toString(ContainerId)),
+        ('cloud.provider', toString(CloudProvider)),
+        ('cloud.region', toString(CloudRegion))
+    ] AS ResourceField
+WHERE (Value != '')
+GROUP BY ProjectId,
+    Key,
+    Day,
+    Value;
@@ -645,51 +645,8 @@ var nonAlphaNumericChars = regexp.MustCompile(`[^\w:*]`)
 // strip toString() wrapper around filter keys to match the KeysToColumns map
 var keyWrapper = regexp.MustCompile(`toString\((\w+)\)`)
 
-func matchFilter[TObj interface{}](row *TObj, config model.TableConfig, filter *listener.FilterOperation) (bool, error) {
-	key := filter.Key
-	groups := keyWrapper.FindStringSubmatch(key)
-	if len(groups) > 0 {
-		key = groups[1]
-	}
-	bodyFilter := config.BodyColumn != "" && filter.Column == "" && key == config.BodyColumn
-	v := reflect.ValueOf(*row)
-
-	rowBodyTerms := map[string]bool{}
-	if bodyFilter {
-		body := v.FieldByName(config.BodyColumn).String()
-		for _, field := range nonAlphaNumericChars.Split(body, -1) {
-			if field != "" {
-				rowBodyTerms[field] = true
-			}
-		}
-		for _, bf := range filter.Values {
-			if filter.Operator == listener.OperatorRegExp {
-				pat, err := regexp.Compile(bf)
-				if err == nil {
-					matches := pat.MatchString(body)
-					shouldMatch := filter.Operator == listener.OperatorRegExp
-
-					if (shouldMatch && !matches) || (!shouldMatch && matches) {
-						return false, nil
-					}
-				}
-			} else if strings.Contains(bf, "%") {
-				pat, err := regexp.Compile(strings.ReplaceAll(regexp.QuoteMeta(bf), "%", ".*"))
-				// this may over match if the expression cannot be compiled,
-				// but we'd prefer to over match as this fn is used to determine sampling
-				if err != nil {
-					return false, err
-				}
-				if !pat.MatchString(body) {
-					return false, nil
-				}
-			} else if !rowBodyTerms[bf] {
-				return false, nil
-			}
-		}
-		return true, nil
-	}
-
+// getRowValue returns the value of a search key for a row, from its columns or its attributes.
+func getRowValue(v reflect.Value, config model.TableConfig, key string) (string, bool) {
 	var rowValue string
 	if chKey, ok := config.KeysToColumns[key]; ok {
 		if val, ok := getChildValue(v, chKey); ok {
@@ -718,74 +675,177 @@ func matchFilter[TObj interface{}](row *TObj, config model.TableConfig, filter *
 			}
 		}
 	} else {
-		return true, e.New(fmt.Sprintf("invalid filter %s", key))
+		return "", false
 	}
-	for _, v := range filter.Values {
-		if filter.Operator == listener.OperatorRegExp {
-			pat, err := regexp.Compile(v)
-			if err == nil {
-				matches := pat.MatchString(rowValue)
-				shouldMatch := filter.Operator == listener.OperatorRegExp
+	return rowValue, true
+}
+
+// rowMatcher matches a row against a compiled filter. Returns an error for filters on unknown keys.
+type rowMatcher func(v reflect.Value) (bool, error)
 
-				if (shouldMatch && !matches) || (!shouldMatch && matches) {
-					return false, nil
+// compileFilter compiles a filter once, so that its patterns are not compiled for each row.
+func compileFilter(config model.TableConfig, filter *listener.FilterOperation) rowMatcher {
+	key := filter.Key
+	groups := keyWrapper.FindStringSubmatch(key)
+	if len(groups) > 0 {
+		key = groups[1]
+	}
+	bodyFilter := config.BodyColumn != "" && filter.Column == "" && key == config.BodyColumn
+
+	if bodyFilter {
+		var matchers []func(body string, rowBodyTerms map[string]bool) (bool, error)
+		for _, bf := range filter.Values {
+			bf := bf
+			if filter.Operator == listener.OperatorRegExp {
+				pat, err := regexp.Compile(bf)
+				if err == nil {
+					matchers = append(matchers, func(body string, _ map[string]bool) (bool, error) {
+						return pat.MatchString(body), nil
+					})
+				}
+			} else if strings.Contains(bf, "%") {
+				pat, err := regexp.Compile(strings.ReplaceAll(regexp.QuoteMeta(bf), "%", ".*"))
+				matchers = append(matchers, func(body string, _ map[string]bool) (bool, error) {
+					// this may over match if the expression cannot be compiled,
+					// but we'd prefer to over match as this fn is used to determine sampling
+					if err != nil {
+						return false, err
+					}
+					return pat.MatchString(body), nil
+				})
+			} else {
+				matchers = append(matchers, func(_ string, rowBodyTerms map[string]bool) (bool, error) {
+					return rowBodyTerms[bf], nil
+				})
+			}
+		}
+		return func(v reflect.Value) (bool, error) {
+			body := v.FieldByName(config.BodyColumn).String()
+			rowBodyTerms := map[string]bool{}
+			for _, field := range nonAlphaNumericChars.Split(body, -1) {
+				if field != "" {
+					rowBodyTerms[field] = true
 				}
 			}
-		} else if strings.Contains(v, "%") {
-			if matched, _ := regexp.Match(strings.ReplaceAll(v, "%", ".*"), []byte(rowValue)); !matched {
-				return false, nil
+			for _, matcher := range matchers {
+				if matches, err := matcher(body, rowBodyTerms); err != nil || !matches {
+					return false, err
+				}
+			}
+			return true, nil
+		}
+	}
+
+	var matchers []func(rowValue string) bool
+	for _, value := range filter.Values {
+		value := value
+		if filter.Operator == listener.OperatorRegExp {
+			pat, err := regexp.Compile(value)
+			if err == nil {
+				matchers = append(matchers, pat.MatchString)
 			}
+		} else if strings.Contains(value, "%") {
+			pat, err := regexp.Compile(strings.ReplaceAll(value, "%", ".*"))
+			matchers = append(matchers, func(rowValue string) bool {
+				return err == nil && pat.MatchString(rowValue)
+			})
 		} else if filter.Operator == listener.OperatorNotEqual {
-			if rowValue == strings.Replace(v, "-", "", 1) {
+			excluded := strings.Replace(value, "-", "", 1)
+			matchers = append(matchers, func(rowValue string) bool {
+				return rowValue != excluded
+			})
+		} else {
+			matchers = append(matchers, func(rowValue string) bool {
+				return rowValue == value
+			})
+		}
+	}
+	invalid := e.New(fmt.Sprintf("invalid filter %s", key))
+	return func(v reflect.Value) (bool, error) {
+		rowValue, ok := getRowValue(v, config, key)
+		if !ok {
+			return true, invalid
+		}
+		for _, matcher := range matchers {
+			if !matcher(rowValue) {
 				return false, nil
 			}
-		} else if v != rowValue {
-			return false, nil
 		}
+		return true, nil
 	}
-	return true, nil
 }
 
-func matchesQuery[TObj interface{}](row *TObj, config model.TableConfig, filters listener.Filters, op listener.Operator) bool {
-	// if multiple filters are passed in, assume an AND operation between them
+// compileFilters compiles a parsed query into a predicate on rows of the table.
+// If multiple filters are passed in, assume an AND operation between them.
+func compileFilters(config model.TableConfig, filters listener.Filters, op listener.Operator) func(v reflect.Value) bool {
+	// each step returns its result, and whether the result is that of the filters
+	var steps []func(v reflect.Value) (bool, bool)
 	for _, filter := range filters {
 		switch filter.Operator {
 		case listener.OperatorAnd:
+			var children []func(v reflect.Value) bool
 			for _, childFilter := range filter.Filters {
-				if !matchesQuery[TObj](row, config, listener.Filters{childFilter}, filter.Operator) {
-					return false
-				}
+				children = append(children, compileFilters(config, listener.Filters{childFilter}, filter.Operator))
 			}
+			steps = append(steps, func(v reflect.Value) (bool, bool) {
+				for _, child := range children {
+					if !child(v) {
+						return false, true
+					}
+				}
+				return true, false
+			})
 		case listener.OperatorOr:
-			var anyMatch bool
+			var children []func(v reflect.Value) bool
 			for _, childFilter := range filter.Filters {
-				if matchesQuery[TObj](row, config, listener.Filters{childFilter}, filter.Operator) {
-					anyMatch = true
-					break
-				}
-			}
-			if !anyMatch {
-				return false
+				children = append(children, compileFilters(config, listener.Filters{childFilter}, filter.Operator))
 			}
+			steps = append(steps, func(v reflect.Value) (bool, bool) {
+				for _, child := range children {
+					if child(v) {
+						return true, false
+					}
+				}
+				return false, true
+			})
 		case listener.OperatorNot:
-			return !matchesQuery[TObj](row, config, listener.Filters{filter.Filters[0]}, filter.Operator)
+			child := compileFilters(config, listener.Filters{filter.Filters[0]}, filter.Operator)
+			steps = append(steps, func(v reflect.Value) (bool, bool) {
+				return !child(v), true
+			})
 		default:
-			matches, err := matchFilter(row, config, filter)
-			if err != nil {
-				if op == listener.OperatorOr {
-					return false
-				} else if op == listener.OperatorNot {
-					return true
-				} else {
-					return true
+			matcher := compileFilter(config, filter)
+			steps = append(steps, func(v reflect.Value) (bool, bool) {
+				matches, err := matcher(v)
+				if err != nil {
+					return op != listener.OperatorOr, true
 				}
-			}
-			if !matches {
-				return false
+				return matches, !matches
+			})
+		}
+	}
+	return func(v reflect.Value) bool {
+		for _, step := range steps {
+			if result, done := step(v); done {
+				return result
 			}
 		}
+		return true
+	}
+}
+
+// Predicate matches rows of a table against a compiled query.
+type Predicate[TObj interface{}] func(row *TObj) bool
+
+func compileQuery[TObj interface{}](config model.TableConfig, filters listener.Filters) Predicate[TObj] {
+	matches := compileFilters(config, filters, listener.OperatorAnd)
+	return func(row *TObj) bool {
+		return matches(reflect.ValueOf(*row))
 	}
-	return true
+}
+
+func matchesQuery[TObj interface{}](row *TObj, config model.TableConfig, filters listener.Filters, op listener.Operator) bool {
+	return compileFilters(config, filters, op)(reflect.ValueOf(*row))
 }
 
 func getFnStr(aggregator modelInputs.MetricAggregator, column string, useSampling bool, useState bool) string {
@@ -794,7 +854,7 @@ func getFnStr(aggregator modelInputs.MetricAggregator, column string, useSamplin
 		if useState {
 			return "countState()"
 		} else if useSampling {
-			return "round(count() * any(_sample_factor))"
+			return "round(sum(_row_sample_factor) * any(_sample_factor))"
 		} else {
 			return "round(count() * 1.0)"
 		}
@@ -838,11 +898,11 @@ func getFnStr(aggregator modelInputs.MetricAggregator, column string, useSamplin
 			return fmt.Sprintf("maxState(toFloat64(%s))", column)
 		}
 		return fmt.Sprintf("toFloat64(max(%s))", column)
-	case modelInputs.MetricAggregatorSum:
+	case modelInputs.MetricAggregatorSum, modelInputs.MetricAggregatorIncrease, modelInputs.MetricAggregatorRate:
 		if useState {
 			return fmt.Sprintf("sumState(toFloat64(%s))", column)
 		} else if useSampling {
-			return fmt.Sprintf("sum(%s) * any(_sample_factor)", column)
+			return fmt.Sprintf("sum(%s * _row_sample_factor) * any(_sample_factor)", column)
 		} else {
 			return fmt.Sprintf("sum(%s) * 1.0", column)
 		}
@@ -850,6 +910,33 @@ func getFnStr(aggregator modelInputs.MetricAggregator, column string, useSamplin
 	return ""
 }
 
+// getWeightedFnStr returns the percentile of histogram buckets, given as arrays of bucket values and their weights
+func getWeightedFnStr(aggregator modelInputs.MetricAggregator, column string, weightColumn string) string {
+	switch aggregator {
+	case modelInputs.MetricAggregatorP50:
+		return fmt.Sprintf("quantileWeightedArray(.5)(%s, %s)", column, weightColumn)
+	case modelInputs.MetricAggregatorP90:
+		return fmt.Sprintf("quantileWeightedArray(.9)(%s, %s)", column, weightColumn)
+	case modelInputs.MetricAggregatorP95:
+		return fmt.Sprintf("quantileWeightedArray(.95)(%s, %s)", column, weightColumn)
+	case modelInputs.MetricAggregatorP99:
+		return fmt.Sprintf("quantileWeightedArray(.99)(%s, %s)", column, weightColumn)
+	}
+	return ""
+}
+
+func isPercentile(aggregator modelInputs.MetricAggregator) bool {
+	switch aggregator {
+	case modelInputs.MetricAggregatorP50, modelInputs.MetricAggregatorP90, modelInputs.MetricAggregatorP95, modelInputs.MetricAggregatorP99:
+		return true
+	}
+	return false
+}
+
+func isDelta(aggregator modelInputs.MetricAggregator) bool {
+	return aggregator == modelInputs.MetricAggregatorRate || aggregator == modelInputs.MetricAggregatorIncrease
+}
+
 func getAttributeFilterCol(sampleableConfig SampleableTableConfig, value, op string) (column string) {
 	column = fmt.Sprintf("%s[%s]", sampleableConfig.tableConfig.AttributesColumn, value)
 	if sampleableConfig.tableConfig.AttributesTable != "" {
@@ -930,7 +1017,7 @@ func (client *Client) saveMetricHistory(ctx context.Context, sb *sqlbuilder.Sele
 		insertCols = append(insertCols, "AvgState")
 	case modelInputs.MetricAggregatorMax:
 		insertCols = append(insertCols, "MaxState")
-	case modelInputs.MetricAggregatorSum:
+	case modelInputs.MetricAggregatorSum, modelInputs.MetricAggregatorIncrease, modelInputs.MetricAggregatorRate:
 		insertCols = append(insertCols, "SumState")
 	case modelInputs.MetricAggregatorP50:
 		insertCols = append(insertCols, "P50State")
@@ -1044,11 +1131,13 @@ func (client *Client) ReadMetrics(ctx context.Context, input ReadMetricsInput) (
 		attributeFields = append(attributeFields, input.Column)
 	}
 	var metricExpr = col
+	var isMetricColumn bool
 	if !isCountDistinct {
 		if reservedCol, found := keysToColumns[strings.ToLower(input.Column)]; found {
 			metricExpr = fmt.Sprintf("toFloat64(%s)", reservedCol)
 		} else if input.SampleableConfig.tableConfig.MetricColumn != nil {
 			metricExpr = *input.SampleableConfig.tableConfig.MetricColumn
+			isMetricColumn = true
 		} else {
 			metricExpr = fmt.Sprintf("toFloat64OrNull(%s)", col)
 		}
@@ -1057,6 +1146,7 @@ func (client *Client) ReadMetrics(ctx context.Context, input ReadMetricsInput) (
 	switch input.Column {
 	case "":
 		metricExpr = "1.0"
+		isMetricColumn = false
 	}
 
 	needsValue := false
@@ -1072,8 +1162,14 @@ func (client *Client) ReadMetrics(ctx context.Context, input ReadMetricsInput) (
 	}
 	if !needsValue {
 		metricExpr = "1.0"
+		isMetricColumn = false
 	}
 
+	// rate and increase use the change since the previous data point of a series, such as a cumulative counter
+	useDelta := isMetricColumn && config.MetricDeltaColumn != nil && lo.SomeBy(input.MetricTypes, isDelta)
+	// percentiles of histograms are computed from their buckets, which would skew any other aggregator
+	useBuckets := isMetricColumn && config.HistogramBucketsColumn != nil && config.HistogramCountsColumn != nil && input.SavedMetricState == nil && lo.EveryBy(input.MetricTypes, isPercentile)
+
 	bucketExpr := "toFloat64(Timestamp)"
 	if input.BucketBy != modelInputs.MetricBucketByNone.String() && input.BucketBy != modelInputs.MetricBucketByTimestamp.String() {
 		if col, found := keysToColumns[strings.ToLower(input.BucketBy)]; found {
@@ -1109,7 +1205,25 @@ func (client *Client) ReadMetrics(ctx context.Context, input ReadMetricsInput) (
 		selectCols = append(selectCols, fromSb.As("1.0", "_sample_factor"))
 	}
 
-	selectCols = append(selectCols, fromSb.As(metricExpr, "metric_value"))
+	// rows sampled at ingest stand for more than one item
+	if config.SampleFactorColumn != nil {
+		selectCols = append(selectCols, fromSb.As(*config.SampleFactorColumn, "_row_sample_factor"))
+	} else {
+		selectCols = append(selectCols, fromSb.As("1.0", "_row_sample_factor"))
+	}
+
+	if useBuckets {
+		selectCols = append(selectCols,
+			fromSb.As(*config.HistogramBucketsColumn, "metric_value"),
+			fromSb.As(*config.HistogramCountsColumn, "metric_weight"),
+		)
+	} else {
+		selectCols = append(selectCols, fromSb.As(metricExpr, "metric_value"))
+	}
+
+	if useDelta {
+		selectCols = append(selectCols, fromSb.As(*config.MetricDeltaColumn, "metric_delta"))
+	}
 
 	for idx, group := range input.GroupBy {
 		groupCol := ""
@@ -1209,7 +1323,19 @@ func (client *Client) ReadMetrics(ctx context.Context, input ReadMetricsInput) (
 		outerSelect = append(outerSelect, "any(max_block_number) as max_block_number")
 	}
 	for idx, metricType := range input.MetricTypes {
-		outerSelect = append(outerSelect, fmt.Sprintf("%s as metric_value%d", getFnStr(metricType, "metric_value", true, input.SavedMetricState != nil), idx))
+		column := "metric_value"
+		if useDelta && isDelta(metricType) {
+			column = "metric_delta"
+		}
+		fnStr := getFnStr(metricType, column, true, input.SavedMetricState != nil)
+		if useBuckets {
+			fnStr = getWeightedFnStr(metricType, column, "metric_weight")
+		}
+		if metricType == modelInputs.MetricAggregatorRate && input.SavedMetricState == nil {
+			// per second, using the width of the bucket
+			fnStr = fmt.Sprintf("%s / greatest((any(max) - any(min)) / %d, 1)", fnStr, nBuckets)
+		}
+		outerSelect = append(outerSelect, fmt.Sprintf("%s as metric_value%d", fnStr, idx))
 	}
 	for idx := range input.GroupBy {
 		outerSelect = append(outerSelect, fmt.Sprintf("g%d", idx))
@@ -0,0 +1,107 @@
+package clickhouse
+
+import (
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// ResourceFields are the kubernetes and cloud resource attributes of a log or span.
+// They are stored in dedicated columns of the logs and traces tables rather than in the attributes map,
+// so that filtering by them can use the column indexes.
+type ResourceFields struct {
+	K8sCluster    string
+	K8sNamespace  string
+	K8sDeployment string
+	K8sNode       string
+	K8sPod        string
+	ContainerId   string
+	CloudProvider string
+	CloudRegion   string
+}
+
+type resourceField struct {
+	// key is the reserved search key of the field
+	key string
+	// attribute is the OpenTelemetry resource attribute promoted to the field
+	attribute string
+	column    string
+	value     func(r *ResourceFields) *string
+}
+
+var resourceFields = []resourceField{
+	{key: "k8s.cluster", attribute: string(semconv.K8SClusterNameKey), column: "K8sCluster", value: func(r *ResourceFields) *string { return &r.K8sCluster }},
+	{key: "k8s.namespace", attribute: string(semconv.K8SNamespaceNameKey), column: "K8sNamespace", value: func(r *ResourceFields) *string { return &r.K8sNamespace }},
+	{key: "k8s.deployment", attribute: string(semconv.K8SDeploymentNameKey), column: "K8sDeployment", value: func(r *ResourceFields) *string { return &r.K8sDeployment }},
+	{key: "k8s.node", attribute: string(semconv.K8SNodeNameKey), column: "K8sNode", value: func(r *ResourceFields) *string { return &r.K8sNode }},
+	{key: "k8s.pod", attribute: string(semconv.K8SPodNameKey), column: "K8sPod", value: func(r *ResourceFields) *string { return &r.K8sPod }},
+	{key: string(semconv.ContainerIDKey), attribute: string(semconv.ContainerIDKey), column: "ContainerId", value: func(r *ResourceFields) *string { return &r.ContainerId }},
+	{key: string(semconv.CloudProviderKey), attribute: string(semconv.CloudProviderKey), column: "CloudProvider", value: func(r *ResourceFields) *string { return &r.CloudProvider }},
+	{key: string(semconv.CloudRegionKey), attribute: string(semconv.CloudRegionKey), column: "CloudRegion", value: func(r *ResourceFields) *string { return &r.CloudRegion }},
+}
+
+var resourceFieldColumns = func() []string {
+	var columns []string
+	for _, field := range resourceFields {
+		columns = append(columns, field.column)
+	}
+	return columns
+}()
+
+// resourceFieldReservedKeys are the search keys of the resource fields.
+var resourceFieldReservedKeys = func() []string {
+	var keys []string
+	for _, field := range resourceFields {
+		keys = append(keys, field.key)
+	}
+	return keys
+}()
+
+// withResourceFieldKeys maps the search keys of the resource fields to their columns.
+// The attribute names also map to the columns, so that filters written against the attributes keep working.
+func withResourceFieldKeys(keysToColumns map[string]string) map[string]string {
+	for _, field := range resourceFields {
+		keysToColumns[field.key] = field.column
+		keysToColumns[field.attribute] = field.column
+	}
+	return keysToColumns
+}
+
+// PromoteResourceFields removes the kubernetes and cloud resource attributes from the attributes,
+// returning them as resource fields.
+func PromoteResourceFields(attrs map[string]string) ResourceFields {
+	var r ResourceFields
+	for _, field := range resourceFields {
+		if val, ok := attrs[field.attribute]; ok {
+			*field.value(&r) = val
+			delete(attrs, field.attribute)
+		}
+	}
+	return r
+}
+
+// Attributes returns the resource fields that are set as resource attributes.
+func (r ResourceFields) Attributes() map[string]string {
+	attrs := map[string]string{}
+	for _, field := range resourceFields {
+		if val := *field.value(&r); val != "" {
+			attrs[field.attribute] = val
+		}
+	}
+	return attrs
+}
+
+// withResourceAttributes returns the attributes of a row along with its resource fields,
+// which is how the resource fields are shown alongside the other attributes.
+func withResourceAttributes(attrs map[string]string, r ResourceFields) map[string]string {
+	resourceAttrs := r.Attributes()
+	if len(resourceAttrs) == 0 {
+		return attrs
+	}
+	merged := make(map[string]string, len(attrs)+len(resourceAttrs))
+	for k, v := range attrs {
+		merged[k] = v
+	}
+	for k, v := range resourceAttrs {
+		merged[k] = v
+	}
+	return merged
+}
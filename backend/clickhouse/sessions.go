@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -488,6 +489,17 @@ func SessionMatchesQuery(session *model.Session, filters listener.Filters) bool
 	return matchesQuery(session, SessionsTableConfig, filters, listener.OperatorAnd)
 }
 
+// CompileSessionQuery compiles a search query into a predicate on sessions.
+func CompileSessionQuery(query string) Predicate[model.Session] {
+	return compileQuery[model.Session](SessionsTableConfig, parser.Parse(query, SessionsTableConfig))
+}
+
+// SessionValue returns the value of a search key for a session, such as service_name or environment.
+func SessionValue(session *model.Session, key string) string {
+	value, _ := getRowValue(reflect.ValueOf(*session), SessionsTableConfig, key)
+	return value
+}
+
 var reservedSessionKeys = lo.Map(modelInputs.AllReservedSessionKey, func(key modelInputs.ReservedSessionKey, _ int) string {
 	return string(key)
 })
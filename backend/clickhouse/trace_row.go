@@ -37,6 +37,9 @@ type TraceRow struct {
 	SecureSessionId string
 	Environment     string
 	HasErrors       bool
+	ResourceFields
+	// SampleFactor is the number of spans the row stands for, the inverse of the rate it was sampled at
+	SampleFactor float64
 }
 
 type Event struct {
@@ -54,9 +57,10 @@ type Link struct {
 
 func NewTraceRow(timestamp time.Time, projectID int) *TraceRow {
 	traceRow := &TraceRow{
-		Timestamp: timestamp,
-		UUID:      uuid.New().String(),
-		ProjectId: uint32(projectID),
+		Timestamp:    timestamp,
+		UUID:         uuid.New().String(),
+		ProjectId:    uint32(projectID),
+		SampleFactor: 1,
 	}
 
 	return traceRow
@@ -117,6 +121,11 @@ func (t *TraceRow) WithEnvironment(environment string) *TraceRow {
 	return t
 }
 
+func (t *TraceRow) WithResourceFields(resourceFields ResourceFields) *TraceRow {
+	t.ResourceFields = resourceFields
+	return t
+}
+
 func (t *TraceRow) WithHasErrors(hasErrors bool) *TraceRow {
 	t.HasErrors = hasErrors
 	return t
@@ -3,11 +3,13 @@ package clickhouse
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/parser"
 	"github.com/highlight-run/highlight/backend/parser/listener"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/util"
@@ -24,7 +26,7 @@ const TracesSamplingTable = "traces_sampling_new"
 const TraceKeysTable = "trace_keys"
 const TraceKeyValuesTable = "trace_key_values"
 
-var traceKeysToColumns = map[string]string{
+var traceKeysToColumns = withResourceFieldKeys(map[string]string{
 	string(modelInputs.ReservedTraceKeySecureSessionID): "SecureSessionId",
 	string(modelInputs.ReservedTraceKeySpanID):          "SpanId",
 	string(modelInputs.ReservedTraceKeyTraceID):         "TraceId",
@@ -41,9 +43,9 @@ var traceKeysToColumns = map[string]string{
 	string(modelInputs.ReservedTraceKeyHasErrors):       "HasErrors",
 	string(modelInputs.ReservedTraceKeyTimestamp):       "Timestamp",
 	string(modelInputs.ReservedTraceKeyHighlightType):   "HighlightType",
-}
+})
 
-var traceColumns = []string{
+var traceColumns = append([]string{
 	"Timestamp",
 	"UUID",
 	"TraceId",
@@ -65,7 +67,7 @@ var traceColumns = []string{
 	"Events.Timestamp",
 	"Events.Name",
 	"Events.Attributes",
-}
+}, resourceFieldColumns...)
 
 var selectTraceColumns = strings.Join(traceColumns, ", ")
 
@@ -81,37 +83,40 @@ var defaultTraceKeys = []*modelInputs.QueryKey{
 	{Name: string(modelInputs.ReservedTraceKeyMetricValue), Type: modelInputs.KeyTypeNumeric},
 }
 
-var reservedTraceKeys = lo.Map(modelInputs.AllReservedTraceKey, func(key modelInputs.ReservedTraceKey, _ int) string {
+var reservedTraceKeys = append(lo.Map(modelInputs.AllReservedTraceKey, func(key modelInputs.ReservedTraceKey, _ int) string {
 	return string(key)
-})
+}), resourceFieldReservedKeys...)
 
 var TracesTableNoDefaultConfig = model.TableConfig{
-	TableName:        TracesTable,
-	KeysToColumns:    traceKeysToColumns,
-	ReservedKeys:     reservedTraceKeys,
-	BodyColumn:       "SpanName",
-	AttributesColumn: "TraceAttributes",
-	SelectColumns:    traceColumns,
+	TableName:          TracesTable,
+	KeysToColumns:      traceKeysToColumns,
+	ReservedKeys:       reservedTraceKeys,
+	BodyColumn:         "SpanName",
+	AttributesColumn:   "TraceAttributes",
+	SelectColumns:      traceColumns,
+	SampleFactorColumn: pointy.String("SampleFactor"),
 }
 
 var TracesTableConfig = model.TableConfig{
-	TableName:        TracesTableNoDefaultConfig.TableName,
-	KeysToColumns:    TracesTableNoDefaultConfig.KeysToColumns,
-	ReservedKeys:     TracesTableNoDefaultConfig.ReservedKeys,
-	BodyColumn:       TracesTableNoDefaultConfig.BodyColumn,
-	AttributesColumn: TracesTableNoDefaultConfig.AttributesColumn,
-	SelectColumns:    TracesTableNoDefaultConfig.SelectColumns,
-	DefaultFilter:    fmt.Sprintf("%s!=%s %s!=%s", modelInputs.ReservedTraceKeySpanName, highlight.MetricSpanName, modelInputs.ReservedTraceKeyHighlightType, highlight.TraceTypeHighlightInternal),
+	TableName:          TracesTableNoDefaultConfig.TableName,
+	KeysToColumns:      TracesTableNoDefaultConfig.KeysToColumns,
+	ReservedKeys:       TracesTableNoDefaultConfig.ReservedKeys,
+	BodyColumn:         TracesTableNoDefaultConfig.BodyColumn,
+	AttributesColumn:   TracesTableNoDefaultConfig.AttributesColumn,
+	SelectColumns:      TracesTableNoDefaultConfig.SelectColumns,
+	SampleFactorColumn: TracesTableNoDefaultConfig.SampleFactorColumn,
+	DefaultFilter:      fmt.Sprintf("%s!=%s %s!=%s", modelInputs.ReservedTraceKeySpanName, highlight.MetricSpanName, modelInputs.ReservedTraceKeyHighlightType, highlight.TraceTypeHighlightInternal),
 }
 
 var tracesSamplingTableConfig = model.TableConfig{
-	TableName:        fmt.Sprintf("%s SAMPLE %d", TracesSamplingTable, SamplingRows),
-	BodyColumn:       "SpanName",
-	KeysToColumns:    traceKeysToColumns,
-	ReservedKeys:     reservedTraceKeys,
-	AttributesColumn: "TraceAttributes",
-	SelectColumns:    traceColumns,
-	DefaultFilter:    fmt.Sprintf("%s!=%s %s!=%s", modelInputs.ReservedTraceKeySpanName, highlight.MetricSpanName, modelInputs.ReservedTraceKeyHighlightType, highlight.TraceTypeHighlightInternal),
+	TableName:          fmt.Sprintf("%s SAMPLE %d", TracesSamplingTable, SamplingRows),
+	BodyColumn:         "SpanName",
+	KeysToColumns:      traceKeysToColumns,
+	ReservedKeys:       reservedTraceKeys,
+	AttributesColumn:   "TraceAttributes",
+	SelectColumns:      traceColumns,
+	SampleFactorColumn: pointy.String("SampleFactor"),
+	DefaultFilter:      fmt.Sprintf("%s!=%s %s!=%s", modelInputs.ReservedTraceKeySpanName, highlight.MetricSpanName, modelInputs.ReservedTraceKeyHighlightType, highlight.TraceTypeHighlightInternal),
 }
 
 var TracesSampleableTableConfig = SampleableTableConfig{
@@ -141,6 +146,7 @@ type ClickhouseTraceRow struct {
 	StatusMessage    string
 	Environment      string
 	HasErrors        bool
+	SampleFactor     float64
 	EventsTimestamp  []time.Time         `json:"Events.Timestamp" ch:"Events.Timestamp"`
 	EventsName       []string            `json:"Events.Name" ch:"Events.Name"`
 	EventsAttributes []map[string]string `json:"Events.Attributes" ch:"Events.Attributes"`
@@ -148,12 +154,19 @@ type ClickhouseTraceRow struct {
 	LinksSpanId      []string            `json:"Links.SpanId" ch:"Links.SpanId"`
 	LinksTraceState  []string            `json:"Links.TraceState" ch:"Links.TraceState"`
 	LinksAttributes  []map[string]string `json:"Links.Attributes" ch:"Links.Attributes"`
+	ResourceFields
 }
 
 func ConvertTraceRow(traceRow *TraceRow) *ClickhouseTraceRow {
 	traceTimes, traceNames, traceAttrs := convertEvents(traceRow)
 	linkTraceIds, linkSpanIds, linkStates, linkAttrs := convertLinks(traceRow)
 
+	// spans queued before the sample factor was recorded were not sampled
+	sampleFactor := traceRow.SampleFactor
+	if sampleFactor == 0 {
+		sampleFactor = 1
+	}
+
 	return &ClickhouseTraceRow{
 		Timestamp:        traceRow.Timestamp,
 		UUID:             traceRow.UUID,
@@ -173,6 +186,7 @@ func ConvertTraceRow(traceRow *TraceRow) *ClickhouseTraceRow {
 		StatusMessage:    traceRow.StatusMessage,
 		Environment:      traceRow.Environment,
 		HasErrors:        traceRow.HasErrors,
+		SampleFactor:     sampleFactor,
 		EventsTimestamp:  traceTimes,
 		EventsName:       traceNames,
 		EventsAttributes: traceAttrs,
@@ -180,6 +194,7 @@ func ConvertTraceRow(traceRow *TraceRow) *ClickhouseTraceRow {
 		LinksSpanId:      linkSpanIds,
 		LinksTraceState:  linkStates,
 		LinksAttributes:  linkAttrs,
+		ResourceFields:   traceRow.ResourceFields,
 	}
 }
 
@@ -252,26 +267,7 @@ func (client *Client) ReadTraces(ctx context.Context, projectID int, params mode
 
 		return &Edge[modelInputs.Trace]{
 			Cursor: encodeCursor(result.Timestamp, result.UUID),
-			Node: &modelInputs.Trace{
-				Timestamp:       result.Timestamp,
-				TraceID:         result.TraceId,
-				SpanID:          result.SpanId,
-				ParentSpanID:    result.ParentSpanId,
-				ProjectID:       int(result.ProjectId),
-				SecureSessionID: result.SecureSessionId,
-				TraceState:      result.TraceState,
-				SpanName:        result.SpanName,
-				SpanKind:        result.SpanKind,
-				Duration:        int(result.Duration),
-				ServiceName:     result.ServiceName,
-				ServiceVersion:  result.ServiceVersion,
-				Environment:     result.Environment,
-				HasErrors:       result.HasErrors,
-				TraceAttributes: expandJSON(result.TraceAttributes),
-				StatusCode:      result.StatusCode,
-				StatusMessage:   result.StatusMessage,
-				Events:          extractEvents(result),
-			},
+			Node:   getTrace(result),
 		}, nil
 	}
 
@@ -362,7 +358,7 @@ func getTracesFromRows(rows driver.Rows) ([]*modelInputs.Trace, error) {
 			ServiceVersion:  result.ServiceVersion,
 			Environment:     result.Environment,
 			HasErrors:       result.HasErrors,
-			TraceAttributes: expandJSON(result.TraceAttributes),
+			TraceAttributes: expandJSON(withResourceAttributes(result.TraceAttributes, result.ResourceFields)),
 			StatusCode:      result.StatusCode,
 			StatusMessage:   result.StatusMessage,
 			Events:          extractEvents(result),
@@ -382,6 +378,34 @@ func getTracesFromRows(rows driver.Rows) ([]*modelInputs.Trace, error) {
 	return traces, nil
 }
 
+func getTrace(result ClickhouseTraceRow) *modelInputs.Trace {
+	return &modelInputs.Trace{
+		Timestamp:       result.Timestamp,
+		TraceID:         result.TraceId,
+		SpanID:          result.SpanId,
+		ParentSpanID:    result.ParentSpanId,
+		ProjectID:       int(result.ProjectId),
+		SecureSessionID: result.SecureSessionId,
+		TraceState:      result.TraceState,
+		SpanName:        result.SpanName,
+		SpanKind:        result.SpanKind,
+		Duration:        int(result.Duration),
+		ServiceName:     result.ServiceName,
+		ServiceVersion:  result.ServiceVersion,
+		Environment:     result.Environment,
+		HasErrors:       result.HasErrors,
+		TraceAttributes: expandJSON(withResourceAttributes(result.TraceAttributes, result.ResourceFields)),
+		StatusCode:      result.StatusCode,
+		StatusMessage:   result.StatusMessage,
+		Events:          extractEvents(result),
+	}
+}
+
+// GetTrace returns the graph model of a span that has not been written yet.
+func GetTrace(traceRow *TraceRow) *modelInputs.Trace {
+	return getTrace(*ConvertTraceRow(traceRow))
+}
+
 func extractEvents(result ClickhouseTraceRow) []*modelInputs.TraceEvent {
 	return lo.Map(result.EventsTimestamp, func(t time.Time, idx int) *modelInputs.TraceEvent {
 		return &modelInputs.TraceEvent{
@@ -511,6 +535,17 @@ func TraceMatchesQuery(trace *TraceRow, filters listener.Filters) bool {
 	return matchesQuery(trace, TracesTableConfig, filters, listener.OperatorAnd)
 }
 
+// CompileTraceQuery compiles a search query into a predicate on spans.
+func CompileTraceQuery(query string) Predicate[TraceRow] {
+	return compileQuery[TraceRow](TracesTableConfig, parser.Parse(query, TracesTableNoDefaultConfig))
+}
+
+// TraceValue returns the value of a search key for a span, such as span_name or a trace attribute.
+func TraceValue(trace *TraceRow, key string) string {
+	value, _ := getRowValue(reflect.ValueOf(*trace), TracesTableConfig, key)
+	return value
+}
+
 func (client *Client) TracesLogLines(ctx context.Context, projectID int, params modelInputs.QueryInput) ([]*modelInputs.LogLine, error) {
 	return logLines(ctx, client, TracesTableConfig, projectID, params)
 }
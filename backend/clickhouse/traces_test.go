@@ -45,6 +45,17 @@ func Test_TraceMatchesQuery(t *testing.T) {
 	assert.True(t, matches)
 }
 
+func Test_TraceValue(t *testing.T) {
+	trace := TraceRow{
+		SpanName:        "GET /orders",
+		ServiceName:     "api",
+		TraceAttributes: map[string]string{"http.route": "/orders"},
+	}
+	assert.Equal(t, "GET /orders", TraceValue(&trace, "span_name"))
+	assert.Equal(t, "api", TraceValue(&trace, "service_name"))
+	assert.Equal(t, "/orders", TraceValue(&trace, "http.route"))
+}
+
 func Test_TraceMatchesQuery_v2(t *testing.T) {
 	trace := TraceRow{}
 	filters := parser.Parse("span_name=fs* OR highlight.type=highlight.internal OR span_name=highlight-metric", TracesTableNoDefaultConfig)
@@ -81,6 +81,9 @@ type Configuration struct {
 	KafkaSASLPassword           string `mapstructure:"KAFKA_SASL_PASSWORD"`
 	KafkaSASLUsername           string `mapstructure:"KAFKA_SASL_USERNAME"`
 	KafkaServers                string `mapstructure:"KAFKA_SERVERS"`
+	KafkaSpoolDir               string `mapstructure:"KAFKA_SPOOL_DIR"`
+	KafkaSpoolDropPolicy        string `mapstructure:"KAFKA_SPOOL_DROP_POLICY"`
+	KafkaSpoolMaxBytes          string `mapstructure:"KAFKA_SPOOL_MAX_BYTES"`
 	KafkaTopic                  string `mapstructure:"KAFKA_TOPIC"`
 	LandingStagingURL           string `mapstructure:"LANDING_PAGE_STAGING_URI"`
 	LicenseKey                  string `mapstructure:"LICENSE_KEY"`
@@ -94,6 +97,7 @@ type Configuration struct {
 	OAuthRedirectUrl            string `mapstructure:"OAUTH_REDIRECT_URL"`
 	OTLPDogfoodEndpoint         string `mapstructure:"OTLP_DOGFOOD_ENDPOINT"`
 	OTLPEndpoint                string `mapstructure:"OTLP_ENDPOINT"`
+	OTLPGrpcPort                string `mapstructure:"OTLP_GRPC_PORT"`
 	ObjectStorageFS             string `mapstructure:"OBJECT_STORAGE_FS"`
 	OnPrem                      string `mapstructure:"ON_PREM"`
 	OpenAIApiKey                string `mapstructure:"OPENAI_API_KEY"`
@@ -118,10 +122,16 @@ type Configuration struct {
 	SlackClientSecret           string `mapstructure:"SLACK_CLIENT_SECRET"`
 	SlackSigningSecret          string `mapstructure:"SLACK_SIGNING_SECRET"`
 	SlackTestAccessToken        string `mapstructure:"TEST_SLACK_ACCESS_TOKEN"`
+	StatsDPort                  string `mapstructure:"STATSD_PORT"`
+	StatsDProject               string `mapstructure:"STATSD_PROJECT"`
 	StripeApiKey                string `mapstructure:"STRIPE_API_KEY"`
 	StripeErrorsProductID       string `mapstructure:"STRIPE_ERRORS_PRODUCT_ID"`
 	StripeSessionsProductID     string `mapstructure:"STRIPE_SESSIONS_PRODUCT_ID"`
 	StripeWebhookSecret         string `mapstructure:"STRIPE_WEBHOOK_SECRET"`
+	SyslogPorts                 string `mapstructure:"SYSLOG_PORTS"`
+	SyslogTLSCertFile           string `mapstructure:"SYSLOG_TLS_CERT_FILE"`
+	SyslogTLSKeyFile            string `mapstructure:"SYSLOG_TLS_KEY_FILE"`
+	SyslogTLSPorts              string `mapstructure:"SYSLOG_TLS_PORTS"`
 	VercelClientId              string `mapstructure:"VERCEL_CLIENT_ID"`
 	VercelClientSecret          string `mapstructure:"VERCEL_CLIENT_SECRET"`
 	Version                     string `mapstructure:"REACT_APP_COMMIT_SHA"`
@@ -230,3 +240,12 @@ func ConsumerSpanSamplingRate() float64 {
 	}
 	return 1. / float64(i)
 }
+
+// KafkaSpoolMaxBytes is the maximum size of the spool of a kafka topic, defaulting to 1 GiB.
+func KafkaSpoolMaxBytes() int64 {
+	i, err := strconv.ParseInt(Config.KafkaSpoolMaxBytes, 10, 64)
+	if err != nil || i <= 0 {
+		i = 1 << 30
+	}
+	return i
+}
@@ -0,0 +1,15 @@
+package http
+
+// message fields of structured logs, following the elastic common schema and logstash conventions
+var logMessageFields = []string{"message", "msg", "log"}
+
+// popLogAttribute removes the first of the fields present in the log attributes and returns its value.
+func popLogAttribute(attributes map[string]string, fields []string) string {
+	for _, field := range fields {
+		if v, ok := attributes[field]; ok {
+			delete(attributes, field)
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,462 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+	"github.com/influxdata/go-syslog/v3"
+	"github.com/influxdata/go-syslog/v3/rfc3164"
+	"github.com/influxdata/go-syslog/v3/rfc5424"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// cloud providers recorded on the logs of each platform log drain
+const (
+	FlyCloudProvider     = "fly_io"
+	RenderCloudProvider  = "render"
+	RailwayCloudProvider = "railway"
+	NetlifyCloudProvider = "netlify"
+)
+
+// maxLogDrainBodyBytes bounds the decompressed body of a log drain request.
+const maxLogDrainBodyBytes = 64 * 1024 * 1024
+
+// drainLog is a log unwrapped from the envelope of a platform log drain.
+type drainLog struct {
+	Message    string
+	Level      string
+	Timestamp  time.Time
+	Service    string
+	Instance   string
+	Region     string
+	Attributes map[string]string
+}
+
+// getLog converts a drain log into a highlight log. The service name of the envelope takes precedence
+// over the one of the drain url, since a single drain may ship the logs of many services.
+func (d *drainLog) getLog(provider, serviceName string) hlog.Log {
+	lg := hlog.Log{
+		Message:    d.Message,
+		Level:      getDrainLevel(d.Level),
+		Timestamp:  d.Timestamp.UTC().Format(hlog.TimestampFormatNano),
+		Attributes: map[string]string{},
+	}
+	if d.Timestamp.IsZero() {
+		lg.Timestamp = time.Now().UTC().Format(hlog.TimestampFormatNano)
+	}
+	for k, v := range d.Attributes {
+		lg.Attributes[k] = v
+	}
+
+	lg.Attributes[string(semconv.CloudProviderKey)] = provider
+	if d.Service != "" {
+		serviceName = d.Service
+	}
+	if serviceName != "" {
+		lg.Attributes[string(semconv.ServiceNameKey)] = serviceName
+	}
+	if d.Instance != "" {
+		lg.Attributes[string(semconv.ServiceInstanceIDKey)] = d.Instance
+	}
+	if d.Region != "" {
+		lg.Attributes[string(semconv.CloudRegionKey)] = d.Region
+	}
+	return lg
+}
+
+// getDrainLevel normalizes the level names used by the platforms to the highlight log levels.
+func getDrainLevel(level string) string {
+	switch level = strings.ToLower(strings.TrimSpace(level)); level {
+	case "":
+		return model.LogLevelInfo.String()
+	case "warning":
+		return model.LogLevelWarn.String()
+	case "err":
+		return model.LogLevelError.String()
+	case "critical", "crit", "emergency", "alert":
+		return model.LogLevelFatal.String()
+	}
+	return level
+}
+
+// getDrainAttributes flattens the fields of a json record that are not part of its envelope into log attributes.
+func getDrainAttributes(record []byte, envelope ...string) (map[string]string, error) {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(record, &fields); err != nil {
+		return nil, err
+	}
+	for _, k := range envelope {
+		delete(fields, k)
+	}
+	attrs := map[string]string{}
+	for k, v := range fields {
+		for key, value := range hlog.FormatLogAttributes(k, v) {
+			attrs[key] = value
+		}
+	}
+	return attrs, nil
+}
+
+// getDrainRecords splits a body of newline delimited json objects or a json array of objects into its records.
+func getDrainRecords(body []byte) ([][]byte, error) {
+	var records [][]byte
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	for {
+		var record json.RawMessage
+		if err := decoder.Decode(&record); err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(record, []byte("[")) {
+			var batch []json.RawMessage
+			if err := json.Unmarshal(record, &batch); err != nil {
+				return nil, err
+			}
+			for _, item := range batch {
+				records = append(records, item)
+			}
+			continue
+		}
+		records = append(records, record)
+	}
+}
+
+// getDrainLines splits a body of syslog messages into its lines.
+func getDrainLines(body []byte) ([][]byte, error) {
+	var lines [][]byte
+	for _, line := range bytes.Split(body, []byte("\n")) {
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// the envelope of the fly.io log shipper, ie.
+// {"event":{"provider":"app"},"fly":{"app":{"instance":"4d891d77b05118","name":"restless-moon-8905"},"region":"lax"},"host":"f442","log":{"level":"info"},"message":"...","timestamp":"2023-06-27T01:19:11.789045558Z"}
+type flyLog struct {
+	Fly struct {
+		App struct {
+			Instance string `json:"instance"`
+			Name     string `json:"name"`
+		} `json:"app"`
+		Region string `json:"region"`
+	} `json:"fly"`
+	Log struct {
+		Level string `json:"level"`
+	} `json:"log"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func getFlyLog(record []byte) (*drainLog, error) {
+	var lg flyLog
+	if err := json.Unmarshal(record, &lg); err != nil {
+		return nil, err
+	}
+	attrs, err := getDrainAttributes(record, "fly", "log", "message", "timestamp")
+	if err != nil {
+		return nil, err
+	}
+	return &drainLog{
+		Message:    lg.Message,
+		Level:      lg.Log.Level,
+		Timestamp:  lg.Timestamp,
+		Service:    lg.Fly.App.Name,
+		Instance:   lg.Fly.App.Instance,
+		Region:     lg.Fly.Region,
+		Attributes: attrs,
+	}, nil
+}
+
+// syslogLevels maps the syslog severities to the highlight log levels.
+var syslogLevels = []model.LogLevel{
+	model.LogLevelFatal,
+	model.LogLevelFatal,
+	model.LogLevelFatal,
+	model.LogLevelError,
+	model.LogLevelWarn,
+	model.LogLevelInfo,
+	model.LogLevelInfo,
+	model.LogLevelDebug,
+}
+
+func getSyslogBaseLog(msg *syslog.Base) *drainLog {
+	lg := drainLog{Attributes: map[string]string{}}
+	if msg.Message != nil {
+		lg.Message = *msg.Message
+	}
+	if msg.Severity != nil && int(*msg.Severity) < len(syslogLevels) {
+		lg.Level = syslogLevels[*msg.Severity].String()
+	}
+	if msg.Timestamp != nil {
+		lg.Timestamp = *msg.Timestamp
+	}
+	if msg.Appname != nil {
+		lg.Service = *msg.Appname
+	}
+	if msg.ProcID != nil {
+		lg.Instance = *msg.ProcID
+	}
+	if msg.Hostname != nil {
+		lg.Attributes["hostname"] = *msg.Hostname
+	}
+	return &lg
+}
+
+// getRenderLog parses a render syslog line, ie.
+// <134>1 2023-07-27T05:43:22.401882Z srv-cj2hc3tph6eg6k05q5v0 my-web-service 5b5f8c74b-x2vxs web - GET /health 200
+// where the app name is the service and the proc id is the instance of the service.
+func getRenderLog(record []byte) (*drainLog, error) {
+	// drop the octet count framing or the stream token preceding the priority
+	if i := bytes.IndexByte(record, '<'); i > 0 && !bytes.ContainsRune(bytes.TrimSpace(record[:i]), ' ') {
+		record = record[i:]
+	}
+
+	message, err := rfc5424.NewParser(rfc5424.WithBestEffort()).Parse(record)
+	if msg, ok := message.(*rfc5424.SyslogMessage); err == nil && ok {
+		lg := getSyslogBaseLog(&msg.Base)
+		if msg.MsgID != nil {
+			lg.Attributes["msg_id"] = *msg.MsgID
+		}
+		if msg.StructuredData != nil {
+			for id, params := range *msg.StructuredData {
+				for k, v := range params {
+					if k == "region" {
+						lg.Region = v
+						continue
+					}
+					lg.Attributes[id+"."+k] = v
+				}
+			}
+		}
+		return lg, nil
+	}
+
+	message, err = rfc3164.NewParser(rfc3164.WithBestEffort(), rfc3164.WithYear(rfc3164.CurrentYear{})).Parse(record)
+	if msg, ok := message.(*rfc3164.SyslogMessage); err == nil && ok {
+		return getSyslogBaseLog(&msg.Base), nil
+	}
+
+	// keep lines that are not syslog rather than dropping the batch
+	return &drainLog{Message: string(record)}, nil
+}
+
+// railwayAttributes are the attributes of a railway log, either an object
+// or the key value list returned by the railway api with json encoded values.
+type railwayAttributes map[string]interface{}
+
+func (a *railwayAttributes) UnmarshalJSON(b []byte) error {
+	var kvs []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(b, &kvs); err != nil {
+		return json.Unmarshal(b, (*map[string]interface{})(a))
+	}
+	*a = railwayAttributes{}
+	for _, kv := range kvs {
+		var value interface{}
+		if err := json.Unmarshal([]byte(kv.Value), &value); err != nil {
+			value = kv.Value
+		}
+		(*a)[kv.Key] = value
+	}
+	return nil
+}
+
+// the envelope of a railway log, ie.
+// {"message":"...","severity":"error","timestamp":"2024-03-01T17:02:11.482Z","attributes":[{"key":"path","value":"\"/health\""}],"tags":{"serviceName":"api","environmentName":"production","deploymentInstanceId":"...","region":"us-west1"}}
+type railwayLog struct {
+	Message    string            `json:"message"`
+	Severity   string            `json:"severity"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Attributes railwayAttributes `json:"attributes"`
+	Tags       map[string]string `json:"tags"`
+}
+
+// railway tags unwrapped into the envelope of the log
+const (
+	railwayServiceNameTag = "serviceName"
+	railwayServiceIDTag   = "serviceId"
+	railwayInstanceTag    = "deploymentInstanceId"
+	railwayRegionTag      = "region"
+	railwayEnvironmentTag = "environmentName"
+)
+
+// other railway tags are recorded as attributes under this prefix
+const railwayTagAttributePrefix = "railway."
+
+func getRailwayLog(record []byte) (*drainLog, error) {
+	var lg railwayLog
+	if err := json.Unmarshal(record, &lg); err != nil {
+		return nil, err
+	}
+
+	dl := drainLog{
+		Message:    lg.Message,
+		Level:      lg.Severity,
+		Timestamp:  lg.Timestamp,
+		Service:    lg.Tags[railwayServiceNameTag],
+		Instance:   lg.Tags[railwayInstanceTag],
+		Region:     lg.Tags[railwayRegionTag],
+		Attributes: map[string]string{},
+	}
+	if dl.Service == "" {
+		dl.Service = lg.Tags[railwayServiceIDTag]
+	}
+	if env := lg.Tags[railwayEnvironmentTag]; env != "" {
+		dl.Attributes[string(semconv.DeploymentEnvironmentKey)] = env
+	}
+	for k, v := range lg.Tags {
+		switch k {
+		case railwayServiceNameTag, railwayInstanceTag, railwayRegionTag, railwayEnvironmentTag:
+			continue
+		}
+		dl.Attributes[railwayTagAttributePrefix+k] = v
+	}
+	for k, v := range lg.Attributes {
+		if level, ok := v.(string); ok && k == "level" {
+			dl.Level = level
+			continue
+		}
+		for key, value := range hlog.FormatLogAttributes(k, v) {
+			dl.Attributes[key] = value
+		}
+	}
+	return &dl, nil
+}
+
+// the envelope of a netlify log, ie.
+// {"log_type":"functions","deploy_id":"65e2...","function_name":"checkout","level":"ERROR","message":"...","request_id":"01HQ...","timestamp":"2024-03-01T17:02:11.482Z"}
+// traffic logs carry the request rather than a message.
+type netlifyLog struct {
+	LogType      string    `json:"log_type"`
+	DeployID     string    `json:"deploy_id"`
+	FunctionName string    `json:"function_name"`
+	Level        string    `json:"level"`
+	Message      string    `json:"message"`
+	Region       string    `json:"region"`
+	Timestamp    time.Time `json:"timestamp"`
+	Method       string    `json:"method"`
+	URL          string    `json:"url"`
+	StatusCode   int       `json:"status_code"`
+}
+
+func getNetlifyLog(record []byte) (*drainLog, error) {
+	var lg netlifyLog
+	if err := json.Unmarshal(record, &lg); err != nil {
+		return nil, err
+	}
+	attrs, err := getDrainAttributes(record, "deploy_id", "function_name", "level", "message", "region", "timestamp")
+	if err != nil {
+		return nil, err
+	}
+
+	dl := drainLog{
+		Message:    lg.Message,
+		Level:      lg.Level,
+		Timestamp:  lg.Timestamp,
+		Service:    lg.FunctionName,
+		Instance:   lg.DeployID,
+		Region:     lg.Region,
+		Attributes: attrs,
+	}
+	if dl.Message == "" && lg.Method != "" {
+		dl.Message = fmt.Sprintf("%s %s %d", lg.Method, lg.URL, lg.StatusCode)
+	}
+	if dl.Level == "" && lg.StatusCode >= http.StatusInternalServerError {
+		dl.Level = model.LogLevelError.String()
+	} else if dl.Level == "" && lg.StatusCode >= http.StatusBadRequest {
+		dl.Level = model.LogLevelWarn.String()
+	}
+	return &dl, nil
+}
+
+// handleLogDrain submits the logs of a platform log drain, where the project and an optional
+// fallback service name are set as query string parameters of the drain url.
+func handleLogDrain(w http.ResponseWriter, r *http.Request, provider string, getRecords func([]byte) ([][]byte, error), getDrainLog func([]byte) (*drainLog, error)) {
+	projectID, serviceName, err := getQueryStringParams(r)
+	if err != nil {
+		http.Error(w, "no project query string parameter provided", http.StatusBadRequest)
+		return
+	}
+
+	requestBody, err := getBody(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("provider", provider).Error("invalid log drain gzip")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, io.NopCloser(requestBody), maxLogDrainBodyBytes))
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("provider", provider).Error("invalid log drain body")
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	records, err := getRecords(body)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("provider", provider).Error("invalid log drain body")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// parse every record before submitting, so that an invalid record does not submit part of the batch
+	logs := make([]hlog.Log, 0, len(records))
+	for _, record := range records {
+		lg, err := getDrainLog(record)
+		if err != nil {
+			log.WithContext(r.Context()).WithError(err).WithField("provider", provider).Error("invalid log drain record")
+			http.Error(w, e.Wrap(err, "invalid log drain record").Error(), http.StatusBadRequest)
+			return
+		}
+		logs = append(logs, lg.getLog(provider, serviceName))
+	}
+
+	for _, lg := range logs {
+		if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, lg); err != nil {
+			log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// HandleFlyLogs ingests the ndjson batches of the fly.io log shipper.
+func HandleFlyLogs(w http.ResponseWriter, r *http.Request) {
+	handleLogDrain(w, r, FlyCloudProvider, getDrainRecords, getFlyLog)
+}
+
+// HandleRenderLogs ingests the syslog lines of a render log stream.
+func HandleRenderLogs(w http.ResponseWriter, r *http.Request) {
+	handleLogDrain(w, r, RenderCloudProvider, getDrainLines, getRenderLog)
+}
+
+// HandleRailwayLogs ingests railway logs, sent as ndjson or a json array.
+func HandleRailwayLogs(w http.ResponseWriter, r *http.Request) {
+	handleLogDrain(w, r, RailwayCloudProvider, getDrainRecords, getRailwayLog)
+}
+
+// HandleNetlifyLogs ingests the json or ndjson batches of a netlify log drain.
+func HandleNetlifyLogs(w http.ResponseWriter, r *http.Request) {
+	handleLogDrain(w, r, NetlifyCloudProvider, getDrainRecords, getNetlifyLog)
+}
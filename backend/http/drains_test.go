@@ -0,0 +1,172 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const RenderSyslog = `<134>1 2023-07-27T05:43:22.401882Z srv-cj2hc3tph6eg6k05q5v0 my-web-service 5b5f8c74b-x2vxs web - GET /health 200
+1jdkoe52 <131>1 2023-07-27T05:43:23.000000Z srv-cj2hc3tph6eg6k05q5v0 my-web-service 5b5f8c74b-x2vxs web [render@53479 region="oregon"] connection refused
+`
+
+const RailwayJson = `[{"message":"request failed","severity":"info","timestamp":"2024-03-01T17:02:11.482Z","attributes":[{"key":"level","value":"\"error\""},{"key":"status","value":"502"}],"tags":{"serviceName":"api","serviceId":"7c1b","environmentName":"production","deploymentInstanceId":"f3a9e1","region":"us-west1"}},
+{"message":"listening on :8080","severity":"info","timestamp":"2024-03-01T17:02:12Z","tags":{"serviceId":"7c1b"}}]`
+
+const NetlifyNDJson = `{"log_type":"functions","deploy_id":"65e2a1","function_name":"checkout","level":"ERROR","message":"payment declined","request_id":"01HQ","timestamp":"2024-03-01T17:02:11.482Z"}
+{"log_type":"traffic","deploy_id":"65e2a1","method":"GET","url":"/missing","status_code":404,"client_ip":"10.0.0.1","timestamp":"2024-03-01T17:02:12Z"}`
+
+func TestGetDrainRecords(t *testing.T) {
+	records, err := getDrainRecords([]byte(FlyNDJson))
+	assert.NoError(t, err)
+	assert.Len(t, records, strings.Count(strings.TrimSpace(FlyNDJson), "\n")+1)
+
+	records, err = getDrainRecords([]byte(RailwayJson))
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	_, err = getDrainRecords([]byte(`{"message":`))
+	assert.Error(t, err)
+}
+
+func TestGetFlyLog(t *testing.T) {
+	records, err := getDrainRecords([]byte(FlyNDJson))
+	assert.NoError(t, err)
+	dl, err := getFlyLog(records[0])
+	assert.NoError(t, err)
+
+	lg := dl.getLog(FlyCloudProvider, "")
+	assert.True(t, strings.HasPrefix(lg.Message, "2023-06-27T01:19:11.788889Z ERROR sink"))
+	assert.Equal(t, "info", lg.Level)
+	assert.Equal(t, "2023-06-27T01:19:11.789045558Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{
+		"service.name":        "restless-moon-8905",
+		"service.instance.id": "4d891d77b05118",
+		"cloud.region":        "lax",
+		"cloud.provider":      "fly_io",
+		"host":                "f442",
+		"event.provider":      "app",
+	}, lg.Attributes)
+}
+
+func TestGetRenderLog(t *testing.T) {
+	lines, err := getDrainLines([]byte(RenderSyslog))
+	assert.NoError(t, err)
+	assert.Len(t, lines, 2)
+
+	dl, err := getRenderLog(lines[0])
+	assert.NoError(t, err)
+	lg := dl.getLog(RenderCloudProvider, "")
+	assert.Equal(t, "GET /health 200", lg.Message)
+	assert.Equal(t, "info", lg.Level)
+	assert.Equal(t, "2023-07-27T05:43:22.401882Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{
+		"service.name":        "my-web-service",
+		"service.instance.id": "5b5f8c74b-x2vxs",
+		"cloud.provider":      "render",
+		"hostname":            "srv-cj2hc3tph6eg6k05q5v0",
+		"msg_id":              "web",
+	}, lg.Attributes)
+
+	dl, err = getRenderLog(lines[1])
+	assert.NoError(t, err)
+	lg = dl.getLog(RenderCloudProvider, "")
+	assert.Equal(t, "connection refused", lg.Message)
+	assert.Equal(t, "error", lg.Level)
+	assert.Equal(t, "oregon", lg.Attributes["cloud.region"])
+
+	dl, err = getRenderLog([]byte("not a <syslog> line"))
+	assert.NoError(t, err)
+	assert.Equal(t, "not a <syslog> line", dl.Message)
+}
+
+func TestGetRailwayLog(t *testing.T) {
+	records, err := getDrainRecords([]byte(RailwayJson))
+	assert.NoError(t, err)
+
+	dl, err := getRailwayLog(records[0])
+	assert.NoError(t, err)
+	lg := dl.getLog(RailwayCloudProvider, "")
+	assert.Equal(t, "request failed", lg.Message)
+	assert.Equal(t, "error", lg.Level)
+	assert.Equal(t, "2024-03-01T17:02:11.482Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{
+		"service.name":           "api",
+		"service.instance.id":    "f3a9e1",
+		"cloud.region":           "us-west1",
+		"cloud.provider":         "railway",
+		"deployment.environment": "production",
+		"railway.serviceId":      "7c1b",
+		"status":                 "502",
+	}, lg.Attributes)
+
+	dl, err = getRailwayLog(records[1])
+	assert.NoError(t, err)
+	lg = dl.getLog(RailwayCloudProvider, "backend")
+	assert.Equal(t, "7c1b", lg.Attributes["service.name"])
+}
+
+func TestGetNetlifyLog(t *testing.T) {
+	records, err := getDrainRecords([]byte(NetlifyNDJson))
+	assert.NoError(t, err)
+
+	dl, err := getNetlifyLog(records[0])
+	assert.NoError(t, err)
+	lg := dl.getLog(NetlifyCloudProvider, "storefront")
+	assert.Equal(t, "payment declined", lg.Message)
+	assert.Equal(t, "error", lg.Level)
+	assert.Equal(t, map[string]string{
+		"service.name":        "checkout",
+		"service.instance.id": "65e2a1",
+		"cloud.provider":      "netlify",
+		"log_type":            "functions",
+		"request_id":          "01HQ",
+	}, lg.Attributes)
+
+	dl, err = getNetlifyLog(records[1])
+	assert.NoError(t, err)
+	lg = dl.getLog(NetlifyCloudProvider, "storefront")
+	assert.Equal(t, "GET /missing 404", lg.Message)
+	assert.Equal(t, "warn", lg.Level)
+	assert.Equal(t, "storefront", lg.Attributes["service.name"])
+	assert.Equal(t, "10.0.0.1", lg.Attributes["client_ip"])
+}
+
+func TestHandleLogDrains(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		handler http.HandlerFunc
+		body    string
+	}{
+		{"fly", HandleFlyLogs, FlyNDJson},
+		{"render", HandleRenderLogs, RenderSyslog},
+		{"railway", HandleRailwayLogs, RailwayJson},
+		{"netlify", HandleNetlifyLogs, NetlifyNDJson},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/v1/logs/"+tc.name+"?project=1", strings.NewReader(tc.body))
+			w := httptest.NewRecorder()
+			tc.handler(w, r)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			r = httptest.NewRequest(http.MethodPost, "/v1/logs/"+tc.name, strings.NewReader(tc.body))
+			w = httptest.NewRecorder()
+			tc.handler(w, r)
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/logs/fly?project=1", strings.NewReader(`{"fly":`))
+	w := httptest.NewRecorder()
+	HandleFlyLogs(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	r = httptest.NewRequest(http.MethodPost, "/v1/logs/fly?project=1", bytes.NewReader(make([]byte, maxLogDrainBodyBytes+1)))
+	w = httptest.NewRecorder()
+	HandleFlyLogs(w, r)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
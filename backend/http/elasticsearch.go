@@ -0,0 +1,206 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ElasticsearchVersion is the version reported to shippers probing the cluster, which pick their request format from it.
+const ElasticsearchVersion = "8.11.0"
+
+const (
+	ElasticsearchIndexAttribute = "index"
+	elasticsearchProductHeader  = "X-Elastic-Product"
+)
+
+// document fields following the elastic common schema and logstash conventions
+var (
+	elasticsearchTimestampFields = []string{"@timestamp", "timestamp", "time"}
+	elasticsearchLevelFields     = []string{"level", "log.level", "severity"}
+)
+
+type elasticsearchError struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type elasticsearchBulkItem struct {
+	Index  string              `json:"_index"`
+	ID     string              `json:"_id"`
+	Status int                 `json:"status"`
+	Result string              `json:"result,omitempty"`
+	Error  *elasticsearchError `json:"error,omitempty"`
+}
+
+type elasticsearchBulkResponse struct {
+	Took   int64                              `json:"took"`
+	Errors bool                               `json:"errors"`
+	Items  []map[string]elasticsearchBulkItem `json:"items"`
+}
+
+// getElasticsearchProjectID resolves the project of the request from the project header, the query string
+// parameter or the username of the basic auth credentials, which every elasticsearch output supports.
+func getElasticsearchProjectID(r *http.Request) (int, error) {
+	projectVerboseID := r.Header.Get(LogDrainProjectHeader)
+	if projectVerboseID == "" {
+		projectVerboseID = r.URL.Query().Get(LogDrainProjectQueryParam)
+	}
+	if projectVerboseID == "" {
+		projectVerboseID, _, _ = r.BasicAuth()
+	}
+	if projectVerboseID == "" {
+		return 0, errors.New("no highlight project provided via header, query string parameter or basic auth")
+	}
+	return model2.FromVerboseID(projectVerboseID)
+}
+
+// getElasticsearchLog converts a bulk document into a highlight log.
+func getElasticsearchLog(index string, doc map[string]interface{}) hlog.Log {
+	lg := hlog.Log{
+		Level:      model.LogLevelInfo.String(),
+		Attributes: map[string]string{},
+	}
+	for k, v := range doc {
+		for key, value := range hlog.FormatLogAttributes(k, v) {
+			lg.Attributes[key] = value
+		}
+	}
+
+	timestamp := time.Now()
+	if v := popLogAttribute(lg.Attributes, elasticsearchTimestampFields); v != "" {
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			timestamp = t
+		}
+	}
+	// epoch millis are formatted as numbers rather than strings
+	if ts, ok := doc["@timestamp"].(float64); ok {
+		timestamp = time.UnixMilli(int64(ts))
+	}
+	lg.Timestamp = timestamp.UTC().Format(hlog.TimestampFormatNano)
+	lg.Message = popLogAttribute(lg.Attributes, logMessageFields)
+	if level := popLogAttribute(lg.Attributes, elasticsearchLevelFields); level != "" {
+		lg.Level = strings.ToLower(level)
+	}
+	if index != "" {
+		lg.Attributes[ElasticsearchIndexAttribute] = index
+	}
+	return lg
+}
+
+// HandleElasticsearchBulk implements the elasticsearch _bulk api used by fluentd, logstash, vector and filebeat.
+// Documents of index and create actions are written as logs, while other actions are rejected. Each action
+// gets an elasticsearch shaped item response so that shippers only retry the documents that failed.
+func HandleElasticsearchBulk(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	w.Header().Set(elasticsearchProductHeader, "Elasticsearch")
+
+	projectID, err := getElasticsearchProjectID(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("failed to parse highlight project id from elasticsearch bulk request")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	requestBody, err := getBody(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid elasticsearch bulk gzip")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := elasticsearchBulkResponse{Items: []map[string]elasticsearchBulkItem{}}
+	defaultIndex := chi.URLParam(r, "index")
+	decoder := json.NewDecoder(requestBody)
+	for {
+		var action map[string]struct {
+			Index string `json:"_index"`
+			ID    string `json:"_id"`
+		}
+		if err := decoder.Decode(&action); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			log.WithContext(r.Context()).WithError(err).Error("invalid elasticsearch bulk action")
+			http.Error(w, e.Wrap(err, "invalid bulk action").Error(), http.StatusBadRequest)
+			return
+		}
+		if len(action) != 1 {
+			http.Error(w, "bulk actions must have exactly one operation", http.StatusBadRequest)
+			return
+		}
+
+		for op, meta := range action {
+			item := elasticsearchBulkItem{Index: meta.Index, ID: meta.ID}
+			if item.Index == "" {
+				item.Index = defaultIndex
+			}
+			if item.ID == "" {
+				item.ID = uuid.New().String()
+			}
+
+			// every action except delete is followed by a document
+			var doc map[string]interface{}
+			if op != "delete" {
+				if err := decoder.Decode(&doc); err != nil {
+					log.WithContext(r.Context()).WithError(err).Error("invalid elasticsearch bulk document")
+					http.Error(w, e.Wrap(err, "invalid bulk document").Error(), http.StatusBadRequest)
+					return
+				}
+			}
+
+			switch op {
+			case "index", "create":
+				if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, getElasticsearchLog(item.Index, doc)); err != nil {
+					log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+					// shippers retry documents rejected with a 429
+					item.Status = http.StatusTooManyRequests
+					item.Error = &elasticsearchError{Type: "es_rejected_execution_exception", Reason: err.Error()}
+				} else {
+					item.Status = http.StatusCreated
+					item.Result = "created"
+				}
+			default:
+				item.Status = http.StatusBadRequest
+				item.Error = &elasticsearchError{Type: "illegal_argument_exception", Reason: "only index and create bulk actions are supported"}
+			}
+			resp.Errors = resp.Errors || item.Error != nil
+			resp.Items = append(resp.Items, map[string]elasticsearchBulkItem{op: item})
+		}
+	}
+
+	resp.Took = time.Since(start).Milliseconds()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// HandleElasticsearchInfo responds to the version probe shippers send before writing to the cluster.
+func HandleElasticsearchInfo(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(elasticsearchProductHeader, "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"name":         "highlight",
+		"cluster_name": "highlight",
+		"version": map[string]string{
+			"number":                              ElasticsearchVersion,
+			"build_flavor":                        "default",
+			"minimum_wire_compatibility_version":  "7.17.0",
+			"minimum_index_compatibility_version": "7.0.0",
+		},
+		"tagline": "You Know, for Search",
+	})
+}
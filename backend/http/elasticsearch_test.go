@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+)
+
+const ElasticsearchBulkNDJson = `{"index":{"_index":"logs-api","_id":"1"}}
+{"@timestamp":"2024-10-07T22:18:32.123Z","message":"GET /users 200","log":{"level":"WARN"},"service":{"name":"api"},"http":{"status":200}}
+{"create":{}}
+{"@timestamp":1728339512123,"msg":"worker started"}
+{"delete":{"_index":"logs-api","_id":"1"}}
+{"update":{"_id":"2"}}
+{"doc":{"message":"updated"}}
+`
+
+func TestGetElasticsearchLog(t *testing.T) {
+	var doc map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"@timestamp":"2024-10-07T22:18:32.123Z","message":"GET /users 200","log":{"level":"WARN"},"service":{"name":"api"},"http":{"status":200}}`), &doc))
+	lg := getElasticsearchLog("logs-api", doc)
+	assert.Equal(t, "GET /users 200", lg.Message)
+	assert.Equal(t, "warn", lg.Level)
+	assert.Equal(t, "2024-10-07T22:18:32.123Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{"service.name": "api", "http.status": "200", "index": "logs-api"}, lg.Attributes)
+
+	doc = nil
+	assert.NoError(t, json.Unmarshal([]byte(`{"@timestamp":1728339512123,"msg":"worker started"}`), &doc))
+	lg = getElasticsearchLog("", doc)
+	assert.Equal(t, "worker started", lg.Message)
+	assert.Equal(t, "info", lg.Level)
+	assert.Equal(t, "2024-10-07T22:18:32.123Z", lg.Timestamp)
+}
+
+func TestHandleElasticsearchBulk(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/elasticsearch/logs-default/_bulk", strings.NewReader(ElasticsearchBulkNDJson))
+	r.Header.Set("Content-Type", "application/x-ndjson")
+	r.SetBasicAuth("1", "")
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("index", "logs-default")
+	r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+	w := httptest.NewRecorder()
+	HandleElasticsearchBulk(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "Elasticsearch", w.Header().Get("X-Elastic-Product"))
+
+	var resp elasticsearchBulkResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.True(t, resp.Errors)
+	assert.Len(t, resp.Items, 4)
+	assert.Equal(t, elasticsearchBulkItem{Index: "logs-api", ID: "1", Status: 201, Result: "created"}, resp.Items[0]["index"])
+	assert.Equal(t, "logs-default", resp.Items[1]["create"].Index)
+	assert.Equal(t, 201, resp.Items[1]["create"].Status)
+	assert.Equal(t, 400, resp.Items[2]["delete"].Status)
+	assert.Equal(t, 400, resp.Items[3]["update"].Status)
+	assert.Equal(t, "illegal_argument_exception", resp.Items[3]["update"].Error.Type)
+
+	r = httptest.NewRequest(http.MethodPost, "/elasticsearch/_bulk", strings.NewReader(ElasticsearchBulkNDJson))
+	w = httptest.NewRecorder()
+	HandleElasticsearchBulk(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r = httptest.NewRequest(http.MethodPost, "/elasticsearch/_bulk?project=1", strings.NewReader(`{"index":{}}`+"\n"+`{"message":`))
+	w = httptest.NewRecorder()
+	HandleElasticsearchBulk(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandleElasticsearchInfo(t *testing.T) {
+	w := httptest.NewRecorder()
+	HandleElasticsearchInfo(w, httptest.NewRequest(http.MethodGet, "/elasticsearch/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"number":"8.11.0"`)
+}
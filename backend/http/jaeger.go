@@ -0,0 +1,563 @@
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+const (
+	JaegerSpanKindTag = "span.kind"
+	// JaegerLogEventField is the log field holding the name of a span event
+	JaegerLogEventField = "event"
+)
+
+// thrift binary protocol field types
+const (
+	thriftStop   = 0
+	thriftBool   = 2
+	thriftByte   = 3
+	thriftDouble = 4
+	thriftI16    = 6
+	thriftI32    = 8
+	thriftI64    = 10
+	thriftString = 11
+	thriftStruct = 12
+	thriftMap    = 13
+	thriftSet    = 14
+	thriftList   = 15
+)
+
+// jaeger tag value types
+const (
+	jaegerTagString = 0
+	jaegerTagDouble = 1
+	jaegerTagBool   = 2
+	jaegerTagLong   = 3
+	jaegerTagBinary = 4
+)
+
+const jaegerRefFollowsFrom = 1
+
+// maxThriftContainerSize bounds the size of lists and strings read from a request.
+const maxThriftContainerSize = 16 * 1024 * 1024
+
+// maxThriftDepth bounds the nesting of skipped structs and containers.
+const maxThriftDepth = 64
+
+type jaegerTag struct {
+	Key   string
+	Value string
+}
+
+type jaegerLog struct {
+	Timestamp int64
+	Fields    []jaegerTag
+}
+
+type jaegerSpanRef struct {
+	RefType     int32
+	TraceIDLow  int64
+	TraceIDHigh int64
+	SpanID      int64
+}
+
+// jaegerSpan is a jaeger thrift span, with timestamps and durations in microseconds.
+type jaegerSpan struct {
+	TraceIDLow    int64
+	TraceIDHigh   int64
+	SpanID        int64
+	ParentSpanID  int64
+	OperationName string
+	References    []jaegerSpanRef
+	StartTime     int64
+	Duration      int64
+	Tags          []jaegerTag
+	Logs          []jaegerLog
+}
+
+type jaegerBatch struct {
+	ServiceName string
+	ProcessTags []jaegerTag
+	Spans       []jaegerSpan
+}
+
+// thriftReader decodes the thrift binary protocol used by jaeger clients to send batches over http.
+type thriftReader struct {
+	b []byte
+}
+
+func (t *thriftReader) next(n int) ([]byte, error) {
+	if n < 0 || n > len(t.b) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	v := t.b[:n]
+	t.b = t.b[n:]
+	return v, nil
+}
+
+func (t *thriftReader) byte() (byte, error) {
+	b, err := t.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (t *thriftReader) i16() (int16, error) {
+	b, err := t.next(2)
+	if err != nil {
+		return 0, err
+	}
+	return int16(binary.BigEndian.Uint16(b)), nil
+}
+
+func (t *thriftReader) i32() (int32, error) {
+	b, err := t.next(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(b)), nil
+}
+
+func (t *thriftReader) i64() (int64, error) {
+	b, err := t.next(8)
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b)), nil
+}
+
+func (t *thriftReader) double() (float64, error) {
+	v, err := t.i64()
+	return math.Float64frombits(uint64(v)), err
+}
+
+func (t *thriftReader) string() (string, error) {
+	n, err := t.i32()
+	if err != nil {
+		return "", err
+	}
+	if n > maxThriftContainerSize {
+		return "", e.Errorf("thrift string of %d bytes is too large", n)
+	}
+	b, err := t.next(int(n))
+	return string(b), err
+}
+
+// list reads the header of a list, returning the number of elements after checking their type.
+func (t *thriftReader) list(elemType byte) (int, error) {
+	typ, err := t.byte()
+	if err != nil {
+		return 0, err
+	}
+	if typ != elemType {
+		return 0, e.Errorf("invalid thrift list of type %d", typ)
+	}
+	return t.count()
+}
+
+// count reads the number of elements of a container. Every element takes at least a byte,
+// so counts over the bytes left in the request are rejected before reading any element.
+func (t *thriftReader) count() (int, error) {
+	n, err := t.i32()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 || n > maxThriftContainerSize || int(n) > len(t.b) {
+		return 0, e.Errorf("invalid thrift container of %d elements with %d bytes left", n, len(t.b))
+	}
+	return int(n), nil
+}
+
+// skip skips a value of the provided type, used for fields that are not decoded.
+func (t *thriftReader) skip(typ byte) error {
+	return t.skipValue(typ, 0)
+}
+
+func (t *thriftReader) skipValue(typ byte, depth int) error {
+	if depth > maxThriftDepth {
+		return e.Errorf("thrift value nested over %d levels", maxThriftDepth)
+	}
+	var err error
+	switch typ {
+	case thriftBool, thriftByte:
+		_, err = t.next(1)
+	case thriftI16:
+		_, err = t.next(2)
+	case thriftI32:
+		_, err = t.next(4)
+	case thriftDouble, thriftI64:
+		_, err = t.next(8)
+	case thriftString:
+		_, err = t.string()
+	case thriftStruct:
+		err = t.structure(func(_ int16, typ byte) error {
+			return t.skipValue(typ, depth+1)
+		})
+	case thriftMap:
+		var keyType, valueType byte
+		var n int
+		if keyType, err = t.byte(); err != nil {
+			return err
+		}
+		if valueType, err = t.byte(); err != nil {
+			return err
+		}
+		if n, err = t.count(); err != nil {
+			return err
+		}
+		for i := 0; i < n && err == nil; i++ {
+			if err = t.skipValue(keyType, depth+1); err == nil {
+				err = t.skipValue(valueType, depth+1)
+			}
+		}
+	case thriftSet, thriftList:
+		var elemType byte
+		var n int
+		if elemType, err = t.byte(); err != nil {
+			return err
+		}
+		if n, err = t.count(); err != nil {
+			return err
+		}
+		for i := 0; i < n && err == nil; i++ {
+			err = t.skipValue(elemType, depth+1)
+		}
+	default:
+		err = e.Errorf("unknown thrift type %d", typ)
+	}
+	return err
+}
+
+// structure reads the fields of a struct until the stop field, calling fn for every field.
+func (t *thriftReader) structure(fn func(id int16, typ byte) error) error {
+	for {
+		typ, err := t.byte()
+		if err != nil {
+			return err
+		}
+		if typ == thriftStop {
+			return nil
+		}
+		id, err := t.i16()
+		if err != nil {
+			return err
+		}
+		if err := fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+// field reads a field of the expected type, skipping it if the type does not match.
+func (t *thriftReader) field(typ byte, expected byte, read func() error) error {
+	if typ != expected {
+		return t.skip(typ)
+	}
+	return read()
+}
+
+func (t *thriftReader) readTag() (jaegerTag, error) {
+	var tag jaegerTag
+	var vType int32
+	var vStr string
+	var vDouble float64
+	var vBool bool
+	var vLong int64
+	var vBinary string
+	err := t.structure(func(id int16, typ byte) (err error) {
+		switch id {
+		case 1:
+			return t.field(typ, thriftString, func() error { tag.Key, err = t.string(); return err })
+		case 2:
+			return t.field(typ, thriftI32, func() error { vType, err = t.i32(); return err })
+		case 3:
+			return t.field(typ, thriftString, func() error { vStr, err = t.string(); return err })
+		case 4:
+			return t.field(typ, thriftDouble, func() error { vDouble, err = t.double(); return err })
+		case 5:
+			return t.field(typ, thriftBool, func() error {
+				var b byte
+				b, err = t.byte()
+				vBool = b != 0
+				return err
+			})
+		case 6:
+			return t.field(typ, thriftI64, func() error { vLong, err = t.i64(); return err })
+		case 7:
+			return t.field(typ, thriftString, func() error { vBinary, err = t.string(); return err })
+		}
+		return t.skip(typ)
+	})
+
+	switch vType {
+	case jaegerTagString:
+		tag.Value = vStr
+	case jaegerTagDouble:
+		tag.Value = strconv.FormatFloat(vDouble, 'f', -1, 64)
+	case jaegerTagBool:
+		tag.Value = strconv.FormatBool(vBool)
+	case jaegerTagLong:
+		tag.Value = strconv.FormatInt(vLong, 10)
+	case jaegerTagBinary:
+		tag.Value = base64.StdEncoding.EncodeToString([]byte(vBinary))
+	}
+	return tag, err
+}
+
+func (t *thriftReader) readTags() ([]jaegerTag, error) {
+	n, err := t.list(thriftStruct)
+	if err != nil {
+		return nil, err
+	}
+	var tags []jaegerTag
+	for i := 0; i < n; i++ {
+		tag, err := t.readTag()
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (t *thriftReader) readLog() (jaegerLog, error) {
+	var l jaegerLog
+	err := t.structure(func(id int16, typ byte) (err error) {
+		switch id {
+		case 1:
+			return t.field(typ, thriftI64, func() error { l.Timestamp, err = t.i64(); return err })
+		case 2:
+			return t.field(typ, thriftList, func() error { l.Fields, err = t.readTags(); return err })
+		}
+		return t.skip(typ)
+	})
+	return l, err
+}
+
+func (t *thriftReader) readSpanRef() (jaegerSpanRef, error) {
+	var ref jaegerSpanRef
+	err := t.structure(func(id int16, typ byte) (err error) {
+		switch id {
+		case 1:
+			return t.field(typ, thriftI32, func() error { ref.RefType, err = t.i32(); return err })
+		case 2:
+			return t.field(typ, thriftI64, func() error { ref.TraceIDLow, err = t.i64(); return err })
+		case 3:
+			return t.field(typ, thriftI64, func() error { ref.TraceIDHigh, err = t.i64(); return err })
+		case 4:
+			return t.field(typ, thriftI64, func() error { ref.SpanID, err = t.i64(); return err })
+		}
+		return t.skip(typ)
+	})
+	return ref, err
+}
+
+func (t *thriftReader) readSpan() (jaegerSpan, error) {
+	var s jaegerSpan
+	err := t.structure(func(id int16, typ byte) (err error) {
+		switch id {
+		case 1:
+			return t.field(typ, thriftI64, func() error { s.TraceIDLow, err = t.i64(); return err })
+		case 2:
+			return t.field(typ, thriftI64, func() error { s.TraceIDHigh, err = t.i64(); return err })
+		case 3:
+			return t.field(typ, thriftI64, func() error { s.SpanID, err = t.i64(); return err })
+		case 4:
+			return t.field(typ, thriftI64, func() error { s.ParentSpanID, err = t.i64(); return err })
+		case 5:
+			return t.field(typ, thriftString, func() error { s.OperationName, err = t.string(); return err })
+		case 6:
+			return t.field(typ, thriftList, func() error {
+				n, err := t.list(thriftStruct)
+				for i := 0; i < n && err == nil; i++ {
+					var ref jaegerSpanRef
+					if ref, err = t.readSpanRef(); err == nil {
+						s.References = append(s.References, ref)
+					}
+				}
+				return err
+			})
+		case 8:
+			return t.field(typ, thriftI64, func() error { s.StartTime, err = t.i64(); return err })
+		case 9:
+			return t.field(typ, thriftI64, func() error { s.Duration, err = t.i64(); return err })
+		case 10:
+			return t.field(typ, thriftList, func() error { s.Tags, err = t.readTags(); return err })
+		case 11:
+			return t.field(typ, thriftList, func() error {
+				n, err := t.list(thriftStruct)
+				for i := 0; i < n && err == nil; i++ {
+					var l jaegerLog
+					if l, err = t.readLog(); err == nil {
+						s.Logs = append(s.Logs, l)
+					}
+				}
+				return err
+			})
+		}
+		return t.skip(typ)
+	})
+	return s, err
+}
+
+func (t *thriftReader) readBatch() (*jaegerBatch, error) {
+	var batch jaegerBatch
+	err := t.structure(func(id int16, typ byte) (err error) {
+		switch id {
+		case 1:
+			return t.field(typ, thriftStruct, func() error {
+				return t.structure(func(id int16, typ byte) (err error) {
+					switch id {
+					case 1:
+						return t.field(typ, thriftString, func() error { batch.ServiceName, err = t.string(); return err })
+					case 2:
+						return t.field(typ, thriftList, func() error { batch.ProcessTags, err = t.readTags(); return err })
+					}
+					return t.skip(typ)
+				})
+			})
+		case 2:
+			return t.field(typ, thriftList, func() error {
+				n, err := t.list(thriftStruct)
+				for i := 0; i < n && err == nil; i++ {
+					var s jaegerSpan
+					if s, err = t.readSpan(); err == nil {
+						batch.Spans = append(batch.Spans, s)
+					}
+				}
+				return err
+			})
+		}
+		return t.skip(typ)
+	})
+	return &batch, err
+}
+
+func formatJaegerTraceID(high, low int64) string {
+	return fmt.Sprintf("%016x%016x", uint64(high), uint64(low))
+}
+
+func formatJaegerSpanID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%016x", uint64(id))
+}
+
+// getJaegerTraceRow converts a jaeger span into a trace row. Process and span tags become trace attributes,
+// logs span events and follows from references span links.
+func getJaegerTraceRow(ctx context.Context, projectID int, batch *jaegerBatch, span *jaegerSpan) *clickhouse.TraceRow {
+	attributes := map[string]string{}
+	for _, tag := range batch.ProcessTags {
+		attributes[tag.Key] = tag.Value
+	}
+	for _, tag := range span.Tags {
+		attributes[tag.Key] = tag.Value
+	}
+	kind := getSpanKind(attributes[JaegerSpanKindTag])
+	delete(attributes, JaegerSpanKindTag)
+
+	serviceName := batch.ServiceName
+	if v, ok := attributes[string(semconv.ServiceNameKey)]; ok {
+		serviceName = v
+		delete(attributes, string(semconv.ServiceNameKey))
+	}
+
+	start := time.UnixMicro(span.StartTime)
+	row := newTraceRow(ctx, projectID, start, start.Add(time.Duration(span.Duration)*time.Microsecond), attributes)
+	if row == nil {
+		return nil
+	}
+
+	events := make([]map[string]any, 0, len(span.Logs))
+	for _, l := range span.Logs {
+		name := "log"
+		fields := map[string]any{}
+		for _, field := range l.Fields {
+			if field.Key == JaegerLogEventField {
+				name = field.Value
+				continue
+			}
+			fields[field.Key] = field.Value
+		}
+		events = append(events, map[string]any{
+			"Timestamp":  time.UnixMicro(l.Timestamp),
+			"Name":       name,
+			"Attributes": fields,
+		})
+	}
+
+	parentSpanID := formatJaegerSpanID(span.ParentSpanID)
+	links := make([]map[string]any, 0)
+	for _, ref := range span.References {
+		if ref.RefType == jaegerRefFollowsFrom {
+			links = append(links, map[string]any{
+				"TraceId":    formatJaegerTraceID(ref.TraceIDHigh, ref.TraceIDLow),
+				"SpanId":     formatJaegerSpanID(ref.SpanID),
+				"TraceState": "",
+				"Attributes": map[string]any{},
+			})
+		} else if parentSpanID == "" {
+			// newer clients only set the parent with a child of reference
+			parentSpanID = formatJaegerSpanID(ref.SpanID)
+		}
+	}
+
+	return row.
+		WithTraceId(formatJaegerTraceID(span.TraceIDHigh, span.TraceIDLow)).
+		WithSpanId(formatJaegerSpanID(span.SpanID)).
+		WithParentSpanId(parentSpanID).
+		WithSpanName(span.OperationName).
+		WithSpanKind(kind.String()).
+		WithServiceName(serviceName).
+		WithEvents(events).
+		WithLinks(links)
+}
+
+// HandleJaegerThrift implements the jaeger collector http endpoint, accepting thrift binary encoded batches.
+func HandleJaegerThrift(w http.ResponseWriter, r *http.Request) {
+	requestBody, err := getBody(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid jaeger gzip")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(requestBody)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid jaeger body")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	batch, err := (&thriftReader{b: body}).readBatch()
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid jaeger thrift batch")
+		http.Error(w, e.Wrap(err, "invalid jaeger thrift batch").Error(), http.StatusBadRequest)
+		return
+	}
+
+	projectID := getTraceProjectID(r)
+	var traceRows []*clickhouse.TraceRow
+	var dropped int
+	for i := range batch.Spans {
+		row := getJaegerTraceRow(r.Context(), projectID, batch, &batch.Spans[i])
+		if row == nil {
+			dropped++
+			continue
+		}
+		traceRows = append(traceRows, row)
+	}
+	writeTraceRows(w, r, traceRows, dropped, "jaeger")
+}
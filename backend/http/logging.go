@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,9 +17,11 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/multiline"
 	"github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
 	highlightChi "github.com/highlight/highlight/sdk/highlight-go/middleware/chi"
+	e "github.com/pkg/errors"
 	"github.com/samber/lo"
 	log "github.com/sirupsen/logrus"
 	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
@@ -30,8 +33,20 @@ const (
 	LogDrainServiceQueryParam = "service"
 	LogDrainProjectHeader     = "x-highlight-project"
 	LogDrainServiceHeader     = "x-highlight-service"
+
+	LogDrainMultilineQueryParam       = "multiline"
+	LogDrainMultilineStartQueryParam  = "multiline_start"
+	LogDrainMultilineIndentQueryParam = "multiline_indent"
+	LogDrainMultilineWaitQueryParam   = "multiline_wait"
+	FirehoseMultilineAttribute        = "x-highlight-multiline"
+	FirehoseMultilineStartAttribute   = "x-highlight-multiline-start"
+	FirehoseMultilineIndentAttribute  = "x-highlight-multiline-indent"
+	FirehoseMultilineWaitAttribute    = "x-highlight-multiline-wait"
 )
 
+// firehoseStreamAttributes identify the stream of a firehose log, so that the lines of different containers are not stitched together
+var firehoseStreamAttributes = []string{string(semconv.ServiceNameKey), "container_id", "log_group", "log_stream", "source"}
+
 type PayloadMessage interface {
 	GetMessage() string
 	GetLevel() string
@@ -325,6 +340,33 @@ func getQueryStringParams(r *http.Request) (int, string, error) {
 	return projectID, qs.Get(LogDrainServiceQueryParam), nil
 }
 
+// getMultilineConfig reads the multiline configuration of a log drain, returning nil when the drain does not reassemble lines.
+func getMultilineConfig(preset, start, indent, wait string) (*multiline.Config, error) {
+	var indentLines bool
+	if indent != "" {
+		var err error
+		if indentLines, err = strconv.ParseBool(indent); err != nil {
+			return nil, e.Wrap(err, "invalid multiline indent")
+		}
+	}
+	var maxWait time.Duration
+	if wait != "" {
+		var err error
+		if maxWait, err = time.ParseDuration(wait); err != nil {
+			return nil, e.Wrap(err, "invalid multiline wait")
+		}
+	}
+	return multiline.NewConfig(preset, start, indentLines, maxWait)
+}
+
+// submitLog submits a log, reassembling it with the other lines of its stream when a multiline configuration is set.
+func submitLog(ctx context.Context, config *multiline.Config, projectID int, stream string, lg hlog.Log) error {
+	if config == nil {
+		return hlog.SubmitHTTPLog(ctx, tracer, projectID, lg)
+	}
+	return multilineAggregator.Add(ctx, config, projectID, stream, lg)
+}
+
 func parsePinoLevel(level uint8) string {
 	switch level {
 	case 10:
@@ -343,6 +385,9 @@ func parsePinoLevel(level uint8) string {
 	return "info"
 }
 
+// HandleFirehoseLog ingests the records of a kinesis firehose http delivery.
+// Multiline records are reassembled in the memory of the replica receiving the lines, so the lines of a record
+// are only stitched together when they reach the same replica and are otherwise submitted as separate records.
 func HandleFirehoseLog(w http.ResponseWriter, r *http.Request) {
 	requestBody, err := getBody(r)
 	if err != nil {
@@ -375,18 +420,29 @@ func HandleFirehoseLog(w http.ResponseWriter, r *http.Request) {
 	}
 
 	attributesMap := struct {
-		CommonAttributes struct {
-			ProjectID string `json:"x-highlight-project"`
-		} `json:"commonAttributes"`
+		CommonAttributes map[string]string `json:"commonAttributes"`
 	}{}
 	if err := json.Unmarshal([]byte(r.Header.Get("X-Amz-Firehose-Common-Attributes")), &attributesMap); err != nil {
 		log.WithContext(r.Context()).WithError(err).Error("invalid http firehose attriutes")
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	projectID, err := model2.FromVerboseID(attributesMap.CommonAttributes.ProjectID)
+	projectVerboseID := attributesMap.CommonAttributes[LogDrainProjectHeader]
+	projectID, err := model2.FromVerboseID(projectVerboseID)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", projectVerboseID).Error("invalid highlight project id from http firehose request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	multilineConfig, err := getMultilineConfig(
+		attributesMap.CommonAttributes[FirehoseMultilineAttribute],
+		attributesMap.CommonAttributes[FirehoseMultilineStartAttribute],
+		attributesMap.CommonAttributes[FirehoseMultilineIndentAttribute],
+		attributesMap.CommonAttributes[FirehoseMultilineWaitAttribute],
+	)
 	if err != nil {
-		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", attributesMap.CommonAttributes.ProjectID).Error("invalid highlight project id from http firehose request")
+		log.WithContext(r.Context()).WithError(err).Error("invalid http firehose multiline attributes")
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -427,7 +483,10 @@ func HandleFirehoseLog(w http.ResponseWriter, r *http.Request) {
 						Attributes: map[string]string{},
 					}
 					ctx := p.SetLogAttributes(r.Context(), &hl, msg)
-					if err := hlog.SubmitHTTPLog(ctx, tracer, projectID, hl); err != nil {
+					stream := strings.Join(lo.Map(firehoseStreamAttributes, func(k string, _ int) string {
+						return hl.Attributes[k]
+					}), "\x00")
+					if err := submitLog(ctx, multilineConfig, projectID, stream, hl); err != nil {
 						log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
 						http.Error(w, err.Error(), http.StatusBadRequest)
 						return
@@ -557,6 +616,9 @@ func HandleJSONLog(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// HandleRawLog ingests a body of raw log lines.
+// Multiline records are reassembled in the memory of the replica receiving the lines, so the lines of a record
+// are only stitched together when they reach the same replica and are otherwise submitted as separate records.
 func HandleRawLog(w http.ResponseWriter, r *http.Request) {
 	projectID, serviceName, err := getQueryStringParams(r)
 	if err != nil {
@@ -578,33 +640,94 @@ func HandleRawLog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	lg := hlog.Log{
-		Attributes: map[string]string{},
-		Message:    string(body),
-		Timestamp:  time.Now().UTC().Format(hlog.TimestampFormat),
-		Level:      model.LogLevelInfo.String(),
+	qs := r.URL.Query()
+	multilineConfig, err := getMultilineConfig(qs.Get(LogDrainMultilineQueryParam), qs.Get(LogDrainMultilineStartQueryParam), qs.Get(LogDrainMultilineIndentQueryParam), qs.Get(LogDrainMultilineWaitQueryParam))
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid http logs multiline parameters")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	if serviceName != "" {
-		lg.Attributes[string(semconv.ServiceNameKey)] = serviceName
+	// when reassembling multiline records, each line of the body is a line of the stream
+	messages := []string{string(body)}
+	if multilineConfig != nil {
+		messages = strings.Split(strings.TrimRight(strings.ReplaceAll(string(body), "\r\n", "\n"), "\n"), "\n")
 	}
-	if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, lg); err != nil {
-		log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+
+	for _, message := range messages {
+		lg := hlog.Log{
+			Attributes: map[string]string{},
+			Message:    message,
+			Timestamp:  time.Now().UTC().Format(hlog.TimestampFormat),
+			Level:      model.LogLevelInfo.String(),
+		}
+
+		if serviceName != "" {
+			lg.Attributes[string(semconv.ServiceNameKey)] = serviceName
+		}
+		if err := submitLog(r.Context(), multilineConfig, projectID, serviceName, lg); err != nil {
+			log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 	}
 
 	w.WriteHeader(http.StatusOK)
 }
 
 var tracer trace.Tracer
+var multilineAggregator = multiline.NewAggregator(func(ctx context.Context, projectID int, lg hlog.Log) error {
+	return hlog.SubmitHTTPLog(ctx, tracer, projectID, lg)
+})
+var metricSubmitter MetricRowSubmitter
+var traceSubmitter TraceRowSubmitter
 
-func Listen(r *chi.Mux, t trace.Tracer) {
+// Stop submits the multiline records still waiting for continuation lines, so that they are not lost on shutdown.
+func Stop(ctx context.Context) {
+	multilineAggregator.Stop(ctx)
+}
+
+func Listen(r *chi.Mux, t trace.Tracer, m MetricRowSubmitter, s TraceRowSubmitter) {
 	tracer = t
+	metricSubmitter = m
+	traceSubmitter = s
 	r.Route("/v1", func(r chi.Router) {
 		r.Use(highlightChi.Middleware)
 		r.HandleFunc("/logs/raw", HandleRawLog)
 		r.HandleFunc("/logs/json", HandleJSONLog)
 		r.HandleFunc("/logs/firehose", HandleFirehoseLog)
+		r.Post("/logs/fly", HandleFlyLogs)
+		r.Post("/logs/render", HandleRenderLogs)
+		r.Post("/logs/railway", HandleRailwayLogs)
+		r.Post("/logs/netlify", HandleNetlifyLogs)
+		r.Post("/metrics/prometheus", HandlePrometheusWrite)
+	})
+	r.Route("/loki/api/v1", func(r chi.Router) {
+		r.Use(highlightChi.Middleware)
+		r.Post("/push", HandleLokiPush)
+	})
+	r.Route("/elasticsearch", func(r chi.Router) {
+		r.Use(highlightChi.Middleware)
+		r.Get("/", HandleElasticsearchInfo)
+		r.Head("/", HandleElasticsearchInfo)
+		r.Post("/_bulk", HandleElasticsearchBulk)
+		r.Put("/_bulk", HandleElasticsearchBulk)
+		r.Post("/{index}/_bulk", HandleElasticsearchBulk)
+		r.Put("/{index}/_bulk", HandleElasticsearchBulk)
+	})
+	r.Route("/services/collector", func(r chi.Router) {
+		r.Use(highlightChi.Middleware)
+		r.Post("/", HandleSplunkEvent)
+		r.Post("/event", HandleSplunkEvent)
+		r.Post("/event/1.0", HandleSplunkEvent)
+		r.Post("/raw", HandleSplunkRaw)
+		r.Post("/raw/1.0", HandleSplunkRaw)
+		r.Get("/health", HandleSplunkHealth)
+		r.Get("/health/1.0", HandleSplunkHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(highlightChi.Middleware)
+		r.Post("/api/v2/spans", HandleZipkinSpans)
+		r.Post("/api/traces", HandleJaegerThrift)
 	})
 }
@@ -125,6 +125,28 @@ func TestHandleRawLog(t *testing.T) {
 	assert.Equal(t, 200, w.statusCode)
 }
 
+func TestHandleRawLogMultiline(t *testing.T) {
+	body := "starting\npanic: boom\n\ngoroutine 1 [running]:\nmain.main()\n\t/app/main.go:20 +0x1d\nexit status 2\n"
+	r, _ := http.NewRequest("POST", fmt.Sprintf("/v1/logs/raw?%s=1jdkoe52&%s=multiline&%s=go&%s=10ms", LogDrainProjectQueryParam, LogDrainServiceQueryParam, LogDrainMultilineQueryParam, LogDrainMultilineWaitQueryParam), strings.NewReader(body))
+	w := &MockResponseWriter{}
+	HandleRawLog(w, r)
+	assert.Equal(t, 200, w.statusCode)
+
+	assert.Eventually(t, func() bool {
+		spans := spanRecorder.Ended()
+		event := spans[len(spans)-1].Events()[0]
+		msg, _ := lo.Find(event.Attributes, func(item attribute.KeyValue) bool {
+			return item.Key == "log.message"
+		})
+		return msg.Value.AsString() == strings.TrimPrefix(strings.TrimSpace(body), "starting\n")
+	}, time.Second, 5*time.Millisecond)
+
+	r, _ = http.NewRequest("POST", fmt.Sprintf("/v1/logs/raw?%s=1jdkoe52&%s=cobol", LogDrainProjectQueryParam, LogDrainMultilineQueryParam), strings.NewReader(body))
+	w = &MockResponseWriter{}
+	HandleRawLog(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.statusCode)
+}
+
 func TestHandleFlyJSONLog(t *testing.T) {
 	r, _ := http.NewRequest("POST", "/v1/logs/json", strings.NewReader(FlyNDJson))
 	r.Header.Set("Content-Type", "application/x-ndjson")
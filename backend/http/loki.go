@@ -0,0 +1,330 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	highlight "github.com/highlight/highlight/sdk/highlight-go"
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// LokiTenantHeader is the loki multi-tenancy header, set by promtail and alloy from their tenant_id config.
+const LokiTenantHeader = "X-Scope-OrgID"
+
+// labels holding the service name of a stream, in the order loki discovers the service name
+var lokiServiceNameLabels = []string{"service_name", "service", "app", "application", "job", "container_name", "container"}
+
+// labels and structured metadata holding the level of a log
+var lokiLevelLabels = []string{"level", "severity", "lvl", "detected_level"}
+
+type lokiEntry struct {
+	Timestamp time.Time
+	Line      string
+	Metadata  map[string]string
+}
+
+type lokiStream struct {
+	Labels  map[string]string
+	Entries []lokiEntry
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream
+}
+
+// parseLokiLabels parses a stream selector such as `{job="api", env="prod"}`.
+func parseLokiLabels(selector string) (map[string]string, error) {
+	labels := map[string]string{}
+	s := strings.TrimSpace(selector)
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return nil, e.Errorf("invalid loki stream labels %q", selector)
+	}
+	s = s[1 : len(s)-1]
+	for {
+		s = strings.TrimLeft(s, " ,")
+		if s == "" {
+			return labels, nil
+		}
+		name, rest, found := strings.Cut(s, "=")
+		if !found {
+			return nil, e.Errorf("invalid loki stream labels %q", selector)
+		}
+		rest = strings.TrimSpace(rest)
+		value, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return nil, e.Errorf("invalid loki stream labels %q", selector)
+		}
+		s = rest[len(value):]
+		if labels[strings.TrimSpace(name)], err = strconv.Unquote(value); err != nil {
+			return nil, e.Errorf("invalid loki stream labels %q", selector)
+		}
+	}
+}
+
+// unmarshalTimestamp decodes a google.protobuf.Timestamp message.
+func unmarshalTimestamp(b []byte, t *time.Time) error {
+	var seconds, nanos uint64
+	err := consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeVarint(typ, b, &seconds)
+		case 2:
+			return consumeVarint(typ, b, &nanos)
+		}
+		return 0
+	})
+	*t = time.Unix(int64(seconds), int64(nanos))
+	return err
+}
+
+func (entry *lokiEntry) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				return unmarshalTimestamp(msg, &entry.Timestamp)
+			})
+		case 2:
+			return consumeString(typ, b, &entry.Line)
+		case 3:
+			// structured metadata pairs share the wire format of prometheus labels
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var label prometheusLabel
+				if err := label.unmarshal(msg); err != nil {
+					return err
+				}
+				if entry.Metadata == nil {
+					entry.Metadata = map[string]string{}
+				}
+				entry.Metadata[label.Name] = label.Value
+				return nil
+			})
+		}
+		return 0
+	})
+}
+
+func (stream *lokiStream) unmarshal(b []byte) error {
+	var selector string
+	err := consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeString(typ, b, &selector)
+		case 2:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var entry lokiEntry
+				if err := entry.unmarshal(msg); err != nil {
+					return err
+				}
+				stream.Entries = append(stream.Entries, entry)
+				return nil
+			})
+		}
+		return 0
+	})
+	if err != nil {
+		return err
+	}
+	stream.Labels, err = parseLokiLabels(selector)
+	return err
+}
+
+func (req *lokiPushRequest) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		if num != 1 {
+			return 0
+		}
+		return consumeEmbedded(typ, b, func(msg []byte) error {
+			var stream lokiStream
+			if err := stream.unmarshal(msg); err != nil {
+				return err
+			}
+			req.Streams = append(req.Streams, stream)
+			return nil
+		})
+	})
+}
+
+func (req *lokiPushRequest) unmarshalJSON(b []byte) error {
+	var body struct {
+		Streams []struct {
+			Stream map[string]string
+			Values [][]json.RawMessage
+		}
+	}
+	if err := json.Unmarshal(b, &body); err != nil {
+		return err
+	}
+	for _, s := range body.Streams {
+		stream := lokiStream{Labels: s.Stream}
+		if stream.Labels == nil {
+			stream.Labels = map[string]string{}
+		}
+		// values are tuples of the timestamp in nanoseconds, the log line and optional structured metadata
+		for _, value := range s.Values {
+			if len(value) < 2 {
+				return e.New("invalid loki log entry")
+			}
+			var ts string
+			var entry lokiEntry
+			if err := json.Unmarshal(value[0], &ts); err != nil {
+				return e.Wrap(err, "invalid loki log timestamp")
+			}
+			nanos, err := strconv.ParseInt(ts, 10, 64)
+			if err != nil {
+				return e.Wrap(err, "invalid loki log timestamp")
+			}
+			entry.Timestamp = time.Unix(0, nanos)
+			if err := json.Unmarshal(value[1], &entry.Line); err != nil {
+				return e.Wrap(err, "invalid loki log line")
+			}
+			if len(value) > 2 {
+				if err := json.Unmarshal(value[2], &entry.Metadata); err != nil {
+					return e.Wrap(err, "invalid loki structured metadata")
+				}
+			}
+			stream.Entries = append(stream.Entries, entry)
+		}
+		req.Streams = append(req.Streams, stream)
+	}
+	return nil
+}
+
+// getLokiPushRequest decodes a push request, either snappy compressed protobuf or (optionally gzipped) json.
+func getLokiPushRequest(r *http.Request) (*lokiPushRequest, error) {
+	requestBody, err := getBody(r)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid gzip body")
+	}
+	body, err := io.ReadAll(requestBody)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid body")
+	}
+
+	var req lokiPushRequest
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := req.unmarshalJSON(body); err != nil {
+			return nil, e.Wrap(err, "invalid loki push json")
+		}
+		return &req, nil
+	}
+
+	decoded, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid snappy body")
+	}
+	if err := req.unmarshal(decoded); err != nil {
+		return nil, e.Wrap(err, "invalid loki push protobuf")
+	}
+	return &req, nil
+}
+
+// getLokiProjectID resolves the project of the request from the project header, the loki tenant header or the query string parameter.
+func getLokiProjectID(r *http.Request) int {
+	projectVerboseID := r.Header.Get(LogDrainProjectHeader)
+	if projectVerboseID == "" {
+		projectVerboseID = r.Header.Get(LokiTenantHeader)
+	}
+	if projectVerboseID == "" {
+		projectVerboseID = r.URL.Query().Get(LogDrainProjectQueryParam)
+	}
+	if projectVerboseID == "" {
+		return 0
+	}
+	projectID, err := model2.FromVerboseID(projectVerboseID)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", projectVerboseID).Error("failed to parse highlight project id from loki push request")
+		return 0
+	}
+	return projectID
+}
+
+// getLokiLog converts a loki log entry into a highlight log. Stream labels and structured metadata
+// become log attributes, with the service name and level picked out of the well known labels.
+func getLokiLog(stream *lokiStream, entry *lokiEntry) hlog.Log {
+	lg := hlog.Log{
+		Message:    entry.Line,
+		Timestamp:  entry.Timestamp.UTC().Format(hlog.TimestampFormatNano),
+		Level:      model.LogLevelInfo.String(),
+		Attributes: map[string]string{},
+	}
+	for k, v := range stream.Labels {
+		lg.Attributes[k] = v
+	}
+	for k, v := range entry.Metadata {
+		lg.Attributes[k] = v
+	}
+
+	for _, label := range lokiServiceNameLabels {
+		if service := lg.Attributes[label]; service != "" {
+			lg.Attributes[string(semconv.ServiceNameKey)] = service
+			// the service_name label would duplicate service.name
+			delete(lg.Attributes, "service_name")
+			break
+		}
+	}
+	for _, label := range lokiLevelLabels {
+		if level := lg.Attributes[label]; level != "" {
+			lg.Level = strings.ToLower(level)
+			delete(lg.Attributes, label)
+			break
+		}
+	}
+	return lg
+}
+
+// HandleLokiPush implements the loki push api used by promtail, grafana alloy and the docker loki logging driver.
+func HandleLokiPush(w http.ResponseWriter, r *http.Request) {
+	req, err := getLokiPushRequest(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid loki push request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	requestProjectID := getLokiProjectID(r)
+	var dropped int
+	for _, stream := range req.Streams {
+		projectID := requestProjectID
+		if projectVerboseID, ok := stream.Labels[highlight.DeprecatedProjectIDAttribute]; ok {
+			delete(stream.Labels, highlight.DeprecatedProjectIDAttribute)
+			if labelProjectID, err := model2.FromVerboseID(projectVerboseID); err == nil {
+				projectID = labelProjectID
+			} else {
+				log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", projectVerboseID).Warn("invalid highlight project id label in loki stream")
+			}
+		}
+		if projectID == 0 {
+			dropped += len(stream.Entries)
+			continue
+		}
+
+		for _, entry := range stream.Entries {
+			if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, getLokiLog(&stream, &entry)); err != nil {
+				log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+
+	if dropped > 0 {
+		log.WithContext(r.Context()).WithField("dropped", dropped).Warn("dropped loki logs without a highlight project")
+		// loki clients do not retry 4xx responses
+		http.Error(w, "no highlight project provided via header, query string parameter or label", http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
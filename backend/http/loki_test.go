@@ -0,0 +1,106 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const LokiPushJson = `{"streams":[{"stream":{"service_name":"api","env":"prod","level":"WARN"},"values":[["1700000000000000001","slow request",{"trace_id":"f80fc1e87e7bce2bb992167f47f8ab00"}],["1700000001000000000","done"]]}]}`
+
+func newLokiPushBody() []byte {
+	var timestamp []byte
+	timestamp = protowire.AppendTag(timestamp, 1, protowire.VarintType)
+	timestamp = protowire.AppendVarint(timestamp, 1700000000)
+	timestamp = protowire.AppendTag(timestamp, 2, protowire.VarintType)
+	timestamp = protowire.AppendVarint(timestamp, 5)
+
+	var metadata []byte
+	metadata = protowire.AppendTag(metadata, 1, protowire.BytesType)
+	metadata = protowire.AppendString(metadata, "pod")
+	metadata = protowire.AppendTag(metadata, 2, protowire.BytesType)
+	metadata = protowire.AppendString(metadata, "api-7d9")
+
+	var entry []byte
+	entry = appendEmbedded(entry, 1, timestamp)
+	entry = protowire.AppendTag(entry, 2, protowire.BytesType)
+	entry = protowire.AppendString(entry, "GET /users 200")
+	entry = appendEmbedded(entry, 3, metadata)
+
+	var stream []byte
+	stream = protowire.AppendTag(stream, 1, protowire.BytesType)
+	stream = protowire.AppendString(stream, `{job="ingress", highlight_project_id="1"}`)
+	stream = appendEmbedded(stream, 2, entry)
+
+	return snappy.Encode(nil, appendEmbedded(nil, 1, stream))
+}
+
+func TestParseLokiLabels(t *testing.T) {
+	labels, err := parseLokiLabels(`{job="api", path="/a,b", msg="say \"hi\""}`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"job": "api", "path": "/a,b", "msg": `say "hi"`}, labels)
+
+	labels, err = parseLokiLabels(`{}`)
+	assert.NoError(t, err)
+	assert.Empty(t, labels)
+
+	for _, selector := range []string{`job="api"`, `{job}`, `{job="api}`} {
+		_, err := parseLokiLabels(selector)
+		assert.Error(t, err, selector)
+	}
+}
+
+func TestGetLokiLog(t *testing.T) {
+	var req lokiPushRequest
+	assert.NoError(t, req.unmarshalJSON([]byte(LokiPushJson)))
+	assert.Len(t, req.Streams, 1)
+	assert.Len(t, req.Streams[0].Entries, 2)
+
+	lg := getLokiLog(&req.Streams[0], &req.Streams[0].Entries[0])
+	assert.Equal(t, "slow request", lg.Message)
+	assert.Equal(t, "warn", lg.Level)
+	assert.Equal(t, "2023-11-14T22:13:20.000000001Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{
+		"service.name": "api",
+		"env":          "prod",
+		"trace_id":     "f80fc1e87e7bce2bb992167f47f8ab00",
+	}, lg.Attributes)
+
+	var pb lokiPushRequest
+	body, err := snappy.Decode(nil, newLokiPushBody())
+	assert.NoError(t, err)
+	assert.NoError(t, pb.unmarshal(body))
+	lg = getLokiLog(&pb.Streams[0], &pb.Streams[0].Entries[0])
+	assert.Equal(t, "GET /users 200", lg.Message)
+	assert.Equal(t, "info", lg.Level)
+	assert.Equal(t, time.Unix(1700000000, 5).UTC().Format("2006-01-02T15:04:05.999999999Z"), lg.Timestamp)
+	assert.Equal(t, "ingress", lg.Attributes["service.name"])
+	assert.Equal(t, "api-7d9", lg.Attributes["pod"])
+}
+
+func TestHandleLokiPush(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/loki/api/v1/push", strings.NewReader(LokiPushJson))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(LokiTenantHeader, "1")
+	w := httptest.NewRecorder()
+	HandleLokiPush(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	r = httptest.NewRequest(http.MethodPost, "/loki/api/v1/push", strings.NewReader(string(newLokiPushBody())))
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	w = httptest.NewRecorder()
+	HandleLokiPush(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	r = httptest.NewRequest(http.MethodPost, "/loki/api/v1/push", strings.NewReader(LokiPushJson))
+	r.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	HandleLokiPush(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,388 @@
+package http
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	highlight "github.com/highlight/highlight/sdk/highlight-go"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	PrometheusMetricNameLabel  = "__name__"
+	PrometheusServiceNameLabel = "service_name"
+	PrometheusJobLabel         = "job"
+)
+
+// prometheus metric types as defined by the remote write MetricMetadata.MetricType enum
+const (
+	prometheusTypeUnknown        = 0
+	prometheusTypeCounter        = 1
+	prometheusTypeGauge          = 2
+	prometheusTypeHistogram      = 3
+	prometheusTypeGaugeHistogram = 4
+	prometheusTypeSummary        = 5
+)
+
+type MetricRowSubmitter interface {
+	SubmitMetricRows(ctx context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error)
+}
+
+type prometheusLabel struct {
+	Name  string
+	Value string
+}
+
+type prometheusSample struct {
+	Value     float64
+	Timestamp int64
+}
+
+type prometheusTimeSeries struct {
+	Labels  []prometheusLabel
+	Samples []prometheusSample
+}
+
+type prometheusMetadata struct {
+	Type             uint64
+	MetricFamilyName string
+	Unit             string
+}
+
+type prometheusWriteRequest struct {
+	TimeSeries []prometheusTimeSeries
+	Metadata   []prometheusMetadata
+}
+
+// consumeMessage iterates over the fields of a protobuf message, calling fn with the
+// wire type and the remaining buffer for every field. fn returns the number of bytes consumed.
+func consumeMessage(b []byte, fn func(num protowire.Number, typ protowire.Type, b []byte) int) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = fn(num, typ, b)
+		if n == 0 {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func consumeString(typ protowire.Type, b []byte, v *string) int {
+	if typ != protowire.BytesType {
+		return 0
+	}
+	s, n := protowire.ConsumeString(b)
+	if n >= 0 {
+		*v = s
+	}
+	return n
+}
+
+func consumeVarint(typ protowire.Type, b []byte, v *uint64) int {
+	if typ != protowire.VarintType {
+		return 0
+	}
+	x, n := protowire.ConsumeVarint(b)
+	if n >= 0 {
+		*v = x
+	}
+	return n
+}
+
+func consumeEmbedded(typ protowire.Type, b []byte, fn func([]byte) error) int {
+	if typ != protowire.BytesType {
+		return 0
+	}
+	msg, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return n
+	}
+	if err := fn(msg); err != nil {
+		return -1
+	}
+	return n
+}
+
+func (l *prometheusLabel) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeString(typ, b, &l.Name)
+		case 2:
+			return consumeString(typ, b, &l.Value)
+		}
+		return 0
+	})
+}
+
+func (s *prometheusSample) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			if typ != protowire.Fixed64Type {
+				return 0
+			}
+			v, n := protowire.ConsumeFixed64(b)
+			s.Value = math.Float64frombits(v)
+			return n
+		case 2:
+			var v uint64
+			n := consumeVarint(typ, b, &v)
+			s.Timestamp = int64(v)
+			return n
+		}
+		return 0
+	})
+}
+
+func (ts *prometheusTimeSeries) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var label prometheusLabel
+				if err := label.unmarshal(msg); err != nil {
+					return err
+				}
+				ts.Labels = append(ts.Labels, label)
+				return nil
+			})
+		case 2:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var sample prometheusSample
+				if err := sample.unmarshal(msg); err != nil {
+					return err
+				}
+				ts.Samples = append(ts.Samples, sample)
+				return nil
+			})
+		}
+		// exemplars and native histograms are not supported
+		return 0
+	})
+}
+
+func (m *prometheusMetadata) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeVarint(typ, b, &m.Type)
+		case 2:
+			return consumeString(typ, b, &m.MetricFamilyName)
+		case 5:
+			return consumeString(typ, b, &m.Unit)
+		}
+		return 0
+	})
+}
+
+func (w *prometheusWriteRequest) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var ts prometheusTimeSeries
+				if err := ts.unmarshal(msg); err != nil {
+					return err
+				}
+				w.TimeSeries = append(w.TimeSeries, ts)
+				return nil
+			})
+		case 3:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var md prometheusMetadata
+				if err := md.unmarshal(msg); err != nil {
+					return err
+				}
+				w.Metadata = append(w.Metadata, md)
+				return nil
+			})
+		}
+		return 0
+	})
+}
+
+// getPrometheusWriteRequest decodes the snappy compressed protobuf body of a remote write request.
+func getPrometheusWriteRequest(r *http.Request) (*prometheusWriteRequest, error) {
+	compressed, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid body")
+	}
+	body, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid snappy body")
+	}
+	var req prometheusWriteRequest
+	if err := req.unmarshal(body); err != nil {
+		return nil, e.Wrap(err, "invalid remote write protobuf")
+	}
+	return &req, nil
+}
+
+// getPrometheusMetricType returns the highlight metric type of a series. Series of counters,
+// histograms and summaries (the _bucket, _count and _sum series) are monotonic cumulative sums.
+// Without metadata, the type is inferred from the prometheus naming conventions.
+func getPrometheusMetricType(name string, labels map[string]string, metadata map[string]prometheusMetadata) (string, bool) {
+	md, ok := metadata[name]
+	if !ok {
+		for _, suffix := range []string{"_bucket", "_count", "_sum", "_total"} {
+			if family, found := strings.CutSuffix(name, suffix); found {
+				if md, ok = metadata[family]; ok {
+					break
+				}
+			}
+		}
+	}
+
+	switch md.Type {
+	case prometheusTypeCounter:
+		return clickhouse.MetricTypeSum, true
+	case prometheusTypeHistogram, prometheusTypeSummary:
+		if _, quantile := labels["quantile"]; quantile && md.Type == prometheusTypeSummary {
+			return clickhouse.MetricTypeSummary, false
+		}
+		return clickhouse.MetricTypeSum, true
+	case prometheusTypeGauge, prometheusTypeGaugeHistogram:
+		return clickhouse.MetricTypeGauge, false
+	case prometheusTypeUnknown:
+		for _, suffix := range []string{"_bucket", "_count", "_sum", "_total"} {
+			if strings.HasSuffix(name, suffix) {
+				return clickhouse.MetricTypeSum, true
+			}
+		}
+	}
+	return clickhouse.MetricTypeGauge, false
+}
+
+// getPrometheusMetricRows converts every sample of the request into a metric row. The project is
+// resolved from the highlight_project_id label of the series, falling back to the request project.
+// Returns the number of samples dropped because their project could not be resolved.
+func getPrometheusMetricRows(ctx context.Context, req *prometheusWriteRequest, requestProjectID int) ([]*clickhouse.MetricRow, int) {
+	metadata := map[string]prometheusMetadata{}
+	for _, md := range req.Metadata {
+		metadata[md.MetricFamilyName] = md
+	}
+
+	var metricRows []*clickhouse.MetricRow
+	var dropped int
+	for _, ts := range req.TimeSeries {
+		labels := map[string]string{}
+		for _, label := range ts.Labels {
+			labels[label.Name] = label.Value
+		}
+		name := labels[PrometheusMetricNameLabel]
+		delete(labels, PrometheusMetricNameLabel)
+		if name == "" {
+			dropped += len(ts.Samples)
+			continue
+		}
+
+		projectID := requestProjectID
+		if projectVerboseID, ok := labels[highlight.DeprecatedProjectIDAttribute]; ok {
+			delete(labels, highlight.DeprecatedProjectIDAttribute)
+			if labelProjectID, err := model2.FromVerboseID(projectVerboseID); err == nil {
+				projectID = labelProjectID
+			} else {
+				log.WithContext(ctx).WithError(err).WithField("projectVerboseID", projectVerboseID).Warn("invalid highlight project id label in prometheus series")
+			}
+		}
+		if projectID == 0 {
+			dropped += len(ts.Samples)
+			continue
+		}
+
+		serviceName := labels[PrometheusServiceNameLabel]
+		if serviceName == "" {
+			serviceName = labels[PrometheusJobLabel]
+		}
+		metricType, isMonotonic := getPrometheusMetricType(name, labels, metadata)
+		temporality := clickhouse.MetricTemporalityUnspecified
+		if metricType == clickhouse.MetricTypeSum {
+			temporality = clickhouse.MetricTemporalityCumulative
+		}
+
+		for _, sample := range ts.Samples {
+			// stale markers are NaN values signaling the series has ended
+			if math.IsNaN(sample.Value) {
+				continue
+			}
+			metricRows = append(metricRows, clickhouse.NewMetricRow(time.UnixMilli(sample.Timestamp), projectID).
+				WithServiceName(serviceName).
+				WithMetricName(name).
+				WithMetricType(metricType).
+				WithTemporality(temporality, isMonotonic).
+				WithUnit(metadata[name].Unit).
+				WithAttributes(labels).
+				WithValue(sample.Value))
+		}
+	}
+	return metricRows, dropped
+}
+
+// getPrometheusProjectID resolves the project of the request from the project header or query string parameter.
+func getPrometheusProjectID(r *http.Request) int {
+	projectVerboseID := r.Header.Get(LogDrainProjectHeader)
+	if projectVerboseID == "" {
+		projectVerboseID = r.URL.Query().Get(LogDrainProjectQueryParam)
+	}
+	if projectVerboseID == "" {
+		return 0
+	}
+	projectID, err := model2.FromVerboseID(projectVerboseID)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", projectVerboseID).Error("failed to parse highlight project id from prometheus remote write request")
+		return 0
+	}
+	return projectID
+}
+
+// HandlePrometheusWrite implements the prometheus remote write (v1) receiver, storing samples as metrics.
+func HandlePrometheusWrite(w http.ResponseWriter, r *http.Request) {
+	req, err := getPrometheusWriteRequest(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid prometheus remote write request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	metricRows, dropped := getPrometheusMetricRows(r.Context(), req, getPrometheusProjectID(r))
+	if len(metricRows) == 0 && dropped > 0 {
+		http.Error(w, "no highlight project provided via header, query string parameter or label", http.StatusBadRequest)
+		return
+	}
+	if dropped > 0 {
+		log.WithContext(r.Context()).WithField("dropped", dropped).Warn("dropped prometheus samples without a highlight project")
+	}
+
+	if len(metricRows) > 0 {
+		rejected, err := metricSubmitter.SubmitMetricRows(r.Context(), metricRows)
+		if err != nil {
+			log.WithContext(r.Context()).WithError(err).Error("failed to submit prometheus metrics")
+			// a 5xx response causes prometheus to retry the request
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if len(rejected) > 0 {
+			log.WithContext(r.Context()).WithField("rejected", len(rejected)).Warn("rejected prometheus samples")
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
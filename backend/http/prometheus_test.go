@@ -0,0 +1,218 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/promql"
+	"github.com/openlyinc/pointy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type mockMetricSubmitter struct {
+	metricRows []*clickhouse.MetricRow
+}
+
+func (m *mockMetricSubmitter) SubmitMetricRows(_ context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error) {
+	m.metricRows = append(m.metricRows, metricRows...)
+	return nil, nil
+}
+
+func appendEmbedded(b []byte, num protowire.Number, msg []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, msg)
+}
+
+func marshalTimeSeries(labels map[string]string, samples []prometheusSample) []byte {
+	var ts []byte
+	for name, value := range labels {
+		var label []byte
+		label = protowire.AppendTag(label, 1, protowire.BytesType)
+		label = protowire.AppendString(label, name)
+		label = protowire.AppendTag(label, 2, protowire.BytesType)
+		label = protowire.AppendString(label, value)
+		ts = appendEmbedded(ts, 1, label)
+	}
+	for _, s := range samples {
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, math.Float64bits(s.Value))
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(s.Timestamp))
+		ts = appendEmbedded(ts, 2, sample)
+	}
+	return ts
+}
+
+func marshalMetadata(metricType uint64, family string) []byte {
+	var md []byte
+	md = protowire.AppendTag(md, 1, protowire.VarintType)
+	md = protowire.AppendVarint(md, metricType)
+	md = protowire.AppendTag(md, 2, protowire.BytesType)
+	md = protowire.AppendString(md, family)
+	return md
+}
+
+func newPrometheusWriteBody() []byte {
+	var req []byte
+	req = appendEmbedded(req, 1, marshalTimeSeries(map[string]string{
+		"__name__": "http_requests_total",
+		"job":      "api",
+		"method":   "GET",
+	}, []prometheusSample{{Value: 10, Timestamp: 1700000000000}, {Value: 15, Timestamp: 1700000015000}}))
+	req = appendEmbedded(req, 1, marshalTimeSeries(map[string]string{
+		"__name__":             "memory_bytes",
+		"job":                  "worker",
+		"highlight_project_id": "2",
+	}, []prometheusSample{{Value: 1024, Timestamp: 1700000000000}, {Value: math.NaN(), Timestamp: 1700000015000}}))
+	req = appendEmbedded(req, 1, marshalTimeSeries(map[string]string{
+		"__name__": "rpc_duration_seconds",
+		"quantile": "0.99",
+	}, []prometheusSample{{Value: 0.5, Timestamp: 1700000000000}}))
+	req = appendEmbedded(req, 3, marshalMetadata(prometheusTypeGauge, "memory_bytes"))
+	req = appendEmbedded(req, 3, marshalMetadata(prometheusTypeSummary, "rpc_duration_seconds"))
+	return snappy.Encode(nil, req)
+}
+
+func TestHandlePrometheusWrite(t *testing.T) {
+	submitter := &mockMetricSubmitter{}
+	metricSubmitter = submitter
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/metrics/prometheus", bytes.NewReader(newPrometheusWriteBody()))
+	r.Header.Set("Content-Encoding", "snappy")
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	r.Header.Set(LogDrainProjectHeader, "1")
+	w := httptest.NewRecorder()
+	HandlePrometheusWrite(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	assert.Len(t, submitter.metricRows, 4)
+	rows := map[string][]*clickhouse.MetricRow{}
+	for _, row := range submitter.metricRows {
+		rows[row.MetricName] = append(rows[row.MetricName], row)
+	}
+
+	counter := rows["http_requests_total"]
+	assert.Len(t, counter, 2)
+	assert.Equal(t, uint32(1), counter[0].ProjectId)
+	assert.Equal(t, "api", counter[0].ServiceName)
+	assert.Equal(t, clickhouse.MetricTypeSum, counter[0].MetricType)
+	assert.Equal(t, clickhouse.MetricTemporalityCumulative, counter[0].Temporality)
+	assert.True(t, counter[0].IsMonotonic)
+	assert.Equal(t, map[string]string{"job": "api", "method": "GET"}, counter[0].Attributes)
+	assert.Equal(t, 15., counter[1].Value)
+	assert.Equal(t, int64(1700000015000), counter[1].Timestamp.UnixMilli())
+
+	gauge := rows["memory_bytes"]
+	assert.Len(t, gauge, 1)
+	assert.Equal(t, uint32(2), gauge[0].ProjectId)
+	assert.Equal(t, clickhouse.MetricTypeGauge, gauge[0].MetricType)
+	assert.Equal(t, 1024., gauge[0].Value)
+	assert.NotContains(t, gauge[0].Attributes, "highlight_project_id")
+
+	quantile := rows["rpc_duration_seconds"]
+	assert.Len(t, quantile, 1)
+	assert.Equal(t, clickhouse.MetricTypeSummary, quantile[0].MetricType)
+	assert.Equal(t, "0.99", quantile[0].Attributes["quantile"])
+}
+
+func TestHandlePrometheusWriteWithoutProject(t *testing.T) {
+	submitter := &mockMetricSubmitter{}
+	metricSubmitter = submitter
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/metrics/prometheus", bytes.NewReader(newPrometheusWriteBody()))
+	w := httptest.NewRecorder()
+	HandlePrometheusWrite(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	// only the series with the project label is ingested
+	assert.Len(t, submitter.metricRows, 1)
+
+	r = httptest.NewRequest(http.MethodPost, "/v1/metrics/prometheus", bytes.NewReader([]byte("not snappy")))
+	w = httptest.NewRecorder()
+	HandlePrometheusWrite(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// readMetricIncrease evaluates the increase of the metric rows of each group of a query, summing the
+// increase of the series of a group, as clickhouse evaluates the increase of cumulative metrics.
+func readMetricIncrease(metricRows []*clickhouse.MetricRow) promql.MetricsReader {
+	return func(ctx context.Context, input clickhouse.ReadMetricsInput) (*modelInputs.MetricsBuckets, error) {
+		first := map[string]*clickhouse.MetricRow{}
+		last := map[string]*clickhouse.MetricRow{}
+		for _, row := range metricRows {
+			if row.MetricName != input.Column {
+				continue
+			}
+			var series []string
+			for k, v := range row.Attributes {
+				series = append(series, k+"="+v)
+			}
+			sort.Strings(series)
+			key := strings.Join(series, ",")
+			if _, ok := first[key]; !ok {
+				first[key] = row
+			}
+			last[key] = row
+		}
+
+		buckets := map[string]*modelInputs.MetricBucket{}
+		result := &modelInputs.MetricsBuckets{}
+		for key, row := range last {
+			var group []string
+			for _, label := range input.GroupBy {
+				group = append(group, row.Attributes[label])
+			}
+			bucket, ok := buckets[strings.Join(group, ",")]
+			if !ok {
+				bucket = &modelInputs.MetricBucket{Group: group, MetricValue: pointy.Float64(0)}
+				buckets[strings.Join(group, ",")] = bucket
+				result.Buckets = append(result.Buckets, bucket)
+			}
+			*bucket.MetricValue += row.Value - first[key].Value
+		}
+		return result, nil
+	}
+}
+
+func TestPrometheusHistogramQuantile(t *testing.T) {
+	submitter := &mockMetricSubmitter{}
+	metricSubmitter = submitter
+
+	var req []byte
+	for le, counts := range map[string][2]float64{"0.25": {2, 3}, "1": {4, 7}, "2": {5, 9}, "+Inf": {5, 9}} {
+		req = appendEmbedded(req, 1, marshalTimeSeries(map[string]string{
+			"__name__": "request_duration_seconds_bucket",
+			"job":      "api",
+			"le":       le,
+		}, []prometheusSample{{Value: counts[0], Timestamp: 1700000000000}, {Value: counts[1], Timestamp: 1700000015000}}))
+	}
+	req = appendEmbedded(req, 3, marshalMetadata(prometheusTypeHistogram, "request_duration_seconds"))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/metrics/prometheus", bytes.NewReader(snappy.Encode(nil, req)))
+	r.Header.Set(LogDrainProjectHeader, "1")
+	w := httptest.NewRecorder()
+	HandlePrometheusWrite(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+
+	// the bucket increases are 1, 3, 4 and 4, so the median is in the middle of the (0.25, 1] bucket
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/query?"+url.Values{
+		"query": {`histogram_quantile(0.5, sum by (job, le) (increase(request_duration_seconds_bucket[5m])))`},
+		"time":  {"1700000015"},
+	}.Encode(), nil)
+	w = httptest.NewRecorder()
+	promql.HandleQuery(w, r, 1, readMetricIncrease(submitter.metricRows))
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"job":"api"},"value":[1700000015,"0.625"]}
+	]}}`, w.Body.String())
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+	log "github.com/sirupsen/logrus"
+)
+
+const SplunkAuthorizationPrefix = "Splunk "
+
+// event metadata recorded as log attributes
+const (
+	SplunkHostAttribute       = "host"
+	SplunkSourceAttribute     = "source"
+	SplunkSourceTypeAttribute = "sourcetype"
+	SplunkIndexAttribute      = "index"
+)
+
+// splunkResponse is the body of every hec response, with the status codes documented by splunk.
+type splunkResponse struct {
+	Text               string `json:"text"`
+	Code               int    `json:"code"`
+	InvalidEventNumber *int   `json:"invalid-event-number,omitempty"`
+}
+
+var (
+	splunkSuccess       = splunkResponse{Text: "Success", Code: 0}
+	splunkTokenRequired = splunkResponse{Text: "Token is required", Code: 2}
+	splunkInvalidToken  = splunkResponse{Text: "Invalid token", Code: 4}
+	splunkNoData        = splunkResponse{Text: "No data", Code: 5}
+	splunkInvalidFormat = splunkResponse{Text: "Invalid data format", Code: 6}
+	splunkServerBusy    = splunkResponse{Text: "Server is busy", Code: 9}
+	splunkEventRequired = splunkResponse{Text: "Event field is required", Code: 12}
+	splunkEventBlank    = splunkResponse{Text: "Event field cannot be blank", Code: 13}
+	splunkHealthy       = splunkResponse{Text: "HEC is healthy", Code: 17}
+)
+
+// event fields holding the level of a log
+var splunkLevelFields = []string{"level", "severity"}
+
+type splunkEvent struct {
+	Time       json.RawMessage            `json:"time"`
+	Host       string                     `json:"host"`
+	Source     string                     `json:"source"`
+	SourceType string                     `json:"sourcetype"`
+	Index      string                     `json:"index"`
+	Event      json.RawMessage            `json:"event"`
+	Fields     map[string]json.RawMessage `json:"fields"`
+}
+
+func writeSplunkResponse(w http.ResponseWriter, status int, resp splunkResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// getSplunkProjectID resolves the project from the `Authorization: Splunk <token>` header, where the token is the project id.
+// Writes the hec error response when the token is missing or invalid.
+func getSplunkProjectID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), SplunkAuthorizationPrefix)
+	if !found || token == "" {
+		writeSplunkResponse(w, http.StatusUnauthorized, splunkTokenRequired)
+		return 0, false
+	}
+	projectID, err := model2.FromVerboseID(strings.TrimSpace(token))
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", token).Error("failed to parse highlight project id from splunk hec token")
+		writeSplunkResponse(w, http.StatusForbidden, splunkInvalidToken)
+		return 0, false
+	}
+	return projectID, true
+}
+
+// parseSplunkTime parses the event time, in epoch seconds with an optional fraction, as a number or a string.
+// The fraction is parsed as digits rather than a float to keep the precision of nanosecond timestamps.
+func parseSplunkTime(raw json.RawMessage) time.Time {
+	value := strings.Trim(string(raw), `"`)
+	secs, frac, _ := strings.Cut(value, ".")
+	digits := strings.Trim(frac, "0123456789") == ""
+	if len(frac) > 9 {
+		frac = frac[:9]
+	}
+	seconds, err := strconv.ParseInt(secs, 10, 64)
+	nanos, fracErr := strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+	if err == nil && fracErr == nil && digits && seconds > 0 {
+		return time.Unix(seconds, int64(nanos))
+	}
+
+	// numbers in exponent notation
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f <= 0 {
+		return time.Now()
+	}
+	return time.UnixMilli(int64(f * 1000))
+}
+
+// getSplunkLog converts a hec event into a highlight log. String events become the log message,
+// while json events are flattened into attributes with the message picked out of their message field.
+func getSplunkLog(event *splunkEvent) hlog.Log {
+	lg := hlog.Log{
+		Level:      model.LogLevelInfo.String(),
+		Timestamp:  parseSplunkTime(event.Time).UTC().Format(hlog.TimestampFormatNano),
+		Attributes: map[string]string{},
+	}
+
+	var message string
+	var body map[string]interface{}
+	if err := json.Unmarshal(event.Event, &message); err == nil {
+		lg.Message = message
+	} else if err := json.Unmarshal(event.Event, &body); err == nil {
+		for k, v := range body {
+			for key, value := range hlog.FormatLogAttributes(k, v) {
+				lg.Attributes[key] = value
+			}
+		}
+		lg.Message = popLogAttribute(lg.Attributes, logMessageFields)
+		if lg.Message == "" {
+			lg.Message = string(event.Event)
+		}
+	} else {
+		lg.Message = string(event.Event)
+	}
+
+	// indexed fields are strings or arrays of strings
+	for k, raw := range event.Fields {
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			continue
+		}
+		if values, ok := v.([]interface{}); ok {
+			strs := make([]string, 0, len(values))
+			for _, value := range values {
+				if s, ok := value.(string); ok {
+					strs = append(strs, s)
+				}
+			}
+			v = strings.Join(strs, ",")
+		}
+		for key, value := range hlog.FormatLogAttributes(k, v) {
+			lg.Attributes[key] = value
+		}
+	}
+
+	if level := popLogAttribute(lg.Attributes, splunkLevelFields); level != "" {
+		lg.Level = strings.ToLower(level)
+	}
+	for k, v := range map[string]string{
+		SplunkHostAttribute:       event.Host,
+		SplunkSourceAttribute:     event.Source,
+		SplunkSourceTypeAttribute: event.SourceType,
+		SplunkIndexAttribute:      event.Index,
+	} {
+		if v != "" {
+			lg.Attributes[k] = v
+		}
+	}
+	return lg
+}
+
+// HandleSplunkEvent implements the splunk http event collector event endpoint,
+// accepting a batch of concatenated json events.
+func HandleSplunkEvent(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := getSplunkProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	requestBody, err := getBody(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid splunk hec gzip")
+		writeSplunkResponse(w, http.StatusBadRequest, splunkInvalidFormat)
+		return
+	}
+
+	// validate the entire batch before submitting, as splunk rejects the batch from the first invalid event
+	var events []*splunkEvent
+	decoder := json.NewDecoder(requestBody)
+	for {
+		var event splunkEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			log.WithContext(r.Context()).WithError(err).Error("invalid splunk hec event")
+			resp := splunkInvalidFormat
+			resp.InvalidEventNumber = ptr.Int(len(events))
+			writeSplunkResponse(w, http.StatusBadRequest, resp)
+			return
+		}
+		var resp *splunkResponse
+		switch string(event.Event) {
+		case "", "null":
+			resp = &splunkEventRequired
+		case `""`:
+			resp = &splunkEventBlank
+		}
+		if resp != nil {
+			invalid := *resp
+			invalid.InvalidEventNumber = ptr.Int(len(events))
+			writeSplunkResponse(w, http.StatusBadRequest, invalid)
+			return
+		}
+		events = append(events, &event)
+	}
+	if len(events) == 0 {
+		writeSplunkResponse(w, http.StatusBadRequest, splunkNoData)
+		return
+	}
+
+	for _, event := range events {
+		if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, getSplunkLog(event)); err != nil {
+			log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+			writeSplunkResponse(w, http.StatusServiceUnavailable, splunkServerBusy)
+			return
+		}
+	}
+	writeSplunkResponse(w, http.StatusOK, splunkSuccess)
+}
+
+// HandleSplunkRaw implements the splunk http event collector raw endpoint, where every line of the body is an event.
+// The event metadata is provided by the host, source, sourcetype and index query string parameters.
+func HandleSplunkRaw(w http.ResponseWriter, r *http.Request) {
+	projectID, ok := getSplunkProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	requestBody, err := getBody(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid splunk hec gzip")
+		writeSplunkResponse(w, http.StatusBadRequest, splunkInvalidFormat)
+		return
+	}
+
+	// read the entire batch before submitting, so that an invalid body does not submit part of the batch
+	qs := r.URL.Query()
+	var logs []hlog.Log
+	scanner := bufio.NewScanner(requestBody)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		raw, _ := json.Marshal(line)
+		logs = append(logs, getSplunkLog(&splunkEvent{
+			Event:      raw,
+			Host:       qs.Get(SplunkHostAttribute),
+			Source:     qs.Get(SplunkSourceAttribute),
+			SourceType: qs.Get(SplunkSourceTypeAttribute),
+			Index:      qs.Get(SplunkIndexAttribute),
+		}))
+	}
+	if err := scanner.Err(); err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid splunk hec raw body")
+		writeSplunkResponse(w, http.StatusBadRequest, splunkInvalidFormat)
+		return
+	}
+	if len(logs) == 0 {
+		writeSplunkResponse(w, http.StatusBadRequest, splunkNoData)
+		return
+	}
+
+	for _, lg := range logs {
+		if err := hlog.SubmitHTTPLog(r.Context(), tracer, projectID, lg); err != nil {
+			log.WithContext(r.Context()).WithError(err).Error("failed to submit log")
+			writeSplunkResponse(w, http.StatusServiceUnavailable, splunkServerBusy)
+			return
+		}
+	}
+	writeSplunkResponse(w, http.StatusOK, splunkSuccess)
+}
+
+// HandleSplunkHealth responds to the health checks of hec clients.
+func HandleSplunkHealth(w http.ResponseWriter, r *http.Request) {
+	writeSplunkResponse(w, http.StatusOK, splunkHealthy)
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const SplunkEventBatchJson = `{"time":1728339512.123,"host":"fw-01","source":"firewall","sourcetype":"cisco:asa","index":"network","event":"%ASA-6-302013: Built outbound TCP connection","fields":{"region":"us-east-2","tags":["edge","prod"]}}
+{"time":"1728339513","sourcetype":"_json","event":{"message":"config reloaded","level":"WARN","device":{"model":"asa5506"}}}`
+
+func TestGetSplunkLog(t *testing.T) {
+	lg := getSplunkLog(&splunkEvent{
+		Time:       []byte(`1728339512.123`),
+		Host:       "fw-01",
+		SourceType: "cisco:asa",
+		Event:      []byte(`"%ASA-6-302013: Built outbound TCP connection"`),
+		Fields:     map[string]json.RawMessage{"region": []byte(`"us-east-2"`), "tags": []byte(`["edge","prod"]`)},
+	})
+	assert.Equal(t, "%ASA-6-302013: Built outbound TCP connection", lg.Message)
+	assert.Equal(t, "info", lg.Level)
+	assert.Equal(t, "2024-10-07T22:18:32.123Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{"host": "fw-01", "sourcetype": "cisco:asa", "region": "us-east-2", "tags": "edge,prod"}, lg.Attributes)
+
+	lg = getSplunkLog(&splunkEvent{
+		Time:  []byte(`"1728339513"`),
+		Event: []byte(`{"message":"config reloaded","level":"WARN","device":{"model":"asa5506"}}`),
+	})
+	assert.Equal(t, "config reloaded", lg.Message)
+	assert.Equal(t, "warn", lg.Level)
+	assert.Equal(t, "2024-10-07T22:18:33Z", lg.Timestamp)
+	assert.Equal(t, map[string]string{"device.model": "asa5506"}, lg.Attributes)
+
+	assert.Equal(t, time.Unix(1728339512, 123456789), parseSplunkTime([]byte(`1728339512.123456789`)))
+	assert.Equal(t, time.Unix(1728339512, 0), parseSplunkTime([]byte(`1.728339512e9`)))
+}
+
+func TestHandleSplunkEvent(t *testing.T) {
+	for _, tc := range []struct {
+		authorization string
+		body          string
+		status        int
+		response      string
+	}{
+		{"Splunk 1", SplunkEventBatchJson, http.StatusOK, `{"text":"Success","code":0}`},
+		{"", SplunkEventBatchJson, http.StatusUnauthorized, `{"text":"Token is required","code":2}`},
+		{"Splunk not a project", SplunkEventBatchJson, http.StatusForbidden, `{"text":"Invalid token","code":4}`},
+		{"Splunk 1", "", http.StatusBadRequest, `{"text":"No data","code":5}`},
+		{"Splunk 1", `{"event":"a"}{"host":"b"}`, http.StatusBadRequest, `{"text":"Event field is required","code":12,"invalid-event-number":1}`},
+		{"Splunk 1", `{"event":""}`, http.StatusBadRequest, `{"text":"Event field cannot be blank","code":13,"invalid-event-number":0}`},
+		{"Splunk 1", `{"event":`, http.StatusBadRequest, `{"text":"Invalid data format","code":6,"invalid-event-number":0}`},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/services/collector/event", strings.NewReader(tc.body))
+		if tc.authorization != "" {
+			r.Header.Set("Authorization", tc.authorization)
+		}
+		w := httptest.NewRecorder()
+		HandleSplunkEvent(w, r)
+		assert.Equal(t, tc.status, w.Code, tc.body)
+		assert.JSONEq(t, tc.response, w.Body.String())
+	}
+}
+
+func TestHandleSplunkRaw(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/services/collector/raw?host=fw-01&sourcetype=syslog", strings.NewReader("first line\n\nsecond line\n"))
+	r.Header.Set("Authorization", "Splunk 1")
+	w := httptest.NewRecorder()
+	HandleSplunkRaw(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"text":"Success","code":0}`, w.Body.String())
+
+	r = httptest.NewRequest(http.MethodPost, "/services/collector/raw", strings.NewReader("first line\n"+strings.Repeat("a", 2*1024*1024)))
+	r.Header.Set("Authorization", "Splunk 1")
+	w = httptest.NewRecorder()
+	HandleSplunkRaw(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"text":"Invalid data format","code":6}`, w.Body.String())
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	highlight "github.com/highlight/highlight/sdk/highlight-go"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// span attributes setting the status of spans converted from other tracing formats
+const (
+	ErrorAttribute                 = "error"
+	OtelStatusCodeAttribute        = "otel.status_code"
+	OtelStatusDescriptionAttribute = "otel.status_description"
+)
+
+type TraceRowSubmitter interface {
+	SubmitTraceRows(ctx context.Context, traceRows []*clickhouse.TraceRow) (int64, error)
+}
+
+// getTraceProjectID resolves the project of a trace request from the project header,
+// the query string parameter or the username of the basic auth credentials.
+func getTraceProjectID(r *http.Request) int {
+	projectVerboseID := r.Header.Get(LogDrainProjectHeader)
+	if projectVerboseID == "" {
+		projectVerboseID = r.URL.Query().Get(LogDrainProjectQueryParam)
+	}
+	if projectVerboseID == "" {
+		projectVerboseID, _, _ = r.BasicAuth()
+	}
+	if projectVerboseID == "" {
+		return 0
+	}
+	projectID, err := model2.FromVerboseID(projectVerboseID)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).WithField("projectVerboseID", projectVerboseID).Error("failed to parse highlight project id from trace request")
+		return 0
+	}
+	return projectID
+}
+
+// newTraceRow creates the trace row of a span converted from another tracing format. Like otel spans,
+// the project, session, environment and service version are set by the attributes of the span.
+// Returns nil when the span has no project.
+func newTraceRow(ctx context.Context, requestProjectID int, start time.Time, end time.Time, attributes map[string]string) *clickhouse.TraceRow {
+	projectID := requestProjectID
+	for _, key := range []string{highlight.DeprecatedProjectIDAttribute, highlight.ProjectIDAttribute} {
+		if projectVerboseID, ok := attributes[key]; ok {
+			delete(attributes, key)
+			if attributeProjectID, err := model2.FromVerboseID(projectVerboseID); err == nil {
+				projectID = attributeProjectID
+			} else {
+				log.WithContext(ctx).WithError(err).WithField("projectVerboseID", projectVerboseID).Warn("invalid highlight project id span attribute")
+			}
+		}
+	}
+	if projectID == 0 {
+		return nil
+	}
+
+	var sessionID string
+	for _, key := range []string{highlight.DeprecatedSessionIDAttribute, highlight.SessionIDAttribute} {
+		if v, ok := attributes[key]; ok {
+			sessionID = v
+			delete(attributes, key)
+		}
+	}
+	environment := attributes[string(semconv.DeploymentEnvironmentKey)]
+	delete(attributes, string(semconv.DeploymentEnvironmentKey))
+	serviceVersion := attributes[string(semconv.ServiceVersionKey)]
+	delete(attributes, string(semconv.ServiceVersionKey))
+
+	statusCode, statusMessage := getSpanStatus(attributes)
+	return clickhouse.NewTraceRow(start, projectID).
+		WithSecureSessionId(sessionID).
+		WithDuration(start, end).
+		WithEnvironment(environment).
+		WithServiceVersion(serviceVersion).
+		WithStatusCode(statusCode.String()).
+		WithStatusMessage(statusMessage).
+		WithTraceAttributes(attributes)
+}
+
+// getSpanStatus returns the status of a span from the otel status attributes set by otel bridges,
+// or the error tag set by zipkin and jaeger instrumentation.
+func getSpanStatus(attributes map[string]string) (ptrace.StatusCode, string) {
+	statusMessage := attributes[OtelStatusDescriptionAttribute]
+	delete(attributes, OtelStatusDescriptionAttribute)
+	if statusCode, ok := attributes[OtelStatusCodeAttribute]; ok {
+		delete(attributes, OtelStatusCodeAttribute)
+		switch strings.ToUpper(statusCode) {
+		case "OK":
+			return ptrace.StatusCodeOk, statusMessage
+		case "ERROR":
+			return ptrace.StatusCodeError, statusMessage
+		}
+	}
+	if err, ok := attributes[ErrorAttribute]; ok && err != "false" {
+		// zipkin error tags hold the error message, while jaeger error tags are booleans
+		if statusMessage == "" && err != "true" {
+			statusMessage = err
+		}
+		return ptrace.StatusCodeError, statusMessage
+	}
+	return ptrace.StatusCodeUnset, statusMessage
+}
+
+// writeTraceRows submits the converted spans, responding with a 202 like the zipkin and jaeger collectors.
+func writeTraceRows(w http.ResponseWriter, r *http.Request, traceRows []*clickhouse.TraceRow, dropped int, format string) {
+	if len(traceRows) == 0 && dropped > 0 {
+		http.Error(w, "no highlight project provided via header, query string parameter or span attribute", http.StatusBadRequest)
+		return
+	}
+	if dropped > 0 {
+		log.WithContext(r.Context()).WithField("dropped", dropped).WithField("format", format).Warn("dropped spans without a highlight project")
+	}
+
+	if len(traceRows) > 0 {
+		rejected, err := traceSubmitter.SubmitTraceRows(r.Context(), traceRows)
+		if err != nil {
+			log.WithContext(r.Context()).WithError(err).WithField("format", format).Error("failed to submit spans")
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		if rejected > 0 {
+			log.WithContext(r.Context()).WithField("rejected", rejected).WithField("format", format).Info("rejected spans")
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// getSpanKind returns the otel span kind of a zipkin kind or jaeger span.kind tag.
+func getSpanKind(kind string) ptrace.SpanKind {
+	switch strings.ToLower(kind) {
+	case "client":
+		return ptrace.SpanKindClient
+	case "server":
+		return ptrace.SpanKindServer
+	case "producer":
+		return ptrace.SpanKindProducer
+	case "consumer":
+		return ptrace.SpanKindConsumer
+	}
+	return ptrace.SpanKindInternal
+}
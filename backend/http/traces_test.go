@@ -0,0 +1,283 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const ZipkinSpansJson = `[{"traceId":"5af7183fb1d4cf5f","parentId":"6b221d5bc9e6496c","id":"352bff9a74ca9ad2","kind":"CLIENT","name":"get /api","timestamp":1556604172355737,"duration":1431,"localEndpoint":{"serviceName":"frontend","ipv4":"192.168.99.1"},"remoteEndpoint":{"serviceName":"backend","ipv4":"172.19.0.2","port":8080},"annotations":[{"timestamp":1556604172355800,"value":"wr"}],"tags":{"http.method":"GET","http.path":"/api","error":"connection refused","deployment.environment":"prod"}},
+{"traceId":"5af7183fb1d4cf5f","id":"6b221d5bc9e6496c","name":"root","timestamp":1556604172355000,"duration":2000,"localEndpoint":{"serviceName":"frontend"},"tags":{"highlight.project_id":"2"}}]`
+
+type mockTraceSubmitter struct {
+	traceRows []*clickhouse.TraceRow
+}
+
+func (m *mockTraceSubmitter) SubmitTraceRows(_ context.Context, traceRows []*clickhouse.TraceRow) (int64, error) {
+	m.traceRows = append(m.traceRows, traceRows...)
+	return 0, nil
+}
+
+// thriftWriter encodes the thrift binary protocol for jaeger test batches.
+type thriftWriter struct {
+	bytes.Buffer
+}
+
+func (t *thriftWriter) field(typ byte, id int16) {
+	t.WriteByte(typ)
+	_ = binary.Write(t, binary.BigEndian, id)
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(thriftI64, id)
+	_ = binary.Write(t, binary.BigEndian, v)
+}
+
+func (t *thriftWriter) string(id int16, v string) {
+	t.field(thriftString, id)
+	_ = binary.Write(t, binary.BigEndian, int32(len(v)))
+	t.WriteString(v)
+}
+
+func (t *thriftWriter) list(id int16, n int) {
+	t.field(thriftList, id)
+	t.WriteByte(thriftStruct)
+	_ = binary.Write(t, binary.BigEndian, int32(n))
+}
+
+func (t *thriftWriter) stringTag(key string, value string) {
+	t.string(1, key)
+	t.field(thriftI32, 2)
+	_ = binary.Write(t, binary.BigEndian, int32(jaegerTagString))
+	t.string(3, value)
+	t.WriteByte(thriftStop)
+}
+
+func (t *thriftWriter) boolTag(key string, value bool) {
+	t.string(1, key)
+	t.field(thriftI32, 2)
+	_ = binary.Write(t, binary.BigEndian, int32(jaegerTagBool))
+	t.field(thriftBool, 5)
+	if value {
+		t.WriteByte(1)
+	} else {
+		t.WriteByte(0)
+	}
+	t.WriteByte(thriftStop)
+}
+
+func newJaegerBatchBody() []byte {
+	t := &thriftWriter{}
+	// process
+	t.field(thriftStruct, 1)
+	t.string(1, "checkout")
+	t.list(2, 1)
+	t.stringTag("hostname", "checkout-1")
+	t.WriteByte(thriftStop)
+
+	t.list(2, 1)
+	t.i64(1, 0x1122334455667788)
+	t.i64(2, 0x0102030405060708)
+	t.i64(3, 0x0a0b0c0d0e0f1011)
+	t.i64(4, 0)
+	t.string(5, "charge card")
+	// a child of reference setting the parent and a follows from reference
+	t.list(6, 2)
+	t.field(thriftI32, 1)
+	_ = binary.Write(t, binary.BigEndian, int32(0))
+	t.i64(4, 0x00000000000000ff)
+	t.WriteByte(thriftStop)
+	t.field(thriftI32, 1)
+	_ = binary.Write(t, binary.BigEndian, int32(jaegerRefFollowsFrom))
+	t.i64(2, 1)
+	t.i64(3, 2)
+	t.i64(4, 3)
+	t.WriteByte(thriftStop)
+	// an unknown flags field is skipped
+	t.field(thriftI32, 7)
+	_ = binary.Write(t, binary.BigEndian, int32(1))
+	t.i64(8, 1700000000000000)
+	t.i64(9, 250000)
+	t.list(10, 3)
+	t.stringTag("span.kind", "server")
+	t.boolTag("error", true)
+	t.stringTag("highlight.project_id", "1")
+	t.list(11, 1)
+	t.i64(1, 1700000000100000)
+	t.list(2, 2)
+	t.stringTag("event", "retry")
+	t.stringTag("attempt", "2")
+	t.WriteByte(thriftStop)
+	t.WriteByte(thriftStop)
+
+	t.WriteByte(thriftStop)
+	return t.Bytes()
+}
+
+func TestGetSpanStatus(t *testing.T) {
+	for _, tc := range []struct {
+		attributes map[string]string
+		code       ptrace.StatusCode
+		message    string
+	}{
+		{map[string]string{}, ptrace.StatusCodeUnset, ""},
+		{map[string]string{"error": "timeout"}, ptrace.StatusCodeError, "timeout"},
+		{map[string]string{"error": "true"}, ptrace.StatusCodeError, ""},
+		{map[string]string{"error": "false"}, ptrace.StatusCodeUnset, ""},
+		{map[string]string{"otel.status_code": "OK", "error": "true"}, ptrace.StatusCodeOk, ""},
+		{map[string]string{"otel.status_code": "ERROR", "otel.status_description": "boom"}, ptrace.StatusCodeError, "boom"},
+	} {
+		code, message := getSpanStatus(tc.attributes)
+		assert.Equal(t, tc.code, code)
+		assert.Equal(t, tc.message, message)
+	}
+}
+
+func TestHandleZipkinSpans(t *testing.T) {
+	submitter := &mockTraceSubmitter{}
+	traceSubmitter = submitter
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/spans?project=1", strings.NewReader(ZipkinSpansJson))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	HandleZipkinSpans(w, r)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Len(t, submitter.traceRows, 2)
+
+	client := submitter.traceRows[0]
+	assert.Equal(t, uint32(1), client.ProjectId)
+	assert.Equal(t, "00000000000000005af7183fb1d4cf5f", client.TraceId)
+	assert.Equal(t, "352bff9a74ca9ad2", client.SpanId)
+	assert.Equal(t, "6b221d5bc9e6496c", client.ParentSpanId)
+	assert.Equal(t, "get /api", client.SpanName)
+	assert.Equal(t, ptrace.SpanKindClient.String(), client.SpanKind)
+	assert.Equal(t, "frontend", client.ServiceName)
+	assert.Equal(t, "prod", client.Environment)
+	assert.Equal(t, ptrace.StatusCodeError.String(), client.StatusCode)
+	assert.Equal(t, "connection refused", client.StatusMessage)
+	assert.Equal(t, int64(1431*time.Microsecond), client.Duration)
+	assert.Equal(t, "backend", client.TraceAttributes["peer.service"])
+	assert.Equal(t, "172.19.0.2", client.TraceAttributes["network.peer.address"])
+	assert.Equal(t, "8080", client.TraceAttributes["network.peer.port"])
+	assert.Len(t, client.Events, 1)
+	assert.Equal(t, "wr", client.Events[0].Name)
+
+	root := submitter.traceRows[1]
+	assert.Equal(t, uint32(2), root.ProjectId)
+	assert.Equal(t, "", root.ParentSpanId)
+	assert.Equal(t, ptrace.SpanKindInternal.String(), root.SpanKind)
+	assert.NotContains(t, root.TraceAttributes, "highlight.project_id")
+}
+
+func TestHandleZipkinSpansProtobuf(t *testing.T) {
+	submitter := &mockTraceSubmitter{}
+	traceSubmitter = submitter
+
+	var endpoint []byte
+	endpoint = protowire.AppendTag(endpoint, 1, protowire.BytesType)
+	endpoint = protowire.AppendString(endpoint, "frontend")
+	endpoint = protowire.AppendTag(endpoint, 2, protowire.BytesType)
+	endpoint = protowire.AppendBytes(endpoint, []byte{10, 0, 0, 1})
+
+	var tag []byte
+	tag = protowire.AppendTag(tag, 1, protowire.BytesType)
+	tag = protowire.AppendString(tag, "http.method")
+	tag = protowire.AppendTag(tag, 2, protowire.BytesType)
+	tag = protowire.AppendString(tag, "GET")
+
+	var span []byte
+	span = protowire.AppendTag(span, 1, protowire.BytesType)
+	span = protowire.AppendBytes(span, []byte{0x5a, 0xf7, 0x18, 0x3f, 0xb1, 0xd4, 0xcf, 0x5f, 0x5a, 0xf7, 0x18, 0x3f, 0xb1, 0xd4, 0xcf, 0x5f})
+	span = protowire.AppendTag(span, 3, protowire.BytesType)
+	span = protowire.AppendBytes(span, []byte{0x35, 0x2b, 0xff, 0x9a, 0x74, 0xca, 0x9a, 0xd2})
+	span = protowire.AppendTag(span, 4, protowire.VarintType)
+	span = protowire.AppendVarint(span, 2)
+	span = protowire.AppendTag(span, 5, protowire.BytesType)
+	span = protowire.AppendString(span, "get /api")
+	span = protowire.AppendTag(span, 6, protowire.Fixed64Type)
+	span = protowire.AppendFixed64(span, 1556604172355737)
+	span = protowire.AppendTag(span, 7, protowire.VarintType)
+	span = protowire.AppendVarint(span, 1431)
+	span = appendEmbedded(span, 8, endpoint)
+	span = appendEmbedded(span, 11, tag)
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v2/spans", bytes.NewReader(appendEmbedded(nil, 1, span)))
+	r.Header.Set("Content-Type", "application/x-protobuf")
+	r.Header.Set(LogDrainProjectHeader, "1")
+	w := httptest.NewRecorder()
+	HandleZipkinSpans(w, r)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Len(t, submitter.traceRows, 1)
+
+	row := submitter.traceRows[0]
+	assert.Equal(t, "5af7183fb1d4cf5f5af7183fb1d4cf5f", row.TraceId)
+	assert.Equal(t, "352bff9a74ca9ad2", row.SpanId)
+	assert.Equal(t, ptrace.SpanKindServer.String(), row.SpanKind)
+	assert.Equal(t, "frontend", row.ServiceName)
+	assert.Equal(t, map[string]string{"http.method": "GET", "network.local.address": "10.0.0.1"}, row.TraceAttributes)
+	assert.Equal(t, int64(1556604172355737), row.Timestamp.UnixMicro())
+}
+
+func TestHandleJaegerThrift(t *testing.T) {
+	submitter := &mockTraceSubmitter{}
+	traceSubmitter = submitter
+
+	r := httptest.NewRequest(http.MethodPost, "/api/traces", bytes.NewReader(newJaegerBatchBody()))
+	r.Header.Set("Content-Type", "application/x-thrift")
+	w := httptest.NewRecorder()
+	HandleJaegerThrift(w, r)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Len(t, submitter.traceRows, 1)
+
+	row := submitter.traceRows[0]
+	assert.Equal(t, uint32(1), row.ProjectId)
+	assert.Equal(t, "01020304050607081122334455667788", row.TraceId)
+	assert.Equal(t, "0a0b0c0d0e0f1011", row.SpanId)
+	assert.Equal(t, "00000000000000ff", row.ParentSpanId)
+	assert.Equal(t, "charge card", row.SpanName)
+	assert.Equal(t, "checkout", row.ServiceName)
+	assert.Equal(t, ptrace.SpanKindServer.String(), row.SpanKind)
+	assert.Equal(t, ptrace.StatusCodeError.String(), row.StatusCode)
+	assert.Equal(t, int64(250*time.Millisecond), row.Duration)
+	assert.Equal(t, map[string]string{"hostname": "checkout-1", "error": "true"}, row.TraceAttributes)
+	assert.Len(t, row.Events, 1)
+	assert.Equal(t, "retry", row.Events[0].Name)
+	assert.Equal(t, map[string]string{"attempt": "2"}, row.Events[0].Attributes)
+	assert.Len(t, row.Links, 1)
+	assert.Equal(t, "00000000000000020000000000000001", row.Links[0].TraceId)
+
+	r = httptest.NewRequest(http.MethodPost, "/api/traces", bytes.NewReader([]byte{thriftStruct, 0}))
+	w = httptest.NewRecorder()
+	HandleJaegerThrift(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestThriftReaderLimits(t *testing.T) {
+	// counts larger than the rest of the payload are rejected before reading or allocating elements
+	_, err := (&thriftReader{b: []byte{thriftStruct, 0x00, 0xff, 0xff, 0xff, thriftStop}}).readTags()
+	assert.ErrorContains(t, err, "invalid thrift container")
+	err = (&thriftReader{b: []byte{thriftI32, thriftI32, 0x00, 0xff, 0xff, 0xff}}).skip(thriftMap)
+	assert.ErrorContains(t, err, "invalid thrift container")
+
+	r := httptest.NewRequest(http.MethodPost, "/api/traces", bytes.NewReader([]byte{thriftList, 0, 2, thriftStruct, 0x00, 0xff, 0xff, 0xff, thriftStop}))
+	w := httptest.NewRecorder()
+	HandleJaegerThrift(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var nested []byte
+	for i := 0; i < 1000; i++ {
+		nested = append(nested, thriftList, 0, 0, 0, 1)
+	}
+	err = (&thriftReader{b: nested}).skip(thriftList)
+	assert.ErrorContains(t, err, "nested")
+}
@@ -0,0 +1,290 @@
+package http
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName"`
+	IPv4        string `json:"ipv4"`
+	IPv6        string `json:"ipv6"`
+	Port        int    `json:"port"`
+}
+
+type zipkinAnnotation struct {
+	Timestamp int64  `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+// zipkinSpan is a zipkin v2 span, with timestamps and durations in microseconds.
+type zipkinSpan struct {
+	TraceID        string             `json:"traceId"`
+	ParentID       string             `json:"parentId"`
+	ID             string             `json:"id"`
+	Kind           string             `json:"kind"`
+	Name           string             `json:"name"`
+	Timestamp      int64              `json:"timestamp"`
+	Duration       int64              `json:"duration"`
+	LocalEndpoint  *zipkinEndpoint    `json:"localEndpoint"`
+	RemoteEndpoint *zipkinEndpoint    `json:"remoteEndpoint"`
+	Annotations    []zipkinAnnotation `json:"annotations"`
+	Tags           map[string]string  `json:"tags"`
+}
+
+// zipkin proto3 span kinds
+var zipkinProtoKinds = map[uint64]string{1: "CLIENT", 2: "SERVER", 3: "PRODUCER", 4: "CONSUMER"}
+
+func consumeHex(typ protowire.Type, b []byte, v *string) int {
+	if typ != protowire.BytesType {
+		return 0
+	}
+	id, n := protowire.ConsumeBytes(b)
+	if n >= 0 {
+		*v = hex.EncodeToString(id)
+	}
+	return n
+}
+
+func (ep *zipkinEndpoint) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeString(typ, b, &ep.ServiceName)
+		case 2, 3:
+			var ip string
+			n := consumeString(typ, b, &ip)
+			if num == 2 {
+				ep.IPv4 = net.IP(ip).String()
+			} else {
+				ep.IPv6 = net.IP(ip).String()
+			}
+			return n
+		case 4:
+			var port uint64
+			n := consumeVarint(typ, b, &port)
+			ep.Port = int(port)
+			return n
+		}
+		return 0
+	})
+}
+
+func (a *zipkinAnnotation) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			if typ != protowire.Fixed64Type {
+				return 0
+			}
+			v, n := protowire.ConsumeFixed64(b)
+			a.Timestamp = int64(v)
+			return n
+		case 2:
+			return consumeString(typ, b, &a.Value)
+		}
+		return 0
+	})
+}
+
+func (s *zipkinSpan) unmarshal(b []byte) error {
+	return consumeMessage(b, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		switch num {
+		case 1:
+			return consumeHex(typ, b, &s.TraceID)
+		case 2:
+			return consumeHex(typ, b, &s.ParentID)
+		case 3:
+			return consumeHex(typ, b, &s.ID)
+		case 4:
+			var kind uint64
+			n := consumeVarint(typ, b, &kind)
+			s.Kind = zipkinProtoKinds[kind]
+			return n
+		case 5:
+			return consumeString(typ, b, &s.Name)
+		case 6:
+			if typ != protowire.Fixed64Type {
+				return 0
+			}
+			v, n := protowire.ConsumeFixed64(b)
+			s.Timestamp = int64(v)
+			return n
+		case 7:
+			var duration uint64
+			n := consumeVarint(typ, b, &duration)
+			s.Duration = int64(duration)
+			return n
+		case 8, 9:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var ep zipkinEndpoint
+				if err := ep.unmarshal(msg); err != nil {
+					return err
+				}
+				if num == 8 {
+					s.LocalEndpoint = &ep
+				} else {
+					s.RemoteEndpoint = &ep
+				}
+				return nil
+			})
+		case 10:
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var a zipkinAnnotation
+				if err := a.unmarshal(msg); err != nil {
+					return err
+				}
+				s.Annotations = append(s.Annotations, a)
+				return nil
+			})
+		case 11:
+			// map entries share the wire format of prometheus labels
+			return consumeEmbedded(typ, b, func(msg []byte) error {
+				var tag prometheusLabel
+				if err := tag.unmarshal(msg); err != nil {
+					return err
+				}
+				if s.Tags == nil {
+					s.Tags = map[string]string{}
+				}
+				s.Tags[tag.Name] = tag.Value
+				return nil
+			})
+		}
+		return 0
+	})
+}
+
+// getZipkinSpans decodes a list of spans, either json or protobuf.
+func getZipkinSpans(r *http.Request) ([]*zipkinSpan, error) {
+	requestBody, err := getBody(r)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid gzip body")
+	}
+	body, err := io.ReadAll(requestBody)
+	if err != nil {
+		return nil, e.Wrap(err, "invalid body")
+	}
+
+	var spans []*zipkinSpan
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-protobuf") {
+		if err := json.Unmarshal(body, &spans); err != nil {
+			return nil, e.Wrap(err, "invalid zipkin json")
+		}
+		return spans, nil
+	}
+
+	err = consumeMessage(body, func(num protowire.Number, typ protowire.Type, b []byte) int {
+		if num != 1 {
+			return 0
+		}
+		return consumeEmbedded(typ, b, func(msg []byte) error {
+			var span zipkinSpan
+			if err := span.unmarshal(msg); err != nil {
+				return err
+			}
+			spans = append(spans, &span)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, e.Wrap(err, "invalid zipkin protobuf")
+	}
+	return spans, nil
+}
+
+// getZipkinTraceRow converts a zipkin span into a trace row. Tags become trace attributes,
+// the remote endpoint network attributes and annotations span events.
+func getZipkinTraceRow(ctx context.Context, projectID int, span *zipkinSpan) *clickhouse.TraceRow {
+	attributes := map[string]string{}
+	for k, v := range span.Tags {
+		attributes[k] = v
+	}
+	var serviceName string
+	if ep := span.LocalEndpoint; ep != nil {
+		serviceName = ep.ServiceName
+		if ep.IPv4 != "" || ep.IPv6 != "" {
+			attributes[string(semconv.NetworkLocalAddressKey)] = ep.IPv4 + ep.IPv6
+		}
+	}
+	if ep := span.RemoteEndpoint; ep != nil {
+		if ep.ServiceName != "" {
+			attributes[string(semconv.PeerServiceKey)] = ep.ServiceName
+		}
+		if ep.IPv4 != "" || ep.IPv6 != "" {
+			attributes[string(semconv.NetworkPeerAddressKey)] = ep.IPv4 + ep.IPv6
+		}
+		if ep.Port != 0 {
+			attributes[string(semconv.NetworkPeerPortKey)] = strconv.Itoa(ep.Port)
+		}
+	}
+
+	start := time.UnixMicro(span.Timestamp)
+	row := newTraceRow(ctx, projectID, start, start.Add(time.Duration(span.Duration)*time.Microsecond), attributes)
+	if row == nil {
+		return nil
+	}
+
+	events := make([]map[string]any, 0, len(span.Annotations))
+	for _, a := range span.Annotations {
+		events = append(events, map[string]any{
+			"Timestamp":  time.UnixMicro(a.Timestamp),
+			"Name":       a.Value,
+			"Attributes": map[string]any{},
+		})
+	}
+
+	traceID := span.TraceID
+	// 64-bit trace ids are left padded like otel trace ids
+	if len(traceID) < 32 {
+		traceID = strings.Repeat("0", 32-len(traceID)) + traceID
+	}
+	return row.
+		WithTraceId(traceID).
+		WithSpanId(span.ID).
+		WithParentSpanId(span.ParentID).
+		WithSpanName(span.Name).
+		WithSpanKind(getSpanKind(span.Kind).String()).
+		WithServiceName(serviceName).
+		WithEvents(events)
+}
+
+// HandleZipkinSpans implements the zipkin v2 span collector, accepting json or protobuf lists of spans.
+func HandleZipkinSpans(w http.ResponseWriter, r *http.Request) {
+	spans, err := getZipkinSpans(r)
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("invalid zipkin spans request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	projectID := getTraceProjectID(r)
+	var traceRows []*clickhouse.TraceRow
+	var dropped int
+	for _, span := range spans {
+		if span.TraceID == "" || span.ID == "" {
+			dropped++
+			continue
+		}
+		row := getZipkinTraceRow(r.Context(), projectID, span)
+		if row == nil {
+			dropped++
+			continue
+		}
+		traceRows = append(traceRows, row)
+	}
+	writeTraceRows(w, r, traceRows, dropped, "zipkin")
+}
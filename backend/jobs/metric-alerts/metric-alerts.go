@@ -105,6 +105,8 @@ func processMetricAlert(ctx context.Context, DB *gorm.DB, MailClient *sendgrid.C
 		config = clickhouse.TracesSampleableTableConfig
 	case modelInputs.ProductTypeEvents:
 		config = clickhouse.EventsSampleableTableConfig
+	case modelInputs.ProductTypeIngestDrops:
+		config = clickhouse.IngestDropsSampleableTableConfig
 	default:
 		return errors.Errorf("Unknown product type: %s", alert.ProductType)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/highlight-run/highlight/backend/env"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -55,7 +56,10 @@ type Queue struct {
 	MessageSizeBytes int64
 	Client           *kafka.Client
 	kafkaP           *kafka.Writer
-	kafkaC           *kafka.Reader
+	// kafkaSyncP writes the batches of SubmitSync when kafkaP is async
+	kafkaSyncP *kafka.Writer
+	kafkaC     *kafka.Reader
+	stopLock   sync.Mutex
 }
 
 type MessageQueue interface {
@@ -291,6 +295,8 @@ func (p *Queue) metricPrefix() string {
 }
 
 func (p *Queue) Stop(ctx context.Context) {
+	p.stopLock.Lock()
+	defer p.stopLock.Unlock()
 	if p.kafkaC != nil {
 		if err := p.kafkaC.Close(); err != nil {
 			log.WithContext(ctx).Error(errors.Wrap(err, "failed to close reader"))
@@ -303,9 +309,28 @@ func (p *Queue) Stop(ctx context.Context) {
 		}
 		p.kafkaP = nil
 	}
+	if p.kafkaSyncP != nil {
+		if err := p.kafkaSyncP.Close(); err != nil {
+			log.WithContext(ctx).Error(errors.Wrap(err, "failed to close sync writer"))
+		}
+		p.kafkaSyncP = nil
+	}
 }
 
 func (p *Queue) Submit(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	return p.submit(ctx, p.kafkaP, partitionKey, messages...)
+}
+
+// SubmitSync writes a batch and waits for it to be written, even when the producer is async.
+func (p *Queue) SubmitSync(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	writer := p.kafkaP
+	if p.kafkaSyncP != nil {
+		writer = p.kafkaSyncP
+	}
+	return p.submit(ctx, writer, partitionKey, messages...)
+}
+
+func (p *Queue) submit(ctx context.Context, writer *kafka.Writer, partitionKey string, messages ...RetryableMessage) error {
 	if len(messages) == 0 {
 		return nil
 	}
@@ -335,7 +360,7 @@ func (p *Queue) Submit(ctx context.Context, partitionKey string, messages ...Ret
 
 	ctx, cancel := context.WithTimeout(ctx, KafkaOperationTimeout)
 	defer cancel()
-	err := p.kafkaP.WriteMessages(ctx, kMessages...)
+	err := writer.WriteMessages(ctx, kMessages...)
 	if err != nil {
 		log.WithContext(ctx).WithError(err).WithField("topic", p.Topic).WithField("partition_key", partitionKey).WithField("num_messages", len(messages)).Errorf("failed to send kafka messages")
 		return err
@@ -344,6 +369,36 @@ func (p *Queue) Submit(ctx context.Context, partitionKey string, messages ...Ret
 	return nil
 }
 
+// OnAsyncError sets the function called with the messages that an async producer fails to write
+// after Submit returned. It must be set before the first Submit.
+func (p *Queue) OnAsyncError(onError func(messages []kafka.Message, err error)) {
+	if p.kafkaP == nil || !p.kafkaP.Async {
+		return
+	}
+	p.kafkaP.Completion = func(messages []kafka.Message, err error) {
+		if err != nil {
+			onError(messages, err)
+		}
+	}
+	if p.kafkaSyncP == nil {
+		p.kafkaSyncP = &kafka.Writer{
+			Addr:         p.kafkaP.Addr,
+			Transport:    p.kafkaP.Transport,
+			Topic:        p.kafkaP.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: p.kafkaP.RequiredAcks,
+			Compression:  p.kafkaP.Compression,
+			BatchSize:    p.kafkaP.BatchSize,
+			BatchBytes:   p.kafkaP.BatchBytes,
+			BatchTimeout: p.kafkaP.BatchTimeout,
+			ReadTimeout:  p.kafkaP.ReadTimeout,
+			WriteTimeout: p.kafkaP.WriteTimeout,
+			Logger:       p.kafkaP.Logger,
+			ErrorLogger:  p.kafkaP.ErrorLogger,
+		}
+	}
+}
+
 func (p *Queue) Receive(ctx context.Context) (msg RetryableMessage) {
 	start := time.Now()
 	ctx, cancel := context.WithTimeout(ctx, KafkaOperationTimeout)
@@ -466,6 +521,10 @@ func (p *Queue) deserializeMessage(compressed []byte) (RetryableMessage, error)
 	if int64(len(compressed)) >= p.MessageSizeBytes {
 		return nil, errors.New("message too large")
 	}
+	return unmarshalMessage(compressed)
+}
+
+func unmarshalMessage(compressed []byte) (RetryableMessage, error) {
 	var msgType struct {
 		Type PayloadType
 	}
@@ -478,6 +537,8 @@ func (p *Queue) deserializeMessage(compressed []byte) (RetryableMessage, error)
 		msg = &LogRowMessage{}
 	} else if msgType.Type == PushTracesFlattened {
 		msg = &TraceRowMessage{}
+	} else if msgType.Type == PushMetricsFlattened {
+		msg = &MetricRowMessage{}
 	} else {
 		msg = &Message{}
 	}
@@ -0,0 +1,599 @@
+package kafka_queue
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/highlight-run/highlight/backend/env"
+	hmetric "github.com/highlight/highlight/sdk/highlight-go/metric"
+)
+
+// SpoolDropPolicy decides which batches are dropped once a spool is full.
+type SpoolDropPolicy string
+
+const (
+	// SpoolDropNewest rejects the batches that do not fit, keeping the spooled batches.
+	SpoolDropNewest SpoolDropPolicy = "newest"
+	// SpoolDropOldest removes the oldest segments to make room for new batches.
+	SpoolDropOldest SpoolDropPolicy = "oldest"
+)
+
+const (
+	spoolSegmentExtension   = ".wal"
+	spoolRecordHeaderBytes  = 8
+	defaultSpoolSegmentSize = 64 * 1024 * 1024
+	spoolReplayInterval     = time.Second
+	// spoolReplayBatches is the number of batches read from the spool per round of replay
+	spoolReplayBatches = 256
+	// spoolReplayConcurrency is the number of partition keys published concurrently by a round of replay
+	spoolReplayConcurrency    = 8
+	defaultSpoolDirectBatches = 100
+)
+
+var (
+	ErrSpoolFull    = errors.New("kafka spool is full")
+	ErrSpoolStopped = errors.New("kafka spool is stopped")
+)
+
+type SpoolConfig struct {
+	// Name identifies the spool in logs and metrics.
+	Name string
+	// Dir holds the segments of the spool, one file per segment.
+	Dir string
+	// MaxBytes is the maximum size of the segments of the spool.
+	MaxBytes int64
+	// SegmentBytes is the size after which a new segment is started.
+	SegmentBytes int64
+	// DirectBatches is the number of spooled batches below which new batches are published directly
+	// rather than spooled behind the batches waiting to be replayed.
+	DirectBatches int64
+	DropPolicy    SpoolDropPolicy
+}
+
+// GetSpoolConfig returns the spool configuration of a topic type, or nil when spooling is disabled.
+func GetSpoolConfig(topicType TopicType) *SpoolConfig {
+	if env.Config.KafkaSpoolDir == "" {
+		return nil
+	}
+	return &SpoolConfig{
+		Name:          string(topicType),
+		Dir:           filepath.Join(env.Config.KafkaSpoolDir, string(topicType)),
+		MaxBytes:      env.KafkaSpoolMaxBytes(),
+		DirectBatches: defaultSpoolDirectBatches,
+		DropPolicy:    SpoolDropPolicy(env.Config.KafkaSpoolDropPolicy),
+	}
+}
+
+type SpoolStats struct {
+	Batches int64
+	Bytes   int64
+	Dropped int64
+}
+
+type spoolBatch struct {
+	PartitionKey string
+	Messages     []json.RawMessage
+}
+
+type spoolSegment struct {
+	id      int64
+	size    int64
+	batches int64
+}
+
+// asyncQueue is a MessageQueue reporting the messages that fail to publish after Submit returned.
+// Spooled batches are replayed with SubmitSync, so that they stay spooled until they are published.
+type asyncQueue interface {
+	OnAsyncError(onError func(messages []kafka.Message, err error))
+	SubmitSync(ctx context.Context, partitionKey string, messages ...RetryableMessage) error
+}
+
+// Spool is a MessageQueue that persists the batches failing to publish to a bounded, segmented log on disk,
+// and replays them once the queue accepts batches again. Batches of an async queue are spooled when the
+// queue reports that they failed to publish. While more than DirectBatches batches are waiting to be replayed,
+// new batches are spooled behind them rather than published directly.
+// Replay keeps the order of the batches of a partition key, and is at least once: the batches of a round of
+// replay that partially failed, and of a segment that was being replayed before a restart, are replayed again.
+// The spool owns the queue it wraps, stopping it when the spool is stopped.
+type Spool struct {
+	MessageQueue
+	config SpoolConfig
+
+	lock sync.Mutex
+	// segments are ordered from oldest to newest, the newest being written to
+	segments []*spoolSegment
+	file     *os.File
+	// readOffset is the offset in the oldest segment of the next batch to replay
+	readOffset int64
+	stats      SpoolStats
+	// closed is set once the segment being written is closed
+	closed bool
+
+	stopped      bool
+	replayCancel context.CancelFunc
+	replayDone   chan struct{}
+}
+
+func NewSpool(queue MessageQueue, config SpoolConfig) (*Spool, error) {
+	if config.SegmentBytes <= 0 {
+		config.SegmentBytes = defaultSpoolSegmentSize
+	}
+	if config.MaxBytes > 0 && config.SegmentBytes > config.MaxBytes {
+		config.SegmentBytes = config.MaxBytes
+	}
+	if config.DropPolicy != SpoolDropOldest {
+		config.DropPolicy = SpoolDropNewest
+	}
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "failed to create kafka spool directory")
+	}
+
+	s := &Spool{MessageQueue: queue, config: config}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if q, ok := queue.(asyncQueue); ok {
+		q.OnAsyncError(s.spoolFailed)
+	}
+	return s, nil
+}
+
+// load reads the segments left by a previous process, truncating a batch that was partially written.
+func (s *Spool) load() error {
+	entries, err := os.ReadDir(s.config.Dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read kafka spool directory")
+	}
+	for _, entry := range entries {
+		id, err := strconv.ParseInt(strings.TrimSuffix(entry.Name(), spoolSegmentExtension), 10, 64)
+		if err != nil || !strings.HasSuffix(entry.Name(), spoolSegmentExtension) {
+			continue
+		}
+		s.segments = append(s.segments, &spoolSegment{id: id})
+	}
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].id < s.segments[j].id
+	})
+
+	for _, segment := range s.segments {
+		if err := s.scanSegment(segment); err != nil {
+			return err
+		}
+		s.stats.Batches += segment.batches
+		s.stats.Bytes += segment.size
+	}
+
+	if len(s.segments) == 0 {
+		return s.rotate()
+	}
+	last := s.segments[len(s.segments)-1]
+	s.file, err = os.OpenFile(s.segmentPath(last), os.O_WRONLY|os.O_APPEND, 0o644)
+	return errors.Wrap(err, "failed to open kafka spool segment")
+}
+
+func (s *Spool) scanSegment(segment *spoolSegment) error {
+	file, err := os.Open(s.segmentPath(segment))
+	if err != nil {
+		return errors.Wrap(err, "failed to open kafka spool segment")
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		n, err := readSpoolRecord(reader, nil)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			log.WithError(err).WithField("segment", s.segmentPath(segment)).Warn("truncating corrupt kafka spool segment")
+			return errors.Wrap(os.Truncate(s.segmentPath(segment), segment.size), "failed to truncate kafka spool segment")
+		}
+		segment.size += n
+		segment.batches += 1
+	}
+}
+
+func (s *Spool) segmentPath(segment *spoolSegment) string {
+	return filepath.Join(s.config.Dir, fmt.Sprintf("%020d%s", segment.id, spoolSegmentExtension))
+}
+
+// Submit publishes a batch, spooling it when publishing fails or earlier batches are waiting to be replayed.
+func (s *Spool) Submit(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return ErrSpoolStopped
+	}
+	pending := s.stats.Batches > s.config.DirectBatches
+	s.lock.Unlock()
+
+	var err error
+	if !pending {
+		if err = s.MessageQueue.Submit(ctx, partitionKey, messages...); err == nil {
+			return nil
+		}
+	}
+
+	if spoolErr := s.append(partitionKey, messages); spoolErr != nil {
+		if err != nil {
+			return errors.Wrapf(spoolErr, "failed to spool batch after submit error: %s", err)
+		}
+		return spoolErr
+	}
+	return nil
+}
+
+// spoolFailed spools the messages that an async queue failed to publish, one batch per partition key.
+func (s *Spool) spoolFailed(messages []kafka.Message, err error) {
+	var batches []*spoolBatch
+	byKey := map[string]*spoolBatch{}
+	for _, msg := range messages {
+		batch, ok := byKey[string(msg.Key)]
+		if !ok {
+			batch = &spoolBatch{PartitionKey: string(msg.Key)}
+			byKey[batch.PartitionKey] = batch
+			batches = append(batches, batch)
+		}
+		batch.Messages = append(batch.Messages, msg.Value)
+	}
+	for _, batch := range batches {
+		if spoolErr := s.appendBatch(batch); spoolErr != nil {
+			log.WithError(spoolErr).WithField("spool", s.config.Name).WithField("num_messages", len(batch.Messages)).
+				Errorf("failed to spool batch after async submit error: %s", err)
+		}
+	}
+}
+
+func (s *Spool) append(partitionKey string, messages []RetryableMessage) error {
+	batch := spoolBatch{PartitionKey: partitionKey}
+	for _, msg := range messages {
+		msg.SetMaxRetries(TaskRetries)
+		data, err := json.Marshal(&msg)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal spooled message")
+		}
+		batch.Messages = append(batch.Messages, data)
+	}
+	return s.appendBatch(&batch)
+}
+
+func (s *Spool) appendBatch(batch *spoolBatch) error {
+	payload, err := json.Marshal(batch)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal spooled batch")
+	}
+	record := make([]byte, spoolRecordHeaderBytes+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[spoolRecordHeaderBytes:], payload)
+	size := int64(len(record))
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return ErrSpoolStopped
+	}
+	for s.config.MaxBytes > 0 && s.stats.Bytes+size > s.config.MaxBytes {
+		if s.config.DropPolicy == SpoolDropNewest || size > s.config.MaxBytes {
+			s.stats.Dropped += 1
+			return ErrSpoolFull
+		}
+		if err := s.dropOldest(); err != nil {
+			return err
+		}
+	}
+
+	if current := s.segments[len(s.segments)-1]; current.size > 0 && current.size+size > s.config.SegmentBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	current := s.segments[len(s.segments)-1]
+	if _, err := s.file.Write(record); err != nil {
+		// drop a partially written batch so that the segment stays readable
+		_ = s.file.Truncate(current.size)
+		return errors.Wrap(err, "failed to write kafka spool segment")
+	}
+	if err := s.file.Sync(); err != nil {
+		_ = s.file.Truncate(current.size)
+		return errors.Wrap(err, "failed to sync kafka spool segment")
+	}
+	current.size += size
+	current.batches += 1
+	s.stats.Bytes += size
+	s.stats.Batches += 1
+	return nil
+}
+
+// rotate starts a new segment. Must be called with the lock held.
+func (s *Spool) rotate() error {
+	var id int64
+	if len(s.segments) > 0 {
+		id = s.segments[len(s.segments)-1].id + 1
+	}
+	segment := &spoolSegment{id: id}
+	file, err := os.OpenFile(s.segmentPath(segment), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create kafka spool segment")
+	}
+	if err := syncDir(s.config.Dir); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			log.WithError(err).Warn("failed to close kafka spool segment")
+		}
+	}
+	s.file = file
+	s.segments = append(s.segments, segment)
+	return nil
+}
+
+// dropOldest removes the oldest segment, along with its batches that were not replayed.
+// Must be called with the lock held.
+func (s *Spool) dropOldest() error {
+	if len(s.segments) == 1 {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	oldest := s.segments[0]
+	s.segments = s.segments[1:]
+	s.readOffset = 0
+	s.stats.Bytes -= oldest.size
+	s.stats.Batches -= oldest.batches
+	s.stats.Dropped += oldest.batches
+	return errors.Wrap(os.Remove(s.segmentPath(oldest)), "failed to remove kafka spool segment")
+}
+
+// Replay publishes the spooled batches until the spool is empty, stopping at the first round that fails to publish.
+// A round reads up to spoolReplayBatches batches of the oldest segment, merging the batches of a partition key
+// into one submit and publishing up to spoolReplayConcurrency partition keys concurrently.
+func (s *Spool) Replay(ctx context.Context) error {
+	for {
+		s.lock.Lock()
+		if s.stats.Batches == 0 {
+			s.lock.Unlock()
+			return nil
+		}
+		oldest, offset, end := s.segments[0], s.readOffset, s.segments[0].size
+		if offset >= end {
+			// the oldest segment is replayed, so it can be removed
+			s.segments = s.segments[1:]
+			s.readOffset = 0
+			s.stats.Bytes -= oldest.size
+			s.lock.Unlock()
+			if err := os.Remove(s.segmentPath(oldest)); err != nil {
+				return errors.Wrap(err, "failed to remove kafka spool segment")
+			}
+			continue
+		}
+		s.lock.Unlock()
+
+		// the batches are read without the lock, up to the end of the segment when the round started
+		batches, n, err := s.readBatches(oldest, offset, end, spoolReplayBatches)
+		if err != nil {
+			s.lock.Lock()
+			dropped := len(s.segments) == 0 || s.segments[0] != oldest
+			s.lock.Unlock()
+			if dropped {
+				// the segment was dropped to make room while it was read
+				continue
+			}
+			return err
+		}
+
+		if err := s.submitBatches(ctx, batches); err != nil {
+			return err
+		}
+
+		s.lock.Lock()
+		// the segment may have been dropped to make room while the batches were published
+		if len(s.segments) > 0 && s.segments[0] == oldest && s.readOffset == offset {
+			s.readOffset = offset + n
+			oldest.batches -= int64(len(batches))
+			s.stats.Batches -= int64(len(batches))
+		}
+		if s.stats.Batches == 0 {
+			// start over with an empty segment rather than keeping the replayed batches on disk
+			err = s.rotate()
+			for err == nil && len(s.segments) > 1 {
+				oldest := s.segments[0]
+				s.segments = s.segments[1:]
+				s.stats.Bytes -= oldest.size
+				err = os.Remove(s.segmentPath(oldest))
+			}
+			s.readOffset = 0
+		}
+		s.lock.Unlock()
+		if err != nil {
+			return errors.Wrap(err, "failed to reset kafka spool")
+		}
+	}
+}
+
+// submitBatches publishes batches read from the spool, keeping the order of the batches of a partition key.
+func (s *Spool) submitBatches(ctx context.Context, batches []*spoolBatch) error {
+	var keys []string
+	messages := map[string][]RetryableMessage{}
+	for _, batch := range batches {
+		if _, ok := messages[batch.PartitionKey]; !ok {
+			keys = append(keys, batch.PartitionKey)
+			messages[batch.PartitionKey] = nil
+		}
+		for _, data := range batch.Messages {
+			msg, err := unmarshalMessage(data)
+			if err != nil {
+				log.WithContext(ctx).WithError(err).Error("failed to unmarshal spooled message")
+				continue
+			}
+			messages[batch.PartitionKey] = append(messages[batch.PartitionKey], msg)
+		}
+	}
+
+	submit := s.MessageQueue.Submit
+	if q, ok := s.MessageQueue.(asyncQueue); ok {
+		submit = q.SubmitSync
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(spoolReplayConcurrency)
+	for _, key := range keys {
+		g.Go(func() error {
+			return submit(ctx, key, messages[key]...)
+		})
+	}
+	return g.Wait()
+}
+
+// readBatches reads up to limit batches between two offsets of a segment, returning their size.
+// Batches are only appended after the end offset, so the lock does not need to be held.
+func (s *Spool) readBatches(segment *spoolSegment, offset int64, end int64, limit int) ([]*spoolBatch, int64, error) {
+	file, err := os.Open(s.segmentPath(segment))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to open kafka spool segment")
+	}
+	defer file.Close()
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to seek kafka spool segment")
+	}
+
+	reader := bufio.NewReader(file)
+	var batches []*spoolBatch
+	var size int64
+	for len(batches) < limit && offset+size < end {
+		var batch spoolBatch
+		n, err := readSpoolRecord(reader, &batch)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to read kafka spool segment")
+		}
+		batches = append(batches, &batch)
+		size += n
+	}
+	return batches, size, nil
+}
+
+// syncDir persists the entries of a directory, such as a newly created segment.
+func syncDir(dir string) error {
+	file, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to open kafka spool directory")
+	}
+	defer file.Close()
+	return errors.Wrap(file.Sync(), "failed to sync kafka spool directory")
+}
+
+// readSpoolRecord reads a length-prefixed and checksummed record, returning its size.
+func readSpoolRecord(reader io.Reader, batch *spoolBatch) (int64, error) {
+	header := make([]byte, spoolRecordHeaderBytes)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, errors.New("truncated kafka spool record header")
+		}
+		return 0, err
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(header[0:4]))
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return 0, errors.New("truncated kafka spool record")
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return 0, errors.New("kafka spool record checksum mismatch")
+	}
+	if batch != nil {
+		if err := json.Unmarshal(payload, batch); err != nil {
+			return 0, errors.Wrap(err, "failed to unmarshal spooled batch")
+		}
+	}
+	return int64(len(header) + len(payload)), nil
+}
+
+func (s *Spool) Name() string {
+	return s.config.Name
+}
+
+func (s *Spool) Stats() SpoolStats {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stats
+}
+
+// Start replays the spooled batches every spoolReplayInterval until the context is done or the spool is stopped.
+func (s *Spool) Start(ctx context.Context) {
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return
+	}
+	ctx, s.replayCancel = context.WithCancel(ctx)
+	s.replayDone = make(chan struct{})
+	done := s.replayDone
+	s.lock.Unlock()
+	defer close(done)
+
+	ticker := time.NewTicker(spoolReplayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Replay(ctx); err != nil && ctx.Err() == nil {
+				log.WithContext(ctx).WithError(err).WithField("spool", s.config.Name).Warn("failed to replay kafka spool")
+			}
+			stats := s.Stats()
+			hmetric.Histogram(ctx, fmt.Sprintf("worker.kafka.spool.%s.batches", s.config.Name), float64(stats.Batches), nil, 1)
+			hmetric.Histogram(ctx, fmt.Sprintf("worker.kafka.spool.%s.bytes", s.config.Name), float64(stats.Bytes), nil, 1)
+		}
+	}
+}
+
+// Stop waits for the replay to stop, then stops the queue so that the batches it fails to flush are spooled,
+// before closing the segment being written. Batches submitted after Stop are rejected.
+func (s *Spool) Stop(ctx context.Context) {
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return
+	}
+	s.stopped = true
+	cancel, done := s.replayCancel, s.replayDone
+	s.lock.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+
+	s.MessageQueue.Stop(ctx)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	if err := s.file.Sync(); err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to sync kafka spool segment")
+	}
+	if err := s.file.Close(); err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to close kafka spool segment")
+	}
+}
@@ -0,0 +1,260 @@
+package kafka_queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQueue struct {
+	MessageQueue
+	lock    sync.Mutex
+	healthy bool
+	// submitted holds the sequence number of each message, carried in its failures
+	submitted []int
+}
+
+func (q *fakeQueue) Submit(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if !q.healthy {
+		return errors.New("kafka is down")
+	}
+	for _, msg := range messages {
+		q.submitted = append(q.submitted, msg.GetFailures())
+	}
+	return nil
+}
+
+func (q *fakeQueue) Stop(ctx context.Context) {}
+
+// fakeAsyncQueue accepts every batch, reporting the batches submitted while unhealthy when it is flushed.
+type fakeAsyncQueue struct {
+	fakeQueue
+	onError func(messages []kafka.Message, err error)
+	failed  []kafka.Message
+}
+
+func (q *fakeAsyncQueue) OnAsyncError(onError func(messages []kafka.Message, err error)) {
+	q.onError = onError
+}
+
+func (q *fakeAsyncQueue) Submit(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	if q.healthy {
+		return q.fakeQueue.Submit(ctx, partitionKey, messages...)
+	}
+	for _, msg := range messages {
+		data, err := (&Queue{}).serializeMessage(msg)
+		if err != nil {
+			return err
+		}
+		q.failed = append(q.failed, kafka.Message{Key: []byte(partitionKey), Value: data})
+	}
+	return nil
+}
+
+func (q *fakeAsyncQueue) SubmitSync(ctx context.Context, partitionKey string, messages ...RetryableMessage) error {
+	return q.fakeQueue.Submit(ctx, partitionKey, messages...)
+}
+
+// flush reports the batches that failed to publish.
+func (q *fakeAsyncQueue) flush() {
+	if len(q.failed) > 0 {
+		q.onError(q.failed, errors.New("kafka is down"))
+		q.failed = nil
+	}
+}
+
+func (q *fakeAsyncQueue) Stop(ctx context.Context) {
+	q.flush()
+}
+
+func submitSequence(t *testing.T, spool *Spool, from int, to int) {
+	for i := from; i < to; i++ {
+		require.NoError(t, spool.Submit(context.Background(), "", &Message{Type: HealthCheck, Failures: i}))
+	}
+}
+
+func TestSpool_ReplaysInOrder(t *testing.T) {
+	ctx := context.Background()
+	queue := &fakeQueue{healthy: true}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20, SegmentBytes: 512})
+	require.NoError(t, err)
+	defer spool.Stop(ctx)
+
+	submitSequence(t, spool, 0, 2)
+	queue.healthy = false
+	submitSequence(t, spool, 2, 20)
+	assert.Equal(t, int64(18), spool.Stats().Batches)
+	assert.Error(t, spool.Replay(ctx))
+
+	// new batches are spooled behind the pending ones even when kafka is healthy
+	queue.healthy = true
+	submitSequence(t, spool, 20, 25)
+	assert.Len(t, queue.submitted, 2)
+
+	require.NoError(t, spool.Replay(ctx))
+	assert.Equal(t, SpoolStats{}, spool.Stats())
+	for i, seq := range queue.submitted {
+		assert.Equal(t, i, seq)
+	}
+	assert.Len(t, queue.submitted, 25)
+
+	submitSequence(t, spool, 25, 26)
+	assert.Len(t, queue.submitted, 26)
+}
+
+func TestSpool_RecoversAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	queue := &fakeQueue{}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: dir, MaxBytes: 1 << 20, SegmentBytes: 512})
+	require.NoError(t, err)
+	submitSequence(t, spool, 0, 10)
+	spool.Stop(ctx)
+
+	queue.healthy = true
+	spool, err = NewSpool(queue, SpoolConfig{Dir: dir, MaxBytes: 1 << 20, SegmentBytes: 512})
+	require.NoError(t, err)
+	defer spool.Stop(ctx)
+	assert.Equal(t, int64(10), spool.Stats().Batches)
+
+	require.NoError(t, spool.Replay(ctx))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, queue.submitted)
+}
+
+func TestSpool_DropPolicy(t *testing.T) {
+	ctx := context.Background()
+	for _, policy := range []SpoolDropPolicy{SpoolDropNewest, SpoolDropOldest} {
+		t.Run(string(policy), func(t *testing.T) {
+			queue := &fakeQueue{}
+			spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 2048, SegmentBytes: 512, DropPolicy: policy})
+			require.NoError(t, err)
+			defer spool.Stop(ctx)
+
+			var rejected int
+			for i := 0; i < 100; i++ {
+				if err := spool.Submit(ctx, "", &Message{Type: HealthCheck, Failures: i}); err != nil {
+					rejected += 1
+				}
+			}
+			stats := spool.Stats()
+			assert.LessOrEqual(t, stats.Bytes, int64(2048))
+			assert.Equal(t, int64(100), stats.Batches+stats.Dropped)
+
+			queue.healthy = true
+			require.NoError(t, spool.Replay(ctx))
+			assert.Len(t, queue.submitted, 100-int(stats.Dropped))
+			if policy == SpoolDropNewest {
+				assert.Equal(t, int(stats.Dropped), rejected)
+				assert.Equal(t, 0, queue.submitted[0])
+			} else {
+				assert.Zero(t, rejected)
+				assert.Equal(t, 99, queue.submitted[len(queue.submitted)-1])
+			}
+		})
+	}
+}
+
+func TestSpool_DirectBatches(t *testing.T) {
+	ctx := context.Background()
+	queue := &fakeQueue{}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20, DirectBatches: 5})
+	require.NoError(t, err)
+	defer spool.Stop(ctx)
+
+	submitSequence(t, spool, 0, 10)
+	assert.Equal(t, int64(10), spool.Stats().Batches)
+
+	// batches are spooled behind a backlog above the threshold, and published directly below it
+	queue.healthy = true
+	submitSequence(t, spool, 10, 11)
+	assert.Empty(t, queue.submitted)
+	require.NoError(t, spool.Replay(ctx))
+	submitSequence(t, spool, 11, 12)
+	assert.Len(t, queue.submitted, 12)
+	assert.Equal(t, SpoolStats{}, spool.Stats())
+}
+
+func TestSpool_SpoolsAsyncErrors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	queue := &fakeAsyncQueue{}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	submitSequence(t, spool, 0, 10)
+	assert.Zero(t, spool.Stats().Batches)
+
+	// the batches reported when the queue is flushed by Stop are spooled before the segment is closed
+	spool.Stop(ctx)
+	spool, err = NewSpool(queue, SpoolConfig{Dir: dir, MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer spool.Stop(ctx)
+	assert.Equal(t, int64(1), spool.Stats().Batches)
+
+	queue.healthy = true
+	require.NoError(t, spool.Replay(ctx))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, queue.submitted)
+}
+
+func TestSpool_ReplaysAsyncQueueSynchronously(t *testing.T) {
+	ctx := context.Background()
+	queue := &fakeAsyncQueue{}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	defer spool.Stop(ctx)
+
+	submitSequence(t, spool, 0, 10)
+	queue.flush()
+	assert.Equal(t, int64(1), spool.Stats().Batches)
+
+	// replay waits for the batches to be published rather than dropping them from the spool
+	for i := 0; i < 3; i++ {
+		assert.Error(t, spool.Replay(ctx))
+		queue.flush()
+		assert.Equal(t, int64(1), spool.Stats().Batches)
+	}
+
+	queue.healthy = true
+	require.NoError(t, spool.Replay(ctx))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, queue.submitted)
+	assert.Equal(t, SpoolStats{}, spool.Stats())
+}
+
+func TestSpool_RejectsSubmitAfterStop(t *testing.T) {
+	ctx := context.Background()
+	queue := &fakeQueue{healthy: true}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+	spool.Stop(ctx)
+
+	assert.ErrorIs(t, spool.Submit(ctx, "", &Message{Type: HealthCheck}), ErrSpoolStopped)
+	assert.Empty(t, queue.submitted)
+}
+
+func TestSpool_StopWaitsForReplay(t *testing.T) {
+	ctx := context.Background()
+	queue := &fakeQueue{}
+	spool, err := NewSpool(queue, SpoolConfig{Dir: t.TempDir(), MaxBytes: 1 << 20})
+	require.NoError(t, err)
+
+	go spool.Start(ctx)
+	assert.Eventually(t, func() bool {
+		spool.lock.Lock()
+		defer spool.lock.Unlock()
+		return spool.replayDone != nil
+	}, time.Second, time.Millisecond)
+
+	spool.Stop(ctx)
+	select {
+	case <-spool.replayDone:
+	default:
+		t.Fatal("replay is running after the spool stopped")
+	}
+}
@@ -36,6 +36,7 @@ const (
 	PushCompressedPayload                  PayloadType = iota
 	PushLogsFlattened                      PayloadType = iota
 	PushTracesFlattened                    PayloadType = iota
+	PushMetricsFlattened                   PayloadType = iota
 	HealthCheck                            PayloadType = math.MaxInt
 )
 
@@ -263,6 +264,41 @@ func (m *TraceRowMessage) SetKafkaMessage(value *kafka.Message) {
 	m.KafkaMessage = value
 }
 
+type MetricRowMessage struct {
+	Type         PayloadType
+	Failures     int
+	MaxRetries   int
+	KafkaMessage *kafka.Message `json:",omitempty"`
+	*clickhouse.MetricRow
+}
+
+func (m *MetricRowMessage) GetType() PayloadType {
+	return PushMetricsFlattened
+}
+
+func (m *MetricRowMessage) GetFailures() int {
+	return m.Failures
+}
+
+func (m *MetricRowMessage) SetFailures(value int) {
+	m.Failures = value
+}
+
+func (m *MetricRowMessage) GetMaxRetries() int {
+	return m.MaxRetries
+}
+
+func (m *MetricRowMessage) SetMaxRetries(value int) {
+	m.MaxRetries = value
+}
+
+func (m *MetricRowMessage) GetKafkaMessage() *kafka.Message {
+	return m.KafkaMessage
+}
+func (m *MetricRowMessage) SetKafkaMessage(value *kafka.Message) {
+	m.KafkaMessage = value
+}
+
 type MockMessageQueue struct{}
 
 func (k *MockMessageQueue) Stop(context.Context) {
@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
+	"os/signal"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/highlight-run/highlight/backend/enterprise"
@@ -47,6 +50,7 @@ import (
 	public "github.com/highlight-run/highlight/backend/public-graph/graph"
 	publicgen "github.com/highlight-run/highlight/backend/public-graph/graph/generated"
 	"github.com/highlight-run/highlight/backend/redis"
+	"github.com/highlight-run/highlight/backend/statsd"
 	"github.com/highlight-run/highlight/backend/stepfunctions"
 	"github.com/highlight-run/highlight/backend/storage"
 	"github.com/highlight-run/highlight/backend/store"
@@ -86,7 +90,7 @@ func init() {
 	runtimeParsed, handlerParsed = util.GetRuntime()
 }
 
-func healthRouter(runtimeFlag util.Runtime, db *gorm.DB, rClient *redis.Client, ccClient *clickhouse.Client, queue *kafkaqueue.Queue, batchedQueue *kafkaqueue.Queue) http.HandlerFunc {
+func healthRouter(runtimeFlag util.Runtime, db *gorm.DB, rClient *redis.Client, ccClient *clickhouse.Client, queue *kafkaqueue.Queue, batchedQueue *kafkaqueue.Queue, spools []*kafkaqueue.Spool) http.HandlerFunc {
 	// only checks kafka because kafka is the only critical infrastructure needed for public graph to be healthy.
 	topic := kafkaqueue.GetTopic(kafkaqueue.GetTopicOptions{Type: kafkaqueue.TopicTypeDefault})
 	batchedTopic := kafkaqueue.GetTopic(kafkaqueue.GetTopicOptions{Type: kafkaqueue.TopicTypeBatched})
@@ -109,7 +113,12 @@ func healthRouter(runtimeFlag util.Runtime, db *gorm.DB, rClient *redis.Client,
 				return
 			}
 		}
-		_, err := w.Write([]byte(fmt.Sprintf("%v is healthy", runtimeFlag)))
+		response := fmt.Sprintf("%v is healthy", runtimeFlag)
+		for _, spool := range spools {
+			stats := spool.Stats()
+			response += fmt.Sprintf("\nkafka %s spool: %d batches, %d bytes, %d dropped", spool.Name(), stats.Batches, stats.Bytes, stats.Dropped)
+		}
+		_, err := w.Write([]byte(response))
 		if err != nil {
 			log.WithContext(ctx).Error(e.Wrap(err, "error writing health response"))
 		}
@@ -184,9 +193,13 @@ func validateOrigin(_ *http.Request, origin string) bool {
 
 var defaultPort = "8082"
 
+// shutdownTimeout bounds the time in-flight requests have to complete on shutdown
+const shutdownTimeout = 20 * time.Second
+
 func main() {
 	rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctx := context.TODO()
+	listeners := newListeners(ctx)
 
 	if env.Config.OTLPDogfoodEndpoint != "" {
 		log.WithContext(ctx).WithField("otlpEndpoint", env.Config.OTLPDogfoodEndpoint).Info("overwriting otlp client address for highlight backend logging")
@@ -306,9 +319,32 @@ func main() {
 	// async writes for workers (where order of write between workers does not matter)
 	kCfg := &kafkaqueue.ConfigOverride{Async: ptr.Bool(true)}
 	kafkaBatchedProducer := kafkaqueue.New(ctx, kafkaqueue.GetTopic(kafkaqueue.GetTopicOptions{Type: kafkaqueue.TopicTypeBatched}), kafkaqueue.Producer, kCfg)
-	defer kafkaBatchedProducer.Stop(ctx)
 	kafkaTracesProducer := kafkaqueue.New(ctx, kafkaqueue.GetTopic(kafkaqueue.GetTopicOptions{Type: kafkaqueue.TopicTypeTraces}), kafkaqueue.Producer, kCfg)
-	defer kafkaTracesProducer.Stop(ctx)
+
+	// the public graph spools the batches of ingested data that fail to write, replaying them once kafka recovers.
+	// The spools stop the producers they wrap, so that the batches failing to flush on shutdown are spooled.
+	var publicBatchedQueue, publicTracesQueue kafkaqueue.MessageQueue = kafkaBatchedProducer, kafkaTracesProducer
+	var spools []*kafkaqueue.Spool
+	if runtimeParsed.IsPublicGraph() && env.Config.KafkaSpoolDir != "" {
+		batchedSpool, err := kafkaqueue.NewSpool(kafkaBatchedProducer, *kafkaqueue.GetSpoolConfig(kafkaqueue.TopicTypeBatched))
+		if err != nil {
+			log.WithContext(ctx).Fatalf("error creating kafka batched spool: %v", err)
+		}
+		defer batchedSpool.Stop(ctx)
+		tracesSpool, err := kafkaqueue.NewSpool(kafkaTracesProducer, *kafkaqueue.GetSpoolConfig(kafkaqueue.TopicTypeTraces))
+		if err != nil {
+			log.WithContext(ctx).Fatalf("error creating kafka traces spool: %v", err)
+		}
+		defer tracesSpool.Stop(ctx)
+		spools = []*kafkaqueue.Spool{batchedSpool, tracesSpool}
+		for _, spool := range spools {
+			go spool.Start(ctx)
+		}
+		publicBatchedQueue, publicTracesQueue = batchedSpool, tracesSpool
+	} else {
+		defer kafkaBatchedProducer.Stop(ctx)
+		defer kafkaTracesProducer.Stop(ctx)
+	}
 
 	var lambdaClient *lambda.Client
 	if !env.IsInDocker() {
@@ -386,6 +422,7 @@ func main() {
 		Store:                  dataStore,
 		DataSyncQueue:          kafkaDataSyncProducer,
 		TracesQueue:            kafkaTracesProducer,
+		IngestPreviewer:        otel.NewIngestPreviewer(db, tracer, tracerNoResources, redisClient, clickhouseClient, dataStore),
 	}
 	private.SetupAuthClient(ctx, dataStore, private.GetEnvAuthMode(), oauthSrv, privateResolver.Query().APIKeyToOrgID)
 	r := chi.NewMux()
@@ -401,7 +438,7 @@ func main() {
 		return brotli.NewWriterLevel(w, level)
 	})
 	r.Use(compressor.Handler)
-	r.HandleFunc("/health", healthRouter(runtimeParsed, db, redisClient, clickhouseClient, kafkaProducer, kafkaBatchedProducer))
+	r.HandleFunc("/health", healthRouter(runtimeParsed, db, redisClient, clickhouseClient, kafkaProducer, kafkaBatchedProducer, spools))
 
 	zapierStore := zapier.ZapierResthookStore{
 		DB: db,
@@ -448,6 +485,8 @@ func main() {
 			}
 			r.Get("/assets/{project_id}/{hash_val}", privateResolver.AssetHandler)
 			r.Get("/project-token/{project_id}", privateResolver.ProjectJWTHandler)
+			r.HandleFunc("/api/v1/query", privateResolver.PromQLQueryHandler)
+			r.HandleFunc("/api/v1/query_range", privateResolver.PromQLQueryRangeHandler)
 
 			private.AuthClient.SetupListeners(r)
 
@@ -494,23 +533,28 @@ func main() {
 			log.Fatalf("error initializing lru cache: %v", err)
 		}
 		publicResolver := &public.Resolver{
-			DB:                db,
-			Tracer:            tracer,
-			TracerNoResources: tracerNoResources,
-			ProducerQueue:     kafkaProducer,
-			BatchedQueue:      kafkaBatchedProducer,
-			DataSyncQueue:     kafkaDataSyncProducer,
-			TracesQueue:       kafkaTracesProducer,
-			MailClient:        sendgrid.NewSendClient(env.Config.SendgridKey),
-			EmbeddingsClient:  embeddings.New(),
-			StorageClient:     storageClient,
-			Redis:             redisClient,
-			Clickhouse:        clickhouseClient,
-			RH:                &rh,
-			Store:             dataStore,
-			LambdaClient:      lambdaClient,
-			SessionCache:      sessionCache,
+			DB:                     db,
+			Tracer:                 tracer,
+			TracerNoResources:      tracerNoResources,
+			ProducerQueue:          kafkaProducer,
+			BatchedQueue:           publicBatchedQueue,
+			DataSyncQueue:          kafkaDataSyncProducer,
+			TracesQueue:            publicTracesQueue,
+			MailClient:             sendgrid.NewSendClient(env.Config.SendgridKey),
+			EmbeddingsClient:       embeddings.New(),
+			StorageClient:          storageClient,
+			Redis:                  redisClient,
+			Clickhouse:             clickhouseClient,
+			RH:                     &rh,
+			Store:                  dataStore,
+			LambdaClient:           lambdaClient,
+			SessionCache:           sessionCache,
+			IngestDrops:            public.NewIngestDrops(clickhouseClient),
+			AdaptiveSamplingCounts: public.NewAdaptiveSamplingCounts(redisClient),
 		}
+		go publicResolver.IngestDrops.Start(ctx)
+		defer publicResolver.IngestDrops.Stop(ctx)
+		go publicResolver.AdaptiveSamplingCounts.Start(ctx)
 		publicEndpoint := "/public"
 		if runtimeParsed == util.PublicGraph {
 			publicEndpoint = "/"
@@ -547,8 +591,57 @@ func main() {
 		})
 		otelHandler := otel.New(publicResolver)
 		otelHandler.Listen(r)
+		if env.Config.OTLPGrpcPort != "" {
+			listeners.Go(func(ctx context.Context) {
+				log.WithContext(ctx).
+					WithField("port", env.Config.OTLPGrpcPort).
+					Info("running OTLP gRPC listener")
+				if err := otelHandler.ListenGRPC(ctx, env.Config.OTLPGrpcPort); err != nil {
+					log.WithContext(ctx).WithError(err).Error("otlp grpc listener failed")
+				}
+			})
+		}
+		if env.Config.StatsDPort != "" {
+			statsdServer := statsd.New(otelHandler.SubmitMetricRows, env.Config.StatsDProject)
+			defer statsdServer.Stop(ctx)
+			go func() {
+				log.WithContext(ctx).
+					WithField("port", env.Config.StatsDPort).
+					Info("running statsd listener")
+				if err := statsdServer.Listen(ctx, env.Config.StatsDPort); err != nil {
+					log.WithContext(ctx).WithError(err).Error("statsd listener failed")
+				}
+			}()
+		}
+		syslogListeners, err := otel.NewSyslogListeners(env.Config.SyslogPorts, nil)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Fatal("invalid syslog ports")
+		}
+		if env.Config.SyslogTLSPorts != "" {
+			cert, err := tls.LoadX509KeyPair(env.Config.SyslogTLSCertFile, env.Config.SyslogTLSKeyFile)
+			if err != nil {
+				log.WithContext(ctx).WithError(err).Fatal("failed to load syslog tls certificate")
+			}
+			tlsListeners, err := otel.NewSyslogListeners(env.Config.SyslogTLSPorts, &tls.Config{Certificates: []tls.Certificate{cert}})
+			if err != nil {
+				log.WithContext(ctx).WithError(err).Fatal("invalid syslog tls ports")
+			}
+			syslogListeners = append(syslogListeners, tlsListeners...)
+		}
+		for _, listener := range syslogListeners {
+			listeners.Go(func(ctx context.Context) {
+				log.WithContext(ctx).
+					WithField("port", listener.Port).
+					WithField("tls", listener.TLSConfig != nil).
+					Info("running syslog listener")
+				if err := otelHandler.ListenSyslog(ctx, listener); err != nil {
+					log.WithContext(ctx).WithError(err).Error("syslog listener failed")
+				}
+			})
+		}
 		vercel.Listen(r, tracerNoResources)
-		highlightHttp.Listen(r, tracerNoResources)
+		highlightHttp.Listen(r, tracerNoResources, otelHandler, otelHandler)
+		defer highlightHttp.Stop(ctx)
 	}
 
 	/*
@@ -565,23 +658,28 @@ func main() {
 			log.Fatalf("error initializing lru cache: %v", err)
 		}
 		publicResolver := &public.Resolver{
-			DB:                db,
-			Tracer:            tracer,
-			TracerNoResources: tracerNoResources,
-			ProducerQueue:     kafkaProducer,
-			BatchedQueue:      kafkaBatchedProducer,
-			DataSyncQueue:     kafkaDataSyncProducer,
-			TracesQueue:       kafkaTracesProducer,
-			MailClient:        sendgrid.NewSendClient(env.Config.SendgridKey),
-			EmbeddingsClient:  embeddings.New(),
-			StorageClient:     storageClient,
-			Redis:             redisClient,
-			Clickhouse:        clickhouseClient,
-			RH:                &rh,
-			Store:             dataStore,
-			LambdaClient:      lambdaClient,
-			SessionCache:      sessionCache,
+			DB:                     db,
+			Tracer:                 tracer,
+			TracerNoResources:      tracerNoResources,
+			ProducerQueue:          kafkaProducer,
+			BatchedQueue:           kafkaBatchedProducer,
+			DataSyncQueue:          kafkaDataSyncProducer,
+			TracesQueue:            kafkaTracesProducer,
+			MailClient:             sendgrid.NewSendClient(env.Config.SendgridKey),
+			EmbeddingsClient:       embeddings.New(),
+			StorageClient:          storageClient,
+			Redis:                  redisClient,
+			Clickhouse:             clickhouseClient,
+			RH:                     &rh,
+			Store:                  dataStore,
+			LambdaClient:           lambdaClient,
+			SessionCache:           sessionCache,
+			IngestDrops:            public.NewIngestDrops(clickhouseClient),
+			AdaptiveSamplingCounts: public.NewAdaptiveSamplingCounts(redisClient),
 		}
+		go publicResolver.IngestDrops.Start(ctx)
+		defer publicResolver.IngestDrops.Stop(ctx)
+		go publicResolver.AdaptiveSamplingCounts.Start(ctx)
 		w := &worker.Worker{Resolver: privateResolver, PublicResolver: publicResolver, StorageClient: storageClient}
 		if runtimeParsed == util.Worker {
 			if !env.IsDevOrTestEnv() && !env.IsOnPrem() {
@@ -607,6 +705,7 @@ func main() {
 			go w.StartLogAlertWatcher(ctx)
 			go w.StartMetricAlertWatcher(ctx)
 			go w.StartSessionDeleteJob(ctx)
+			go w.StartTraceTailSampler(ctx)
 			go func() {
 				w.ReportStripeUsage(ctx)
 				for range time.Tick(time.Hour) {
@@ -625,33 +724,79 @@ func main() {
 					w.AutoResolveStaleErrors(ctx)
 				}
 			}()
-			if env.IsDevEnv() && env.UseSSL() {
-				log.WithContext(ctx).
-					WithField("runtime", runtimeParsed).
-					WithField("port", defaultPort).
-					Info("running HTTPS listener")
-				log.Fatal(http.ListenAndServeTLS(":"+defaultPort, localhostCertPath, localhostKeyPath, r))
-			} else {
-				log.WithContext(ctx).
-					WithField("runtime", runtimeParsed).
-					WithField("port", defaultPort).
-					Info("running HTTP listener")
-				log.Fatal(http.ListenAndServe(":"+defaultPort, r))
-			}
+			serve(ctx, runtimeParsed, r, listeners)
 		}
 	} else {
-		if env.IsDevEnv() && env.UseSSL() {
-			log.WithContext(ctx).
-				WithField("runtime", runtimeParsed).
-				WithField("port", defaultPort).
-				Info("running HTTPS listener")
-			log.Fatal(http.ListenAndServeTLS(":"+defaultPort, localhostCertPath, localhostKeyPath, r))
-		} else {
-			log.WithContext(ctx).
-				WithField("runtime", runtimeParsed).
-				WithField("port", defaultPort).
-				Info("running HTTP listener")
-			log.Fatal(http.ListenAndServe(":"+defaultPort, r))
+		serve(ctx, runtimeParsed, r, listeners)
+	}
+}
+
+// listeners runs the servers started besides the http listener, such as the otlp grpc receiver.
+// Their context is cancelled when the http listener shuts down.
+type listeners struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newListeners(ctx context.Context) *listeners {
+	l := &listeners{}
+	l.ctx, l.cancel = context.WithCancel(ctx)
+	return l
+}
+
+// Go runs a listener until its context is cancelled.
+func (l *listeners) Go(listen func(ctx context.Context)) {
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		listen(l.ctx)
+	}()
+}
+
+// Stop cancels the context of the listeners and waits for them to return.
+func (l *listeners) Stop() {
+	l.cancel()
+	l.wg.Wait()
+}
+
+// serve runs the http listener until the process is asked to stop, then shuts it down gracefully along with
+// the other listeners so that the deferred shutdown of main can flush the data buffered by the ingest paths.
+func serve(ctx context.Context, runtimeParsed util.Runtime, r http.Handler, listeners *listeners) {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	server := &http.Server{Addr: ":" + defaultPort, Handler: r}
+	// ListenAndServe returns as soon as the shutdown starts, so serve returns once the shutdown is done
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		log.WithContext(ctx).WithField("runtime", runtimeParsed).Info("shutting down HTTP listener")
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.WithContext(ctx).WithError(err).Error("failed to shut down HTTP listener")
 		}
+		listeners.Stop()
+	}()
+
+	var err error
+	if env.IsDevEnv() && env.UseSSL() {
+		log.WithContext(ctx).
+			WithField("runtime", runtimeParsed).
+			WithField("port", defaultPort).
+			Info("running HTTPS listener")
+		err = server.ListenAndServeTLS(localhostCertPath, localhostKeyPath)
+	} else {
+		log.WithContext(ctx).
+			WithField("runtime", runtimeParsed).
+			WithField("port", defaultPort).
+			Info("running HTTP listener")
+		err = server.ListenAndServe()
+	}
+	if !e.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
 	}
+	<-shutdown
 }
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+)
+
+// LogPipeline is the ordered list of processing stages applied to the logs of a project at ingest.
+type LogPipeline []*modelInputs.LogPipelineStage
+
+// Scan scan value into Jsonb, implements sql.Scanner interface
+func (lp *LogPipeline) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(v), &lp); err != nil {
+			return err
+		}
+	case []byte:
+		if err := json.Unmarshal(v, &lp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value return json value, implement driver.Valuer interface
+func (lp LogPipeline) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(lp)
+	return string(bytes), err
+}
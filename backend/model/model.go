@@ -446,10 +446,27 @@ type ProjectFilterSettings struct {
 	ErrorMinuteRateLimit              *int64
 	LogMinuteRateLimit                *int64
 	TraceMinuteRateLimit              *int64
+	SessionMinuteRateLimitBurst       *int64
+	ErrorMinuteRateLimitBurst         *int64
+	LogMinuteRateLimitBurst           *int64
+	TraceMinuteRateLimitBurst         *int64
+	SessionMinuteRateLimitKey         *string
+	ErrorMinuteRateLimitKey           *string
+	LogMinuteRateLimitKey             *string
+	TraceMinuteRateLimitKey           *string
 	SessionExclusionQuery             *string
 	ErrorExclusionQuery               *string
 	LogExclusionQuery                 *string
 	TraceExclusionQuery               *string
+	TraceTailSampling                 bool `gorm:"default:false"`
+	TraceTailSamplingMinDurationMs    *int64
+	TraceTailSamplingQuery            *string
+	LogAdaptiveSamplingTarget         *int64
+	LogAdaptiveSamplingKey            *string
+	TraceAdaptiveSamplingTarget       *int64
+	TraceAdaptiveSamplingKey          *string
+	LogPipeline                       LogPipeline    `gorm:"type:jsonb;default:'[]'"`
+	RedactionRules                    RedactionRules `gorm:"type:jsonb;default:'[]'"`
 }
 
 type AllWorkspaceSettings struct {
@@ -2476,9 +2493,17 @@ type TableConfig struct {
 	AttributesColumn string
 	AttributesTable  string
 	MetricColumn     *string
-	KeysToColumns    map[string]string
-	ReservedKeys     []string
-	SelectColumns    []string
-	DefaultFilter    string
-	IgnoredFilters   map[string]bool
+	// MetricDeltaColumn is the change of MetricColumn since the previous data point, used by rate and increase
+	MetricDeltaColumn *string
+	// HistogramBucketsColumn is the array of bucket values of a row, used by percentiles
+	HistogramBucketsColumn *string
+	// HistogramCountsColumn is the array of observations of each bucket of HistogramBucketsColumn
+	HistogramCountsColumn *string
+	// SampleFactorColumn is the number of items a row stands for, used by counts and sums of sampled rows
+	SampleFactorColumn *string
+	KeysToColumns      map[string]string
+	ReservedKeys       []string
+	SelectColumns      []string
+	DefaultFilter      string
+	IgnoredFilters     map[string]bool
 }
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+)
+
+// RedactionRules are the rules masking or hashing sensitive data in the telemetry of a project at ingest.
+type RedactionRules []*modelInputs.RedactionRule
+
+// Scan scan value into Jsonb, implements sql.Scanner interface
+func (rr *RedactionRules) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(v), &rr); err != nil {
+			return err
+		}
+	case []byte:
+		if err := json.Unmarshal(v, &rr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value return json value, implement driver.Valuer interface
+func (rr RedactionRules) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(rr)
+	return string(bytes), err
+}
@@ -0,0 +1,333 @@
+package multiline
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+)
+
+// LinesAttribute is set on reassembled records to the number of lines they were stitched from.
+const LinesAttribute = "multiline.lines"
+
+const DefaultMaxWait = time.Second
+const MaxMaxWait = time.Minute
+
+// MaxLines bounds the number of lines of a record, so that a stream of continuation lines is still flushed.
+const MaxLines = 1000
+
+// MaxStreams bounds the number of streams with an open record. When it is reached,
+// the record of the oldest stream is submitted to make room for a new stream.
+const MaxStreams = 10000
+
+var (
+	javaException      = regexp.MustCompile(`^(?:Exception in thread "[^"]*" )?((?:[a-zA-Z_$][\w$]*\.)+[A-Z][\w$]*(?:Exception|Error|Throwable))(?:: (.*))?$`)
+	javaContinuation   = regexp.MustCompile(`^(?:Caused by: |Suppressed: |\.\.\. \d+ (?:more|common frames omitted))`)
+	pythonTraceback    = regexp.MustCompile(`^Traceback \(most recent call last\):$`)
+	pythonContinuation = regexp.MustCompile(`^(?:During handling of the above exception, another exception occurred:|The above exception was the direct cause of the following exception:)$`)
+	pythonException    = regexp.MustCompile(`^([A-Za-z_][\w.]*)(?:: (.*))?$`)
+	goPanic            = regexp.MustCompile(`^(panic|fatal error): (.*?)(?: \[recovered\])?$`)
+	goGoroutine        = regexp.MustCompile(`^goroutine \d+ \[`)
+	goContinuation     = regexp.MustCompile(`^(?:goroutine \d+ \[|created by |\[signal |exit status \d+$|\S+\(.*\)$)`)
+)
+
+// presets decide whether a line continues the open record of a stream, by the name of the runtime.
+var presets = map[string]func(lines []string, line string) bool{
+	"java": func(lines []string, line string) bool {
+		return isIndented(line) || javaContinuation.MatchString(line) || javaException.MatchString(line)
+	},
+	"python": func(lines []string, line string) bool {
+		if isIndented(line) || pythonTraceback.MatchString(line) || pythonContinuation.MatchString(line) {
+			return true
+		}
+		// the exception line ends the frames of a traceback
+		return indexOf(lines, pythonTraceback) >= 0 && isIndented(lines[len(lines)-1]) && pythonException.MatchString(line)
+	},
+	"go": func(lines []string, line string) bool {
+		return indexOf(lines, goPanic) >= 0 && (isIndented(line) || goContinuation.MatchString(line))
+	},
+}
+
+// Config configures how the lines of a stream are reassembled into records.
+type Config struct {
+	// Start matches the first line of a record. Other lines continue the open record.
+	Start *regexp.Regexp
+	// Indent makes indented lines continue the open record.
+	Indent bool
+	// MaxWait is how long the open record of a stream waits for a continuation line before it is submitted.
+	MaxWait time.Duration
+
+	continues func(lines []string, line string) bool
+	key       string
+}
+
+// NewConfig builds a multiline configuration from a preset, a start-of-record regex and indent detection.
+// Returns nil when none are set, in which case lines are submitted as they are.
+func NewConfig(preset, start string, indent bool, maxWait time.Duration) (*Config, error) {
+	if preset == "" && start == "" && !indent {
+		return nil, nil
+	}
+	if maxWait == 0 {
+		maxWait = DefaultMaxWait
+	} else if maxWait < 0 || maxWait > MaxMaxWait {
+		return nil, fmt.Errorf("multiline wait must be between 0 and %s", MaxMaxWait)
+	}
+
+	config := &Config{
+		Indent:  indent,
+		MaxWait: maxWait,
+		key:     strings.Join([]string{preset, start, strconv.FormatBool(indent), maxWait.String()}, "\x00"),
+	}
+	if preset != "" {
+		continues, ok := presets[strings.ToLower(preset)]
+		if !ok {
+			return nil, fmt.Errorf("unknown multiline preset %s", preset)
+		}
+		config.continues = continues
+	}
+	if start != "" {
+		var err error
+		if config.Start, err = regexp.Compile(start); err != nil {
+			return nil, e.Wrap(err, "invalid multiline start pattern")
+		}
+	}
+	return config, nil
+}
+
+// Continues returns whether a line continues a record made of the given lines.
+func (c *Config) Continues(lines []string, line string) bool {
+	if strings.TrimSpace(line) == "" {
+		return true
+	}
+	if c.Start != nil {
+		return !c.Start.MatchString(line)
+	}
+	if c.Indent && isIndented(line) {
+		return true
+	}
+	return c.continues != nil && c.continues(lines, line)
+}
+
+type SubmitFunc func(ctx context.Context, projectID int, lg hlog.Log) error
+
+type stream struct {
+	ctx       context.Context
+	projectID int
+	log       hlog.Log
+	lines     []string
+	timer     *time.Timer
+	// elem is the element of the stream in the creation order of the aggregator
+	elem *list.Element
+}
+
+// Aggregator reassembles the lines of log streams into records. A record is submitted when a line
+// starting the next record arrives, or when no continuation line arrives within the wait of the stream.
+// Streams are held in memory, so the lines of a stream are only reassembled when they reach the same aggregator.
+type Aggregator struct {
+	submit SubmitFunc
+
+	mu      sync.Mutex
+	streams map[string]*stream
+	// order holds the keys of the streams from the oldest to the newest
+	order   *list.List
+	stopped bool
+}
+
+func NewAggregator(submit SubmitFunc) *Aggregator {
+	return &Aggregator{submit: submit, streams: map[string]*stream{}, order: list.New()}
+}
+
+// remove removes a stream from the aggregator, which must be locked.
+func (a *Aggregator) remove(key string, s *stream) {
+	s.timer.Stop()
+	delete(a.streams, key)
+	a.order.Remove(s.elem)
+}
+
+// Add adds a log line to a stream. The level, timestamp and attributes of a record are those of its first line.
+// The timestamp of a line is checked when it is added, so that an invalid line fails the request sending it
+// rather than its record failing once submitted after the request is done.
+func (a *Aggregator) Add(ctx context.Context, config *Config, projectID int, key string, lg hlog.Log) error {
+	if err := checkTimestamp(lg.Timestamp); err != nil {
+		return err
+	}
+	key = strings.Join([]string{strconv.Itoa(projectID), config.key, key}, "\x00")
+
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return a.submit(ctx, projectID, lg)
+	}
+	s := a.streams[key]
+	if s != nil && len(s.lines) < MaxLines && config.Continues(s.lines, lg.Message) {
+		s.lines = append(s.lines, lg.Message)
+		s.timer.Reset(config.MaxWait)
+		a.mu.Unlock()
+		return nil
+	}
+	if s != nil {
+		a.remove(key, s)
+	}
+	var evicted *stream
+	if strings.TrimSpace(lg.Message) != "" {
+		if len(a.streams) >= MaxStreams {
+			oldest := a.order.Front().Value.(string)
+			evicted = a.streams[oldest]
+			a.remove(oldest, evicted)
+		}
+		next := &stream{
+			// the record may be submitted after the request of its first line is done
+			ctx:       context.WithoutCancel(ctx),
+			projectID: projectID,
+			log:       lg,
+			lines:     []string{lg.Message},
+		}
+		next.timer = time.AfterFunc(config.MaxWait, func() {
+			a.expire(key, next)
+		})
+		next.elem = a.order.PushBack(key)
+		a.streams[key] = next
+	}
+	a.mu.Unlock()
+
+	if evicted != nil {
+		if err := a.submit(evicted.ctx, evicted.projectID, evicted.record()); err != nil {
+			log.WithContext(evicted.ctx).WithError(err).Error("failed to submit multiline log")
+		}
+	}
+
+	if s == nil {
+		return nil
+	}
+	return a.submit(s.ctx, s.projectID, s.record())
+}
+
+func (a *Aggregator) expire(key string, s *stream) {
+	a.mu.Lock()
+	if a.streams[key] != s {
+		a.mu.Unlock()
+		return
+	}
+	a.remove(key, s)
+	a.mu.Unlock()
+
+	if err := a.submit(s.ctx, s.projectID, s.record()); err != nil {
+		log.WithContext(s.ctx).WithError(err).Error("failed to submit multiline log")
+	}
+}
+
+// Flush submits the open records of all streams.
+func (a *Aggregator) Flush(ctx context.Context) error {
+	a.mu.Lock()
+	streams := a.streams
+	a.streams = map[string]*stream{}
+	a.order.Init()
+	a.mu.Unlock()
+
+	var errs []error
+	for _, s := range streams {
+		s.timer.Stop()
+		if err := a.submit(s.ctx, s.projectID, s.record()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Stop submits the open records of all streams, so that they are not lost on shutdown.
+// Lines added after Stop are submitted as they are.
+func (a *Aggregator) Stop(ctx context.Context) {
+	a.mu.Lock()
+	a.stopped = true
+	a.mu.Unlock()
+
+	if err := a.Flush(ctx); err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to flush multiline logs on shutdown")
+	}
+}
+
+func checkTimestamp(timestamp string) error {
+	if _, err := time.Parse(hlog.TimestampFormat, timestamp); err == nil {
+		return nil
+	}
+	if _, err := time.Parse(hlog.TimestampFormatNano, timestamp); err != nil {
+		return e.Wrap(err, "invalid log timestamp")
+	}
+	return nil
+}
+
+func (s *stream) record() hlog.Log {
+	lines := s.lines
+	for len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 1 {
+		return s.log
+	}
+
+	lg := hlog.Log{
+		Message:    strings.Join(lines, "\n"),
+		Timestamp:  s.log.Timestamp,
+		Level:      s.log.Level,
+		Attributes: make(map[string]string, len(s.log.Attributes)+4),
+	}
+	for k, v := range s.log.Attributes {
+		lg.Attributes[k] = v
+	}
+	lg.Attributes[LinesAttribute] = strconv.Itoa(len(lines))
+	if typ, message, stackTrace, ok := Exception(lines); ok {
+		lg.Attributes[string(semconv.ExceptionTypeKey)] = typ
+		lg.Attributes[string(semconv.ExceptionMessageKey)] = message
+		lg.Attributes[string(semconv.ExceptionStacktraceKey)] = stackTrace
+	}
+	return lg
+}
+
+// Exception detects a java, python or go stack trace in the lines of a record,
+// returning the type and message of the exception and the stack trace.
+func Exception(lines []string) (typ, message, stackTrace string, ok bool) {
+	for idx, line := range lines {
+		if match := javaException.FindStringSubmatch(line); match != nil && idx+1 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[idx+1]), "at ") {
+			return match[1], match[2], strings.Join(lines[idx:], "\n"), true
+		}
+	}
+
+	if start := indexOf(lines, pythonTraceback); start >= 0 {
+		// the last exception of a chained traceback is the one raised
+		for idx := len(lines) - 1; idx > start; idx-- {
+			if match := pythonException.FindStringSubmatch(lines[idx]); match != nil && isIndented(lines[idx-1]) {
+				return match[1], match[2], strings.Join(lines[start:idx+1], "\n"), true
+			}
+		}
+	}
+
+	if start := indexOf(lines, goPanic); start >= 0 && indexOf(lines[start:], goGoroutine) >= 0 {
+		match := goPanic.FindStringSubmatch(lines[start])
+		return match[1], match[2], strings.Join(lines[start:], "\n"), true
+	}
+	return "", "", "", false
+}
+
+func isIndented(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+}
+
+func indexOf(lines []string, pattern *regexp.Regexp) int {
+	for idx, line := range lines {
+		if pattern.MatchString(line) {
+			return idx
+		}
+	}
+	return -1
+}
@@ -0,0 +1,198 @@
+package multiline
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+)
+
+const javaStackTrace = `2024-05-01 12:00:00 ERROR c.e.OrderService - failed to place order
+java.lang.IllegalStateException: order already placed
+	at com.example.OrderService.place(OrderService.java:42)
+	at com.example.OrderController.post(OrderController.java:17)
+Caused by: java.sql.SQLException: duplicate key
+	at com.example.OrderRepository.insert(OrderRepository.java:88)
+	... 2 more`
+
+const pythonStackTrace = `ERROR:root:failed to place order
+Traceback (most recent call last):
+  File "/app/orders.py", line 12, in place
+    insert(order)
+  File "/app/db.py", line 5, in insert
+    raise ValueError("duplicate key")
+ValueError: duplicate key`
+
+const goStackTrace = `panic: runtime error: index out of range [3] with length 3
+
+goroutine 1 [running]:
+main.place(...)
+	/app/main.go:12
+main.main()
+	/app/main.go:20 +0x1d
+exit status 2`
+
+const timestamp = "2024-05-01T12:00:00Z"
+
+type recorder struct {
+	mu   sync.Mutex
+	logs []hlog.Log
+}
+
+func (r *recorder) submit(_ context.Context, _ int, lg hlog.Log) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logs = append(r.logs, lg)
+	return nil
+}
+
+func (r *recorder) messages() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var messages []string
+	for _, lg := range r.logs {
+		messages = append(messages, lg.Message)
+	}
+	return messages
+}
+
+func add(t *testing.T, a *Aggregator, config *Config, stream string, lines ...string) {
+	for _, line := range lines {
+		require.NoError(t, a.Add(context.TODO(), config, 1, stream, hlog.Log{Message: line, Level: "info", Timestamp: timestamp, Attributes: map[string]string{"service.name": "orders"}}))
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	config, err := NewConfig("", "", false, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, config)
+
+	config, err = NewConfig("java", "", false, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMaxWait, config.MaxWait)
+
+	_, err = NewConfig("cobol", "", false, 0)
+	assert.Error(t, err)
+	_, err = NewConfig("", "(", false, 0)
+	assert.Error(t, err)
+	_, err = NewConfig("", "", true, time.Hour)
+	assert.Error(t, err)
+}
+
+func TestPresets(t *testing.T) {
+	for preset, stackTrace := range map[string]string{"java": javaStackTrace, "python": pythonStackTrace, "go": goStackTrace} {
+		t.Run(preset, func(t *testing.T) {
+			config, err := NewConfig(preset, "", false, time.Minute)
+			require.NoError(t, err)
+
+			r := &recorder{}
+			a := NewAggregator(r.submit)
+			add(t, a, config, "", "starting up")
+			add(t, a, config, "", strings.Split(stackTrace, "\n")...)
+			add(t, a, config, "", "shutting down")
+			assert.Equal(t, []string{"starting up", stackTrace}, r.messages())
+			assert.Equal(t, "orders", r.logs[1].Attributes["service.name"])
+			assert.Equal(t, "info", r.logs[1].Level)
+		})
+	}
+}
+
+func TestStartAndIndent(t *testing.T) {
+	config, err := NewConfig("", `^\d{4}-\d{2}-\d{2}`, false, time.Minute)
+	require.NoError(t, err)
+	r := &recorder{}
+	a := NewAggregator(r.submit)
+	add(t, a, config, "", "2024-05-01 first", "continued", "", "2024-05-01 second", "2024-05-01 third")
+	assert.Equal(t, []string{"2024-05-01 first\ncontinued", "2024-05-01 second"}, r.messages())
+	assert.Equal(t, "2", r.logs[0].Attributes[LinesAttribute])
+	assert.NotContains(t, r.logs[1].Attributes, LinesAttribute)
+
+	config, err = NewConfig("", "", true, time.Minute)
+	require.NoError(t, err)
+	r = &recorder{}
+	a = NewAggregator(r.submit)
+	add(t, a, config, "a", "first", "  indented")
+	add(t, a, config, "b", "other stream", "\tindented")
+	add(t, a, config, "a", "second")
+	add(t, a, config, "b", "next")
+	assert.Equal(t, []string{"first\n  indented", "other stream\n\tindented"}, r.messages())
+}
+
+func TestMaxWait(t *testing.T) {
+	config, err := NewConfig("", "", true, 10*time.Millisecond)
+	require.NoError(t, err)
+	r := &recorder{}
+	a := NewAggregator(r.submit)
+	add(t, a, config, "", "first", "  indented")
+	assert.Eventually(t, func() bool {
+		return len(r.messages()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"first\n  indented"}, r.messages())
+}
+
+func TestException(t *testing.T) {
+	for stackTrace, expected := range map[string][3]string{
+		javaStackTrace:   {"java.lang.IllegalStateException", "order already placed", strings.Join(strings.Split(javaStackTrace, "\n")[1:], "\n")},
+		pythonStackTrace: {"ValueError", "duplicate key", strings.Join(strings.Split(pythonStackTrace, "\n")[1:], "\n")},
+		goStackTrace:     {"panic", "runtime error: index out of range [3] with length 3", goStackTrace},
+	} {
+		typ, message, trace, ok := Exception(strings.Split(stackTrace, "\n"))
+		assert.True(t, ok)
+		assert.Equal(t, expected, [3]string{typ, message, trace})
+	}
+
+	_, _, _, ok := Exception([]string{"request failed", "  retrying"})
+	assert.False(t, ok)
+
+	config, err := NewConfig("java", "", false, time.Minute)
+	require.NoError(t, err)
+	r := &recorder{}
+	a := NewAggregator(r.submit)
+	add(t, a, config, "", strings.Split(javaStackTrace, "\n")...)
+	add(t, a, config, "", "next")
+	assert.Equal(t, "java.lang.IllegalStateException", r.logs[0].Attributes[string(semconv.ExceptionTypeKey)])
+	assert.Equal(t, "order already placed", r.logs[0].Attributes[string(semconv.ExceptionMessageKey)])
+}
+
+func TestStop(t *testing.T) {
+	config, err := NewConfig("", "", true, time.Minute)
+	require.NoError(t, err)
+	r := &recorder{}
+	a := NewAggregator(r.submit)
+	add(t, a, config, "a", "first", "  indented")
+	add(t, a, config, "b", "second")
+
+	a.Stop(context.TODO())
+	assert.ElementsMatch(t, []string{"first\n  indented", "second"}, r.messages())
+
+	add(t, a, config, "a", "third", "  indented")
+	assert.ElementsMatch(t, []string{"first\n  indented", "second", "third", "  indented"}, r.messages())
+
+	assert.Error(t, a.Add(context.TODO(), config, 1, "a", hlog.Log{Message: "invalid", Timestamp: "yesterday"}))
+}
+
+func TestMaxStreams(t *testing.T) {
+	config, err := NewConfig("", "", true, time.Minute)
+	require.NoError(t, err)
+	r := &recorder{}
+	a := NewAggregator(r.submit)
+	for i := 0; i < MaxStreams; i++ {
+		add(t, a, config, strconv.Itoa(i), "line")
+	}
+	assert.Empty(t, r.messages())
+
+	add(t, a, config, "0", "  indented")
+	add(t, a, config, "new", "first")
+	assert.Equal(t, []string{"line\n  indented"}, r.messages())
+	assert.Len(t, a.streams, MaxStreams)
+	assert.Equal(t, a.order.Len(), MaxStreams)
+	assert.NotContains(t, a.streams, strings.Join([]string{"1", config.key, "0"}, "\x00"))
+}
@@ -0,0 +1,191 @@
+package otel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	e "github.com/pkg/errors"
+)
+
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeJSON     = "application/json"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// MaxDecompressedBodyBytes bounds the size of a decompressed request body.
+const MaxDecompressedBodyBytes = 64 * 1024 * 1024
+
+var ErrUnsupportedMediaType = e.New("unsupported otlp content type or encoding")
+
+var ErrBodyTooLarge = e.New("decompressed otlp body is too large")
+
+type otlpRequest interface {
+	UnmarshalProto(data []byte) error
+	UnmarshalJSON(data []byte) error
+}
+
+type otlpResponse interface {
+	MarshalProto() ([]byte, error)
+	MarshalJSON() ([]byte, error)
+}
+
+// getContentType returns the OTLP/HTTP content type of the request, defaulting to protobuf.
+func getContentType(r *http.Request) (string, error) {
+	header := r.Header.Get("Content-Type")
+	if header == "" {
+		return ContentTypeProtobuf, nil
+	}
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		return "", e.Wrap(ErrUnsupportedMediaType, err.Error())
+	}
+	switch mediaType {
+	case ContentTypeProtobuf, "application/protobuf", "application/octet-stream":
+		return ContentTypeProtobuf, nil
+	case ContentTypeJSON:
+		return ContentTypeJSON, nil
+	}
+	return "", e.Wrapf(ErrUnsupportedMediaType, "content type %s", mediaType)
+}
+
+// decompress decodes the body according to the Content-Encoding header.
+// Without the header, gzip and zstd payloads are detected by their magic bytes
+// since older SDKs send gzip without setting the encoding.
+func decompress(encoding string, body []byte) ([]byte, error) {
+	encoding = strings.ToLower(strings.TrimSpace(encoding))
+	if encoding == "" {
+		if bytes.HasPrefix(body, gzipMagic) {
+			encoding = "gzip"
+		} else if bytes.HasPrefix(body, zstdMagic) {
+			encoding = "zstd"
+		}
+	}
+
+	switch encoding {
+	case "", "identity":
+		return body, nil
+	case "gzip", "x-gzip":
+		gz, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, e.Wrap(err, "invalid gzip format")
+		}
+		defer gz.Close()
+		output, err := readDecompressed(gz)
+		if err != nil {
+			return nil, e.Wrap(err, "invalid gzip stream")
+		}
+		return output, nil
+	case "deflate":
+		zr, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, e.Wrap(err, "invalid deflate format")
+		}
+		defer zr.Close()
+		output, err := readDecompressed(zr)
+		if err != nil {
+			return nil, e.Wrap(err, "invalid deflate stream")
+		}
+		return output, nil
+	case "zstd":
+		zr, err := zstd.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, e.Wrap(err, "invalid zstd format")
+		}
+		defer zr.Close()
+		output, err := readDecompressed(zr)
+		if err != nil {
+			return nil, e.Wrap(err, "invalid zstd stream")
+		}
+		return output, nil
+	}
+	return nil, e.Wrapf(ErrUnsupportedMediaType, "content encoding %s", encoding)
+}
+
+// readDecompressed reads a decompressing reader, failing once the output exceeds MaxDecompressedBodyBytes.
+func readDecompressed(reader io.Reader) ([]byte, error) {
+	output, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedBodyBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(output) > MaxDecompressedBodyBytes {
+		return nil, ErrBodyTooLarge
+	}
+	return output, nil
+}
+
+// readRequest reads an OTLP/HTTP export request, negotiating the
+// encoding and content type as described by the OTLP specification.
+func readRequest(r *http.Request, req otlpRequest) error {
+	contentType, err := getContentType(r)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return e.Wrap(err, "invalid body")
+	}
+
+	output, err := decompress(r.Header.Get("Content-Encoding"), body)
+	if err != nil {
+		return err
+	}
+
+	if contentType == ContentTypeJSON {
+		if err := req.UnmarshalJSON(output); err != nil {
+			return e.Wrap(err, "invalid json")
+		}
+		return nil
+	}
+
+	if err := req.UnmarshalProto(output); err != nil {
+		return e.Wrap(err, "invalid protobuf")
+	}
+	return nil
+}
+
+// writeRequestError responds with a 415 for content the handler cannot negotiate,
+// a 413 for a body that decompresses past MaxDecompressedBodyBytes, otherwise a 400.
+func writeRequestError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if e.Is(err, ErrUnsupportedMediaType) {
+		status = http.StatusUnsupportedMediaType
+	} else if e.Is(err, ErrBodyTooLarge) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	http.Error(w, err.Error(), status)
+}
+
+// writeResponse encodes the export response with the content type of the request.
+func writeResponse(w http.ResponseWriter, r *http.Request, resp otlpResponse) {
+	contentType, err := getContentType(r)
+	if err != nil {
+		contentType = ContentTypeProtobuf
+	}
+
+	var body []byte
+	if contentType == ContentTypeJSON {
+		body, err = resp.MarshalJSON()
+	} else {
+		body, err = resp.MarshalProto()
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
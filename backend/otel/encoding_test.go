@@ -0,0 +1,114 @@
+package otel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+)
+
+func newMetricsRequest(t *testing.T) pmetricotlp.ExportRequest {
+	inputBytes, err := os.ReadFile("./samples/metrics.json")
+	if err != nil {
+		t.Fatalf("error reading: %v", err)
+	}
+
+	req := pmetricotlp.NewExportRequest()
+	if err := req.UnmarshalJSON(inputBytes); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func Test_readRequest(t *testing.T) {
+	expected := newMetricsRequest(t)
+	proto, err := expected.MarshalProto()
+	assert.NoError(t, err)
+	json, err := expected.MarshalJSON()
+	assert.NoError(t, err)
+
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	_, err = gz.Write(proto)
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+
+	zw, err := zstd.NewWriter(nil)
+	assert.NoError(t, err)
+	zstdJson := zw.EncodeAll(json, nil)
+
+	for name, tc := range map[string]struct {
+		body            []byte
+		contentType     string
+		contentEncoding string
+	}{
+		"gzip protobuf without headers": {body: gzipped.Bytes()},
+		"gzip protobuf":                 {body: gzipped.Bytes(), contentType: ContentTypeProtobuf, contentEncoding: "gzip"},
+		"uncompressed protobuf":         {body: proto, contentType: ContentTypeProtobuf},
+		"uncompressed json":             {body: json, contentType: "application/json; charset=utf-8"},
+		"zstd json":                     {body: zstdJson, contentType: ContentTypeJSON, contentEncoding: "zstd"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/otel/v1/metrics", bytes.NewReader(tc.body))
+			if tc.contentType != "" {
+				r.Header.Set("Content-Type", tc.contentType)
+			}
+			if tc.contentEncoding != "" {
+				r.Header.Set("Content-Encoding", tc.contentEncoding)
+			}
+
+			req := pmetricotlp.NewExportRequest()
+			assert.NoError(t, readRequest(r, req))
+			assert.Equal(t, expected.Metrics().DataPointCount(), req.Metrics().DataPointCount())
+		})
+	}
+}
+
+func Test_readRequestUnsupported(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/otel/v1/metrics", bytes.NewReader([]byte("hello")))
+	r.Header.Set("Content-Type", "text/plain")
+	err := readRequest(r, pmetricotlp.NewExportRequest())
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+
+	r = httptest.NewRequest(http.MethodPost, "/otel/v1/metrics", bytes.NewReader([]byte("hello")))
+	r.Header.Set("Content-Encoding", "br")
+	err = readRequest(r, pmetricotlp.NewExportRequest())
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+
+	w := httptest.NewRecorder()
+	writeRequestError(w, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func Test_readRequestTooLarge(t *testing.T) {
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	_, err := gz.Write(make([]byte, MaxDecompressedBodyBytes+1))
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/otel/v1/metrics", bytes.NewReader(gzipped.Bytes()))
+	r.Header.Set("Content-Encoding", "gzip")
+	err = readRequest(r, pmetricotlp.NewExportRequest())
+	assert.ErrorIs(t, err, ErrBodyTooLarge)
+
+	w := httptest.NewRecorder()
+	writeRequestError(w, err)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func Test_writeResponse(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/otel/v1/metrics", nil)
+	r.Header.Set("Content-Type", ContentTypeJSON)
+	w := httptest.NewRecorder()
+	writeResponse(w, r, pmetricotlp.NewExportResponse())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, ContentTypeJSON, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"partialSuccess":{}}`, w.Body.String())
+}
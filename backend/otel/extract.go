@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/highlight-run/highlight/backend/clickhouse"
 	model "github.com/highlight-run/highlight/backend/model"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/public-graph/graph"
@@ -37,6 +38,9 @@ type extractedFields struct {
 	serviceName    string
 	serviceVersion string
 
+	// kubernetes and cloud resource attributes stored in dedicated columns of logs and traces
+	resource clickhouse.ResourceFields
+
 	timestamp time.Time
 
 	logSeverity string
@@ -77,6 +81,7 @@ type extractFieldsParams struct {
 	event     *ptrace.SpanEvent
 	scopeLogs *plog.ScopeLogs
 	logRecord *plog.LogRecord
+	dataPoint metricDataPoint
 	curTime   time.Time
 
 	herokuProjectExtractor func(context.Context, string) (string, int)
@@ -85,7 +90,7 @@ type extractFieldsParams struct {
 func extractFields(ctx context.Context, params extractFieldsParams) (*extractedFields, error) {
 	fields := newExtractedFields()
 
-	var resourceAttributes, spanAttributes, eventAttributes, scopeAttributes, logAttributes map[string]any
+	var resourceAttributes, spanAttributes, eventAttributes, scopeAttributes, logAttributes, dataPointAttributes map[string]any
 	if params.resource != nil {
 		resourceAttributes = params.resource.Attributes().AsRaw()
 	}
@@ -157,7 +162,7 @@ func extractFields(ctx context.Context, params extractFieldsParams) (*extractedF
 			}
 
 			// process potential syslog message
-			extractSyslog(fields)
+			extractSyslog(fields, params.scopeLogs != nil && params.scopeLogs.Scope().Name() == syslogScopeName)
 			if fields.attrs["app_name"] == "heroku" {
 				if params.herokuProjectExtractor != nil {
 					fields.projectID, fields.projectIDInt = params.herokuProjectExtractor(ctx, fields.attrs["hostname"])
@@ -166,12 +171,18 @@ func extractFields(ctx context.Context, params extractFieldsParams) (*extractedF
 		}
 	}
 
+	if params.dataPoint != nil {
+		fields.timestamp = params.dataPoint.Timestamp().AsTime()
+		dataPointAttributes = params.dataPoint.Attributes().AsRaw()
+	}
+
 	originalAttrs := mergeMaps(
 		resourceAttributes,
 		spanAttributes,
 		eventAttributes,
 		scopeAttributes,
 		logAttributes,
+		dataPointAttributes,
 	)
 
 	if val, ok := originalAttrs[highlight.DeprecatedSourceAttribute]; ok {
@@ -311,6 +322,11 @@ func extractFields(ctx context.Context, params extractFieldsParams) (*extractedF
 		delete(fields.attrs, string(semconv.ServiceVersionKey))
 	}
 
+	// metrics do not have dedicated columns for resource attributes, so they are kept as attributes
+	if params.dataPoint == nil {
+		fields.resource = clickhouse.PromoteResourceFields(fields.attrs)
+	}
+
 	if fields.projectID == "" {
 		if tag := fields.attrs["fluent.tag"]; tag != "" {
 			project := fluentProjectPattern.FindStringSubmatch(tag)
@@ -292,6 +292,23 @@ func TestExtractFields_ExtractServiceVersion(t *testing.T) {
 	assert.Equal(t, fields.attrs, map[string]string{})
 }
 
+func TestExtractFields_ExtractResourceFields(t *testing.T) {
+	resource := newResource(t, map[string]any{
+		string(semconv.K8SNamespaceNameKey): "payments",
+		string(semconv.K8SPodNameKey):       "payments-api-6cc89b447c-wbksh",
+		string(semconv.ContainerIDKey):      "b202eacdb71a",
+		string(semconv.CloudRegionKey):      "us-east-2",
+		"k8s.pod.uid":                       "1234",
+	})
+	fields, err := extractFields(ctx, extractFieldsParams{resource: &resource})
+	assert.NoError(t, err)
+	assert.Equal(t, "payments", fields.resource.K8sNamespace)
+	assert.Equal(t, "payments-api-6cc89b447c-wbksh", fields.resource.K8sPod)
+	assert.Equal(t, "b202eacdb71a", fields.resource.ContainerId)
+	assert.Equal(t, "us-east-2", fields.resource.CloudRegion)
+	assert.Equal(t, fields.attrs, map[string]string{"k8s.pod.uid": "1234"})
+}
+
 func TestExtractFields_ExtractEvents(t *testing.T) {
 	span := newSpan(map[string]string{})
 	event := span.Events().AppendEmpty()
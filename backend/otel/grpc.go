@@ -0,0 +1,110 @@
+package otel
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxGRPCMessageSize matches the default max request size of the otel collector
+const maxGRPCMessageSize = 64 * 1024 * 1024
+
+type traceServer struct {
+	ptraceotlp.UnimplementedGRPCServer
+	handler *Handler
+}
+
+func (s *traceServer) Export(ctx context.Context, req ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	resp, err := s.handler.exportTraces(ctx, getGRPCHeaders(ctx), req)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to export otel traces over grpc")
+		return resp, status.Error(codes.Unavailable, err.Error())
+	}
+	return resp, nil
+}
+
+type logServer struct {
+	plogotlp.UnimplementedGRPCServer
+	handler *Handler
+}
+
+func (s *logServer) Export(ctx context.Context, req plogotlp.ExportRequest) (plogotlp.ExportResponse, error) {
+	resp, err := s.handler.exportLogs(ctx, getGRPCHeaders(ctx), req)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to export otel logs over grpc")
+		return resp, status.Error(codes.Unavailable, err.Error())
+	}
+	return resp, nil
+}
+
+type metricServer struct {
+	pmetricotlp.UnimplementedGRPCServer
+	handler *Handler
+}
+
+func (s *metricServer) Export(ctx context.Context, req pmetricotlp.ExportRequest) (pmetricotlp.ExportResponse, error) {
+	resp, err := s.handler.exportMetrics(ctx, getGRPCHeaders(ctx), req)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to export otel metrics over grpc")
+		return resp, status.Error(codes.Unavailable, err.Error())
+	}
+	return resp, nil
+}
+
+// getGRPCHeaders exposes the incoming grpc metadata as http headers so that
+// fields such as the project id header are extracted the same way as over http.
+func getGRPCHeaders(ctx context.Context) http.Header {
+	headers := http.Header{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return headers
+	}
+	for key, values := range md {
+		for _, value := range values {
+			headers.Add(key, value)
+		}
+	}
+	return headers
+}
+
+// NewGRPCServer creates a grpc server implementing the OTLP trace, logs and metrics export services.
+func (o *Handler) NewGRPCServer() *grpc.Server {
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(maxGRPCMessageSize))
+	ptraceotlp.RegisterGRPCServer(server, &traceServer{handler: o})
+	plogotlp.RegisterGRPCServer(server, &logServer{handler: o})
+	pmetricotlp.RegisterGRPCServer(server, &metricServer{handler: o})
+	return server
+}
+
+// ListenGRPC serves the OTLP grpc receiver on the provided port, typically 4317, until the context is done.
+// The server is then stopped gracefully, returning once the in-flight exports completed.
+func (o *Handler) ListenGRPC(ctx context.Context, port string) error {
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return e.Wrapf(err, "failed to listen for otlp grpc on port %s", port)
+	}
+
+	server := o.NewGRPCServer()
+	stopped := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+		close(stopped)
+	}()
+	if err := server.Serve(lis); err != nil {
+		return err
+	}
+	<-stopped
+	return nil
+}
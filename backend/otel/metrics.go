@@ -0,0 +1,232 @@
+package otel
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const MetricQuantileAttribute = "quantile"
+
+// metricDataPoint is implemented by every pmetric data point type
+type metricDataPoint interface {
+	Attributes() pcommon.Map
+	StartTimestamp() pcommon.Timestamp
+	Timestamp() pcommon.Timestamp
+}
+
+type metricValue struct {
+	dataPoint      metricDataPoint
+	name           string
+	metricType     string
+	temporality    string
+	isMonotonic    bool
+	unit           string
+	value          float64
+	isAggregate    bool
+	count          uint64
+	sum            float64
+	min            float64
+	max            float64
+	bucketCounts   []uint64
+	explicitBounds []float64
+	attrs          map[string]string
+}
+
+func (v *metricValue) toRow(timestamp time.Time, projectID int) *clickhouse.MetricRow {
+	row := clickhouse.NewMetricRow(timestamp, projectID).
+		WithMetricName(v.name).
+		WithMetricType(v.metricType).
+		WithTemporality(v.temporality, v.isMonotonic).
+		WithUnit(v.unit).
+		WithStartTimestamp(v.dataPoint.StartTimestamp().AsTime()).
+		WithValue(v.value)
+	if v.isAggregate {
+		row = row.WithAggregate(v.count, v.sum, v.min, v.max)
+	}
+	if len(v.bucketCounts) > 0 {
+		row = row.WithBuckets(v.bucketCounts, v.explicitBounds)
+	}
+	return row
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
+
+func getTemporality(temporality pmetric.AggregationTemporality) string {
+	switch temporality {
+	case pmetric.AggregationTemporalityDelta:
+		return clickhouse.MetricTemporalityDelta
+	case pmetric.AggregationTemporalityCumulative:
+		return clickhouse.MetricTemporalityCumulative
+	}
+	return clickhouse.MetricTemporalityUnspecified
+}
+
+// aggregateValues flattens the count / sum of a pre-aggregated data point (histogram, summary)
+// into monotonic counters, which can be queried with rate and increase.
+func aggregateValues(base metricValue, count uint64, sum float64, hasSum bool) []metricValue {
+	countValue := base
+	countValue.name = base.name + ".count"
+	countValue.metricType = clickhouse.MetricTypeSum
+	countValue.isMonotonic = true
+	countValue.unit = ""
+	countValue.value = float64(count)
+	values := []metricValue{countValue}
+	if hasSum {
+		sumValue := base
+		sumValue.name = base.name + ".sum"
+		sumValue.metricType = clickhouse.MetricTypeSum
+		sumValue.isMonotonic = true
+		sumValue.value = sum
+		values = append(values, sumValue)
+	}
+	return values
+}
+
+// histogramValues records the histogram with its buckets under the metric name in addition to the count / sum.
+func histogramValues(base metricValue, count uint64, sum float64, hasSum bool, min float64, max float64, bucketCounts []uint64, explicitBounds []float64) []metricValue {
+	values := aggregateValues(base, count, sum, hasSum)
+	if hasSum && count > 0 {
+		histogram := base
+		histogram.isAggregate = true
+		histogram.count = count
+		histogram.sum = sum
+		histogram.min = min
+		histogram.max = max
+		histogram.bucketCounts = bucketCounts
+		histogram.explicitBounds = explicitBounds
+		values = append(values, histogram)
+	}
+	return values
+}
+
+// exponentialBuckets converts exponential histogram buckets into the explicit bounds representation,
+// where len(bucketCounts) == len(explicitBounds) + 1 and the last bucket is unbounded.
+func exponentialBuckets(dp pmetric.ExponentialHistogramDataPoint) ([]uint64, []float64) {
+	base := math.Pow(2, math.Pow(2, -float64(dp.Scale())))
+	var bucketCounts []uint64
+	var explicitBounds []float64
+
+	// negative bucket i covers [-base^(i+1), -base^i), so iterate from the most negative bucket
+	negative := dp.Negative()
+	for k := negative.BucketCounts().Len() - 1; k >= 0; k-- {
+		explicitBounds = append(explicitBounds, -math.Pow(base, float64(int(negative.Offset())+k)))
+		bucketCounts = append(bucketCounts, negative.BucketCounts().At(k))
+	}
+
+	if dp.ZeroCount() > 0 {
+		explicitBounds = append(explicitBounds, dp.ZeroThreshold())
+		bucketCounts = append(bucketCounts, dp.ZeroCount())
+	}
+
+	// positive bucket i covers (base^i, base^(i+1)]
+	positive := dp.Positive()
+	for k := 0; k < positive.BucketCounts().Len(); k++ {
+		explicitBounds = append(explicitBounds, math.Pow(base, float64(int(positive.Offset())+k+1)))
+		bucketCounts = append(bucketCounts, positive.BucketCounts().At(k))
+	}
+
+	if len(bucketCounts) == 0 {
+		return nil, nil
+	}
+	return append(bucketCounts, 0), explicitBounds
+}
+
+// getMetricValues converts every data point of an otel metric into individual values.
+func getMetricValues(metric pmetric.Metric) []metricValue {
+	var values []metricValue
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			values = append(values, metricValue{
+				dataPoint:   dp,
+				name:        metric.Name(),
+				metricType:  clickhouse.MetricTypeGauge,
+				temporality: clickhouse.MetricTemporalityUnspecified,
+				unit:        metric.Unit(),
+				value:       numberValue(dp),
+			})
+		}
+	case pmetric.MetricTypeSum:
+		sum := metric.Sum()
+		dps := sum.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			values = append(values, metricValue{
+				dataPoint:   dp,
+				name:        metric.Name(),
+				metricType:  clickhouse.MetricTypeSum,
+				temporality: getTemporality(sum.AggregationTemporality()),
+				isMonotonic: sum.IsMonotonic(),
+				unit:        metric.Unit(),
+				value:       numberValue(dp),
+			})
+		}
+	case pmetric.MetricTypeHistogram:
+		histogram := metric.Histogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			base := metricValue{
+				dataPoint:   dp,
+				name:        metric.Name(),
+				metricType:  clickhouse.MetricTypeHistogram,
+				temporality: getTemporality(histogram.AggregationTemporality()),
+				unit:        metric.Unit(),
+			}
+			values = append(values, histogramValues(base, dp.Count(), dp.Sum(), dp.HasSum(), dp.Min(), dp.Max(), dp.BucketCounts().AsRaw(), dp.ExplicitBounds().AsRaw())...)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		histogram := metric.ExponentialHistogram()
+		dps := histogram.DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			base := metricValue{
+				dataPoint:   dp,
+				name:        metric.Name(),
+				metricType:  clickhouse.MetricTypeExponentialHistogram,
+				temporality: getTemporality(histogram.AggregationTemporality()),
+				unit:        metric.Unit(),
+			}
+			bucketCounts, explicitBounds := exponentialBuckets(dp)
+			values = append(values, histogramValues(base, dp.Count(), dp.Sum(), dp.HasSum(), dp.Min(), dp.Max(), bucketCounts, explicitBounds)...)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			base := metricValue{
+				dataPoint:  dp,
+				name:       metric.Name(),
+				metricType: clickhouse.MetricTypeSummary,
+				// summaries are always cumulative
+				temporality: clickhouse.MetricTemporalityCumulative,
+				unit:        metric.Unit(),
+			}
+			values = append(values, aggregateValues(base, dp.Count(), dp.Sum(), true)...)
+			quantiles := dp.QuantileValues()
+			for j := 0; j < quantiles.Len(); j++ {
+				q := quantiles.At(j)
+				quantile := base
+				quantile.temporality = clickhouse.MetricTemporalityUnspecified
+				quantile.value = q.Value()
+				quantile.attrs = map[string]string{
+					MetricQuantileAttribute: strconv.FormatFloat(q.Quantile(), 'f', -1, 64),
+				}
+				values = append(values, quantile)
+			}
+		}
+	}
+	return values
+}
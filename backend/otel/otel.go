@@ -1,13 +1,10 @@
 package otel
 
 import (
-	"bytes"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/highlight-run/highlight/backend/redis"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -22,9 +19,12 @@ import (
 	"github.com/highlight-run/highlight/backend/clickhouse"
 	kafkaqueue "github.com/highlight-run/highlight/backend/kafka-queue"
 	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/multiline"
+	"github.com/highlight-run/highlight/backend/pipeline"
 	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/public-graph/graph"
 	"github.com/highlight-run/highlight/backend/public-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/redact"
 	"github.com/highlight-run/highlight/backend/stacktraces"
 	"github.com/highlight/highlight/sdk/highlight-go"
 	highlightChi "github.com/highlight/highlight/sdk/highlight-go/middleware/chi"
@@ -71,7 +71,7 @@ func getBackendError(ctx context.Context, ts time.Time, fields *extractedFields,
 		fields.exceptionStackTrace = ""
 	}
 	fields.exceptionStackTrace = stacktraces.FormatStructureStackTrace(ctx, fields.exceptionStackTrace)
-	payloadBytes, _ := json.Marshal(fields.attrs)
+	payloadBytes, _ := json.Marshal(lo.Assign(fields.attrs, fields.resource.Attributes()))
 	err := &model.BackendErrorObjectInput{
 		SessionSecureID: &fields.sessionID,
 		RequestID:       &fields.requestID,
@@ -104,7 +104,7 @@ func getMetric(ctx context.Context, ts time.Time, fields *extractedFields, spanI
 	if fields.metricEventName == "" {
 		return nil, e.New("otel received metric with no name")
 	}
-	tags := lo.Map(lo.Entries(fields.attrs), func(t lo.Entry[string, string], i int) *model.MetricTag {
+	tags := lo.Map(lo.Entries(lo.Assign(fields.attrs, fields.resource.Attributes())), func(t lo.Entry[string, string], i int) *model.MetricTag {
 		return &model.MetricTag{
 			Name:  t.Key,
 			Value: t.Value,
@@ -133,41 +133,96 @@ func getMetric(ctx context.Context, ts time.Time, fields *extractedFields, spanI
 
 func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid trace logBody")
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	req := ptraceotlp.NewExportRequest()
+	if err := readRequest(r, req); err != nil {
+		log.WithContext(ctx).WithError(err).Error("invalid trace export request")
+		writeRequestError(w, err)
 		return
 	}
 
-	gz, err := gzip.NewReader(bytes.NewReader(body))
+	resp, err := o.exportTraces(ctx, r.Header, req)
 	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip format for trace")
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.WithContext(ctx).WithError(err).Error("failed to export otel traces")
+		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
 
-	output, err := io.ReadAll(gz)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip stream for trace")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+	writeResponse(w, r, resp)
+}
+
+// tracesExport holds the items built from the spans of an export request.
+type tracesExport struct {
+	projectSessionErrors map[string]map[string][]*model.BackendErrorObjectInput
+	projectLogs          map[string][]*clickhouse.LogRow
+	traceSpans           map[string][]*clickhouse.TraceRow
+	projectTraceMetrics  map[string]map[string][]*model.MetricInput
+	rejected             rejections
+}
+
+func (o *Handler) exportTraces(ctx context.Context, headers http.Header, req ptraceotlp.ExportRequest) (ptraceotlp.ExportResponse, error) {
+	export := o.extractTraces(ctx, headers, req)
+	rejected := export.rejected
+
+	keyedErrorMessages := make(map[string][]kafkaqueue.RetryableMessage)
+	for projectID, sessionErrors := range export.projectSessionErrors {
+		for sessionID, errors := range sessionErrors {
+			for _, errorObject := range errors {
+				// cannot return error since we already perform this check for all project errors in `extractFields`
+				projectIDInt, _ := model2.FromVerboseID(projectID)
+				if !o.resolver.IsErrorIngested(ctx, projectIDInt, errorObject) {
+					continue
+				}
+				// session-less errors will have sessionID = "", which will
+				// generate a random key for the kafka message
+				keyedErrorMessages[sessionID] = append(keyedErrorMessages[sessionID], &kafkaqueue.Message{
+					Type: kafkaqueue.PushBackendPayload,
+					PushBackendPayload: &kafkaqueue.PushBackendPayloadArgs{
+						ProjectVerboseID: pointy.String(projectID),
+						SessionSecureID:  pointy.String(sessionID),
+						Errors:           []*model.BackendErrorObjectInput{errorObject},
+					}})
+			}
+		}
+	}
+	for key, messages := range keyedErrorMessages {
+		err := o.resolver.ProducerQueue.Submit(ctx, key, messages...)
+		if err != nil {
+			return ptraceotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel errors to public worker queue")
+		}
 	}
 
-	req := ptraceotlp.NewExportRequest()
-	err = req.UnmarshalProto(output)
+	if err := o.submitProjectMetrics(ctx, export.projectTraceMetrics); err != nil {
+		return ptraceotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel project metrics")
+	}
+
+	rejectedSpans, err := o.submitTraceSpans(ctx, export.traceSpans)
 	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid trace protobuf")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return ptraceotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel project spans")
 	}
+	rejected.merge(rejectedSpans)
 
+	// logs of span events are not reported since the partial success only counts spans
+	if _, err := o.submitProjectLogs(ctx, export.projectLogs); err != nil {
+		return ptraceotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel project logs")
+	}
+
+	resp := ptraceotlp.NewExportResponse()
+	if rejected.total() > 0 {
+		resp.PartialSuccess().SetRejectedSpans(rejected.total())
+		resp.PartialSuccess().SetErrorMessage(rejected.message())
+	}
+	return resp, nil
+}
+
+// extractTraces builds the spans of an export request, along with the logs, errors and metrics of their events.
+func (o *Handler) extractTraces(ctx context.Context, headers http.Header, req ptraceotlp.ExportRequest) *tracesExport {
 	var projectSessionErrors = make(map[string]map[string][]*model.BackendErrorObjectInput)
 	var projectLogs = make(map[string][]*clickhouse.LogRow)
 
 	var traceSpans = make(map[string][]*clickhouse.TraceRow)
 	var projectTraceMetrics = make(map[string]map[string][]*model.MetricInput)
 
+	rejected := rejections{}
 	curTime := time.Now()
 
 	spans := req.Traces().ResourceSpans()
@@ -189,14 +244,15 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 					}
 				}
 
-				fields, err := extractFields(r.Context(), extractFieldsParams{
-					headers:  r.Header,
+				fields, err := extractFields(ctx, extractFieldsParams{
+					headers:  headers,
 					resource: &resource,
 					span:     &span,
 					curTime:  curTime,
 				})
 				if err != nil {
 					lg(ctx, fields).WithError(err).Info("failed to extract fields from span")
+					rejected.add(RejectedInvalidProject, 1)
 					continue
 				}
 				traceID := cast(fields.requestID, span.TraceID().String())
@@ -208,8 +264,8 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					event := events.At(l)
-					fields, err := extractFields(r.Context(), extractFieldsParams{
-						headers:  r.Header,
+					fields, err := extractFields(ctx, extractFieldsParams{
+						headers:  headers,
 						resource: &resource,
 						span:     &span,
 						event:    &event,
@@ -246,6 +302,7 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 							clickhouse.WithSeverityText("ERROR"),
 							clickhouse.WithSource(fields.source),
 							clickhouse.WithEnvironment(fields.environment),
+							clickhouse.WithResourceFields(fields.resource),
 						)
 
 						projectLogs[fields.projectID] = append(projectLogs[fields.projectID], logRow)
@@ -278,9 +335,23 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 							clickhouse.WithSeverityText(fields.logSeverity),
 							clickhouse.WithSource(fields.source),
 							clickhouse.WithEnvironment(fields.environment),
+							clickhouse.WithResourceFields(fields.resource),
 						)
 
 						projectLogs[fields.projectID] = append(projectLogs[fields.projectID], logRow)
+
+						// stack traces reassembled from multiline logs are also reported as errors
+						if _, ok := fields.attrs[multiline.LinesAttribute]; ok && fields.exceptionType != "" {
+							_, backendError := getBackendError(ctx, fields.timestamp, fields, traceID, spanID, pointy.String(logRow.Cursor()))
+							if backendError != nil {
+								spanHasErrors = true
+
+								if _, ok := projectSessionErrors[fields.projectID]; !ok {
+									projectSessionErrors[fields.projectID] = make(map[string][]*model.BackendErrorObjectInput)
+								}
+								projectSessionErrors[fields.projectID][fields.sessionID] = append(projectSessionErrors[fields.projectID][fields.sessionID], backendError)
+							}
+						}
 					} else if event.Name() == highlight.MetricEvent {
 						metric, err := getMetric(ctx, fields.timestamp, fields, spanID, span.ParentSpanID().String(), traceID)
 						if err != nil {
@@ -318,6 +389,7 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 					WithServiceName(fields.serviceName).
 					WithServiceVersion(fields.serviceVersion).
 					WithEnvironment(fields.environment).
+					WithResourceFields(fields.resource).
 					WithHasErrors(spanHasErrors).
 					WithStatusCode(span.Status().Code().String()).
 					WithStatusMessage(span.Status().Message()).
@@ -329,105 +401,56 @@ func (o *Handler) HandleTrace(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	keyedErrorMessages := make(map[string][]kafkaqueue.RetryableMessage)
-	for projectID, sessionErrors := range projectSessionErrors {
-		for sessionID, errors := range sessionErrors {
-			for _, errorObject := range errors {
-				// cannot return error since we already perform this check for all project errors in `extractFields`
-				projectIDInt, _ := model2.FromVerboseID(projectID)
-				if !o.resolver.IsErrorIngested(ctx, projectIDInt, errorObject) {
-					continue
-				}
-				// session-less errors will have sessionID = "", which will
-				// generate a random key for the kafka message
-				keyedErrorMessages[sessionID] = append(keyedErrorMessages[sessionID], &kafkaqueue.Message{
-					Type: kafkaqueue.PushBackendPayload,
-					PushBackendPayload: &kafkaqueue.PushBackendPayloadArgs{
-						ProjectVerboseID: pointy.String(projectID),
-						SessionSecureID:  pointy.String(sessionID),
-						Errors:           []*model.BackendErrorObjectInput{errorObject},
-					}})
-			}
-		}
-	}
-	for key, messages := range keyedErrorMessages {
-		err = o.resolver.ProducerQueue.Submit(ctx, key, messages...)
-		if err != nil {
-			log.WithContext(ctx).WithError(err).Error("failed to submit otel errors to public worker queue")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			return
-		}
-	}
-
-	for projectID, traceMetrics := range projectTraceMetrics {
-		for sessionID, metrics := range traceMetrics {
-			var messages []kafkaqueue.RetryableMessage
-			for _, metric := range metrics {
-				messages = append(messages, &kafkaqueue.Message{
-					Type: kafkaqueue.PushMetrics,
-					PushMetrics: &kafkaqueue.PushMetricsArgs{
-						ProjectVerboseID: &projectID,
-						SessionSecureID:  &sessionID,
-						Metrics:          []*model.MetricInput{metric},
-					}})
-			}
-			err = o.resolver.ProducerQueue.Submit(ctx, sessionID, messages...)
-			if err != nil {
-				log.WithContext(ctx).WithError(err).Error("failed to submit otel project metrics to public worker queue")
-				w.WriteHeader(http.StatusServiceUnavailable)
-				return
-			}
-		}
+	return &tracesExport{
+		projectSessionErrors: projectSessionErrors,
+		projectLogs:          projectLogs,
+		traceSpans:           traceSpans,
+		projectTraceMetrics:  projectTraceMetrics,
+		rejected:             rejected,
 	}
+}
 
-	if err := o.submitTraceSpans(ctx, traceSpans); err != nil {
-		log.WithContext(ctx).WithError(err).Error("failed to submit otel project spans")
-		w.WriteHeader(http.StatusServiceUnavailable)
+func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	req := plogotlp.NewExportRequest()
+	if err := readRequest(r, req); err != nil {
+		log.WithContext(ctx).WithError(err).Error("invalid log export request")
+		writeRequestError(w, err)
 		return
 	}
 
-	if err := o.submitProjectLogs(ctx, projectLogs); err != nil {
-		log.WithContext(ctx).WithError(err).Error("failed to submit otel project logs")
+	resp, err := o.exportLogs(ctx, r.Header, req)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to export otel logs")
 		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
+	writeResponse(w, r, resp)
 }
 
-func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid log logBody")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
+func (o *Handler) exportLogs(ctx context.Context, headers http.Header, req plogotlp.ExportRequest) (plogotlp.ExportResponse, error) {
+	projectLogs, rejected := o.extractLogs(ctx, headers, req)
 
-	gz, err := gzip.NewReader(bytes.NewReader(body))
+	rejectedLogs, err := o.submitProjectLogs(ctx, projectLogs)
 	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip format for log")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return plogotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel project logs")
 	}
+	rejected.merge(rejectedLogs)
 
-	output, err := io.ReadAll(gz)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip stream for log")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	req := plogotlp.NewExportRequest()
-	err = req.UnmarshalProto(output)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid log protobuf")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+	resp := plogotlp.NewExportResponse()
+	if rejected.total() > 0 {
+		resp.PartialSuccess().SetRejectedLogRecords(rejected.total())
+		resp.PartialSuccess().SetErrorMessage(rejected.message())
 	}
+	return resp, nil
+}
 
+// extractLogs builds the log rows of an export request by project.
+func (o *Handler) extractLogs(ctx context.Context, headers http.Header, req plogotlp.ExportRequest) (map[string][]*clickhouse.LogRow, rejections) {
 	var projectLogs = make(map[string][]*clickhouse.LogRow)
 
+	rejected := rejections{}
 	var curTime = time.Now()
 
 	resourceLogs := req.Logs().ResourceLogs()
@@ -440,8 +463,8 @@ func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
 			for k := 0; k < logRecords.Len(); k++ {
 				logRecord := logRecords.At(k)
 
-				fields, err := extractFields(r.Context(), extractFieldsParams{
-					headers:                r.Header,
+				fields, err := extractFields(ctx, extractFieldsParams{
+					headers:                headers,
 					resource:               &resource,
 					logRecord:              &logRecord,
 					curTime:                curTime,
@@ -449,6 +472,7 @@ func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
 				})
 				if err != nil {
 					lg(ctx, fields).WithError(err).WithField("body", logRecord.Body().AsRaw()).Info("failed to extract fields from log")
+					rejected.add(RejectedInvalidProject, 1)
 					continue
 				}
 
@@ -464,6 +488,7 @@ func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
 					clickhouse.WithSeverityText(fields.logSeverity),
 					clickhouse.WithSource(fields.source),
 					clickhouse.WithEnvironment(fields.environment),
+					clickhouse.WithResourceFields(fields.resource),
 				)
 
 				if fields.projectID != "" {
@@ -473,58 +498,104 @@ func (o *Handler) HandleLog(w http.ResponseWriter, r *http.Request) {
 					projectLogs[fields.projectID] = append(projectLogs[fields.projectID], logRow)
 				} else {
 					lg(ctx, fields).Errorf("otel log got no project")
+					rejected.add(RejectedInvalidProject, 1)
 					continue
 				}
 			}
 		}
 	}
-
-	if err := o.submitProjectLogs(ctx, projectLogs); err != nil {
-		log.WithContext(ctx).WithError(err).Error("failed to submit otel project logs")
-		w.WriteHeader(http.StatusServiceUnavailable)
-		return
-	}
-
-	w.WriteHeader(http.StatusOK)
+	return projectLogs, rejected
 }
 
 func (o *Handler) HandleMetric(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	body, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid metric body")
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	req := pmetricotlp.NewExportRequest()
+	if err := readRequest(r, req); err != nil {
+		log.WithContext(ctx).WithError(err).Error("invalid metric export request")
+		writeRequestError(w, err)
 		return
 	}
 
-	gz, err := gzip.NewReader(bytes.NewReader(body))
+	resp, err := o.exportMetrics(ctx, r.Header, req)
 	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip format for metric")
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.WithContext(ctx).WithError(err).Error("failed to export otel metrics")
+		w.WriteHeader(http.StatusServiceUnavailable)
 		return
 	}
 
-	output, err := io.ReadAll(gz)
-	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid gzip stream for metric")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+	writeResponse(w, r, resp)
+}
+
+func (o *Handler) exportMetrics(ctx context.Context, headers http.Header, req pmetricotlp.ExportRequest) (pmetricotlp.ExportResponse, error) {
+	var metricRows []*clickhouse.MetricRow
+	// a data point may be stored as multiple rows, but rejections are reported per data point
+	var rowDataPoints = make(map[*clickhouse.MetricRow]metricDataPoint)
+	var rejectedDataPoints = make(map[metricDataPoint]string)
+
+	var curTime = time.Now()
+
+	resourceMetrics := req.Metrics().ResourceMetrics()
+	for i := 0; i < resourceMetrics.Len(); i++ {
+		resource := resourceMetrics.At(i).Resource()
+		scopeMetrics := resourceMetrics.At(i).ScopeMetrics()
+		for j := 0; j < scopeMetrics.Len(); j++ {
+			metrics := scopeMetrics.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				for _, value := range getMetricValues(metrics.At(k)) {
+					fields, err := extractFields(ctx, extractFieldsParams{
+						headers:   headers,
+						resource:  &resource,
+						dataPoint: value.dataPoint,
+						curTime:   curTime,
+					})
+					if err != nil {
+						lg(ctx, fields).WithError(err).Info("failed to extract fields from metric")
+						rejectedDataPoints[value.dataPoint] = RejectedInvalidProject
+						continue
+					}
+
+					if fields.projectID == "" {
+						lg(ctx, fields).Errorf("otel metric got no project")
+						rejectedDataPoints[value.dataPoint] = RejectedInvalidProject
+						continue
+					}
+
+					for key, val := range value.attrs {
+						fields.attrs[key] = val
+					}
+
+					metricRow := value.toRow(fields.timestamp, fields.projectIDInt).
+						WithServiceName(fields.serviceName).
+						WithServiceVersion(fields.serviceVersion).
+						WithEnvironment(fields.environment).
+						WithSecureSessionId(fields.sessionID).
+						WithAttributes(fields.attrs)
+					metricRows = append(metricRows, metricRow)
+					rowDataPoints[metricRow] = value.dataPoint
+				}
+			}
+		}
 	}
 
-	req := pmetricotlp.NewExportRequest()
-	err = req.UnmarshalProto(output)
+	rejectedRows, err := o.SubmitMetricRows(ctx, metricRows)
 	if err != nil {
-		log.WithContext(ctx).WithError(err).Error("invalid metric protobuf")
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return pmetricotlp.NewExportResponse(), e.Wrap(err, "failed to submit otel project metrics")
+	}
+	for metricRow, reason := range rejectedRows {
+		rejectedDataPoints[rowDataPoints[metricRow]] = reason
 	}
 
-	log.WithContext(ctx).
-		WithField("count", req.Metrics().MetricCount()).
-		WithField("dp_count", req.Metrics().DataPointCount()).
-		Info("received otel metrics")
+	rejected := rejections{}
+	for _, reason := range rejectedDataPoints {
+		rejected.add(reason, 1)
+	}
 
-	w.WriteHeader(http.StatusOK)
+	resp := pmetricotlp.NewExportResponse()
+	if rejected.total() > 0 {
+		resp.PartialSuccess().SetRejectedDataPoints(rejected.total())
+		resp.PartialSuccess().SetErrorMessage(rejected.message())
+	}
+	return resp, nil
 }
 
 func (o *Handler) getQuotaExceededByProject(ctx context.Context, projectIds map[uint32]struct{}, productType model2.PricingProductType) (map[uint32]bool, error) {
@@ -581,7 +652,46 @@ func (o *Handler) getQuotaExceededByProject(ctx context.Context, projectIds map[
 	return quotaExceededByProject, nil
 }
 
-func (o *Handler) submitProjectLogs(ctx context.Context, projectLogs map[string][]*clickhouse.LogRow) error {
+// processProjectLogs runs the log pipeline of each project, moving logs routed to another project
+// under the key of that project. Logs are then redacted with the rules of the project they are written to.
+func (o *Handler) processProjectLogs(ctx context.Context, projectLogs map[string][]*clickhouse.LogRow) (map[string][]*clickhouse.LogRow, rejections) {
+	rejected := rejections{}
+	processed := make(map[string][]*clickhouse.LogRow)
+	pipelines := map[uint32]*pipeline.Pipeline{}
+	redactors := map[uint32]*redact.Redactor{}
+	for key, logRows := range projectLogs {
+		for _, logRow := range logRows {
+			projectID := logRow.ProjectId
+			p, ok := pipelines[projectID]
+			if !ok {
+				p = o.resolver.GetLogPipeline(ctx, int(projectID))
+				pipelines[projectID] = p
+			}
+			if p != nil && !p.Process(ctx, logRow) {
+				rejected.add(RejectedLogPipeline, 1)
+				continue
+			}
+
+			redactor, ok := redactors[logRow.ProjectId]
+			if !ok {
+				redactor = o.resolver.GetRedactor(ctx, int(logRow.ProjectId))
+				redactors[logRow.ProjectId] = redactor
+			}
+			redactor.LogRow(logRow)
+
+			if logRow.ProjectId != projectID {
+				routedKey := strconv.Itoa(int(logRow.ProjectId))
+				processed[routedKey] = append(processed[routedKey], logRow)
+			} else {
+				processed[key] = append(processed[key], logRow)
+			}
+		}
+	}
+	return processed, rejected
+}
+
+func (o *Handler) submitProjectLogs(ctx context.Context, projectLogs map[string][]*clickhouse.LogRow) (rejections, error) {
+	projectLogs, rejected := o.processProjectLogs(ctx, projectLogs)
 	projectIds := map[uint32]struct{}{}
 	for _, logRows := range projectLogs {
 		for _, logRow := range logRows {
@@ -617,6 +727,8 @@ func (o *Handler) submitProjectLogs(ctx context.Context, projectLogs map[string]
 
 			// Filter out any log rows for projects where the log quota has been exceeded
 			if quotaExceededByProject[logRow.ProjectId] {
+				rejected.add(RejectedQuotaExceeded, 1)
+				o.resolver.RecordIngestDrop(ctx, int(logRow.ProjectId), privateModel.ProductTypeLogs, privateModel.IngestReasonQuota, logRow.ServiceName, 1)
 				continue
 			}
 
@@ -634,6 +746,7 @@ func (o *Handler) submitProjectLogs(ctx context.Context, projectLogs map[string]
 	var messages []kafkaqueue.RetryableMessage
 	for _, logRow := range filteredRows {
 		if !o.resolver.IsLogIngested(ctx, logRow) {
+			rejected.add(RejectedIngestFilter, 1)
 			continue
 		}
 		messages = append(messages, &kafkaqueue.LogRowMessage{
@@ -643,16 +756,25 @@ func (o *Handler) submitProjectLogs(ctx context.Context, projectLogs map[string]
 	}
 	err = o.resolver.BatchedQueue.Submit(ctx, "", messages...)
 	if err != nil {
-		return e.Wrap(err, "failed to submit otel project logs to public worker queue")
+		return nil, e.Wrap(err, "failed to submit otel project logs to public worker queue")
 	}
-	return nil
+	return rejected, nil
 }
 
-func (o *Handler) submitTraceSpans(ctx context.Context, traceRows map[string][]*clickhouse.TraceRow) error {
+func (o *Handler) submitTraceSpans(ctx context.Context, traceRows map[string][]*clickhouse.TraceRow) (rejections, error) {
+	rejected := rejections{}
 	markBackendSetupProjectIds := map[uint32]struct{}{}
 	projectIds := map[uint32]struct{}{}
+	redactors := map[uint32]*redact.Redactor{}
 	for _, traceRows := range traceRows {
 		for _, traceRow := range traceRows {
+			redactor, ok := redactors[traceRow.ProjectId]
+			if !ok {
+				redactor = o.resolver.GetRedactor(ctx, int(traceRow.ProjectId))
+				redactors[traceRow.ProjectId] = redactor
+			}
+			redactor.TraceRow(traceRow)
+
 			// Don't mark backend setup for frontend or internal traces
 			if value := traceRow.TraceAttributes["highlight.type"]; value != "http.request" && value != "highlight.internal" {
 				markBackendSetupProjectIds[traceRow.ProjectId] = struct{}{}
@@ -669,11 +791,25 @@ func (o *Handler) submitTraceSpans(ctx context.Context, traceRows map[string][]*
 
 	for traceID, traceRows := range traceRows {
 		var messages []kafkaqueue.RetryableMessage
+		buffered := map[uint32][]*clickhouse.TraceRow{}
 		for _, traceRow := range traceRows {
 			if quotaExceededByProject[traceRow.ProjectId] {
+				rejected.add(RejectedQuotaExceeded, 1)
+				o.resolver.RecordIngestDrop(ctx, int(traceRow.ProjectId), privateModel.ProductTypeTraces, privateModel.IngestReasonQuota, traceRow.ServiceName, 1)
+				continue
+			}
+			// tail sampled spans are sampled and rate limited once their trace is decided
+			if o.resolver.IsTraceTailSampled(ctx, int(traceRow.ProjectId)) {
+				if !o.resolver.IsTraceIngestedByFilter(ctx, traceRow) {
+					rejected.add(RejectedIngestFilter, 1)
+					o.resolver.RecordIngestDrop(ctx, int(traceRow.ProjectId), privateModel.ProductTypeTraces, privateModel.IngestReasonFilter, traceRow.ServiceName, 1)
+					continue
+				}
+				buffered[traceRow.ProjectId] = append(buffered[traceRow.ProjectId], traceRow)
 				continue
 			}
 			if !o.resolver.IsTraceIngested(ctx, traceRow) {
+				rejected.add(RejectedIngestFilter, 1)
 				continue
 			}
 			messages = append(messages, &kafkaqueue.TraceRowMessage{
@@ -682,9 +818,17 @@ func (o *Handler) submitTraceSpans(ctx context.Context, traceRows map[string][]*
 			})
 		}
 
+		for projectID, projectRows := range buffered {
+			dropped, err := o.resolver.BufferTraceForTailSampling(ctx, int(projectID), traceID, projectRows)
+			if err != nil {
+				return nil, e.Wrap(err, "failed to buffer otel project traces for tail sampling")
+			}
+			rejected.add(RejectedIngestFilter, int64(dropped))
+		}
+
 		err := o.resolver.TracesQueue.Submit(ctx, traceID, messages...)
 		if err != nil {
-			return e.Wrap(err, "failed to submit otel project traces to public worker queue")
+			return nil, e.Wrap(err, "failed to submit otel project traces to public worker queue")
 		}
 	}
 
@@ -695,9 +839,82 @@ func (o *Handler) submitTraceSpans(ctx context.Context, traceRows map[string][]*
 		}
 	}
 
+	return rejected, nil
+}
+
+func (o *Handler) submitProjectMetrics(ctx context.Context, projectMetrics map[string]map[string][]*model.MetricInput) error {
+	for projectID, sessionMetrics := range projectMetrics {
+		for sessionID, metrics := range sessionMetrics {
+			var messages []kafkaqueue.RetryableMessage
+			for _, metric := range metrics {
+				messages = append(messages, &kafkaqueue.Message{
+					Type: kafkaqueue.PushMetrics,
+					PushMetrics: &kafkaqueue.PushMetricsArgs{
+						ProjectVerboseID: pointy.String(projectID),
+						SessionSecureID:  pointy.String(sessionID),
+						Metrics:          []*model.MetricInput{metric},
+					}})
+			}
+			err := o.resolver.ProducerQueue.Submit(ctx, sessionID, messages...)
+			if err != nil {
+				return e.Wrap(err, "failed to submit otel project metrics to public worker queue")
+			}
+		}
+	}
 	return nil
 }
 
+// SubmitMetricRows writes the metric rows to the batched queue, returning the rows
+// rejected because their project is over its billing quota along with the reason.
+func (o *Handler) SubmitMetricRows(ctx context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error) {
+	rejected := map[*clickhouse.MetricRow]string{}
+	projectIds := map[uint32]struct{}{}
+	for _, metricRow := range metricRows {
+		projectIds[metricRow.ProjectId] = struct{}{}
+	}
+
+	// metrics are billed as traces
+	quotaExceededByProject, err := o.getQuotaExceededByProject(ctx, projectIds, model2.PricingProductTypeTraces)
+	if err != nil {
+		log.WithContext(ctx).Error(err)
+		quotaExceededByProject = map[uint32]bool{}
+	}
+
+	var messages []kafkaqueue.RetryableMessage
+	for _, metricRow := range metricRows {
+		if quotaExceededByProject[metricRow.ProjectId] {
+			rejected[metricRow] = RejectedQuotaExceeded
+			o.resolver.RecordIngestDrop(ctx, int(metricRow.ProjectId), privateModel.ProductTypeMetrics, privateModel.IngestReasonQuota, metricRow.ServiceName, 1)
+			continue
+		}
+		messages = append(messages, &kafkaqueue.MetricRowMessage{
+			Type:      kafkaqueue.PushMetricsFlattened,
+			MetricRow: metricRow,
+		})
+	}
+	err = o.resolver.BatchedQueue.Submit(ctx, "", messages...)
+	if err != nil {
+		return nil, e.Wrap(err, "failed to submit otel project metrics to public worker queue")
+	}
+	return rejected, nil
+}
+
+// SubmitTraceRows writes trace rows converted from other tracing formats to the traces queue,
+// applying the same quota and ingest filters as otel spans. Returns the number of rejected rows.
+func (o *Handler) SubmitTraceRows(ctx context.Context, traceRows []*clickhouse.TraceRow) (int64, error) {
+	curTime := time.Now()
+	traceSpans := map[string][]*clickhouse.TraceRow{}
+	for _, traceRow := range traceRows {
+		traceRow.Timestamp = graph.ClampTime(traceRow.Timestamp, curTime)
+		traceSpans[traceRow.TraceId] = append(traceSpans[traceRow.TraceId], traceRow)
+	}
+	rejected, err := o.submitTraceSpans(ctx, traceSpans)
+	if err != nil {
+		return 0, err
+	}
+	return rejected.total(), nil
+}
+
 func (o *Handler) matchHerokuDrain(ctx context.Context, herokuDrainToken string) (string, int) {
 	data, err := redis.CachedEval(ctx, o.resolver.Redis, fmt.Sprintf("matchHerokuDrain-%s", herokuDrainToken), time.Minute, time.Second, func() (*int, error) {
 		projectMapping := &model2.IntegrationProjectMapping{
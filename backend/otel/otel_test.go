@@ -9,7 +9,10 @@ import (
 	"github.com/highlight/highlight/sdk/highlight-go"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"gorm.io/gorm"
+	"math"
+	"net"
 	"net/http"
 	"os"
 	"testing"
@@ -32,6 +35,9 @@ import (
 	public "github.com/highlight-run/highlight/backend/public-graph/graph"
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 )
 
 type MockKafkaProducer struct {
@@ -318,3 +324,241 @@ func TestExtractFields_Syslog(t *testing.T) {
 		"proc_id":  "1",
 	}, fields.attrs)
 }
+
+func TestHandler_HandleMetric(t *testing.T) {
+	inputBytes, err := os.ReadFile("./samples/metrics.json")
+	if err != nil {
+		t.Fatalf("error reading: %v", err)
+	}
+
+	req := pmetricotlp.NewExportRequest()
+	if err := req.UnmarshalJSON(inputBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := req.MarshalProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := bytes.Buffer{}
+	gz := gzip.NewWriter(&b)
+	if _, err := gz.Write(body); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	w := &MockResponseWriter{}
+	r, _ := http.NewRequest("POST", "", bytes.NewReader(b.Bytes()))
+
+	producer := MockKafkaProducer{}
+	resolver := &public.Resolver{
+		Redis:         red,
+		Store:         store.NewStore(db, red, integrations.NewIntegrationsClient(db), &storage.FilesystemClient{}, &producer, nil),
+		ProducerQueue: &producer,
+		BatchedQueue:  &producer,
+		TracesQueue:   &producer,
+		DB:            db,
+		Clickhouse:    chClient,
+	}
+	h := Handler{
+		resolver: resolver,
+	}
+	h.HandleMetric(w, r)
+
+	metricValues := map[string][]float64{}
+	metricRows := map[string]*clickhouse.MetricRow{}
+	for _, message := range producer.messages {
+		assert.Equal(t, kafkaqueue.PushMetricsFlattened, message.GetType())
+		metricRow := message.(*kafka_queue.MetricRowMessage).MetricRow
+		assert.Equal(t, uint32(1), metricRow.ProjectId)
+		assert.Equal(t, "otelcol-contrib", metricRow.ServiceName)
+		metricValues[metricRow.MetricName] = append(metricValues[metricRow.MetricName], metricRow.Value)
+		metricRows[metricRow.MetricName] = metricRow
+	}
+
+	assert.Equal(t, map[string][]float64{
+		"system.memory.usage":        {4294967296},
+		"system.cpu.time":            {1234.5},
+		"http.server.duration.count": {4},
+		"http.server.duration.sum":   {100},
+		"http.server.duration":       {25},
+		"rpc.server.duration.count":  {3},
+		"rpc.server.duration.sum":    {30},
+		"rpc.server.duration":        {10},
+		"go.gc.pause.count":          {10},
+		"go.gc.pause.sum":            {0.5},
+		"go.gc.pause":                {0.04, 0.1},
+	}, metricValues)
+
+	assert.Equal(t, clickhouse.MetricTypeGauge, metricRows["system.memory.usage"].MetricType)
+	assert.Equal(t, "used", metricRows["system.memory.usage"].Attributes["state"])
+
+	assert.Equal(t, clickhouse.MetricTypeSum, metricRows["system.cpu.time"].MetricType)
+	assert.Equal(t, clickhouse.MetricTemporalityCumulative, metricRows["system.cpu.time"].Temporality)
+	assert.True(t, metricRows["system.cpu.time"].IsMonotonic)
+
+	assert.Equal(t, clickhouse.MetricTypeHistogram, metricRows["http.server.duration"].MetricType)
+	assert.Equal(t, uint64(4), metricRows["http.server.duration"].Count)
+	assert.Equal(t, []uint64{1, 2, 1}, metricRows["http.server.duration"].BucketCounts)
+	assert.Equal(t, []float64{10, 50}, metricRows["http.server.duration"].ExplicitBounds)
+	assert.Equal(t, clickhouse.MetricTypeSum, metricRows["http.server.duration.count"].MetricType)
+	assert.True(t, metricRows["http.server.duration.count"].IsMonotonic)
+
+	assert.Equal(t, clickhouse.MetricTypeExponentialHistogram, metricRows["rpc.server.duration"].MetricType)
+	assert.Equal(t, clickhouse.MetricTemporalityDelta, metricRows["rpc.server.duration"].Temporality)
+	assert.Equal(t, []uint64{1, 2, 0}, metricRows["rpc.server.duration"].BucketCounts)
+	assert.InDeltaSlice(t, []float64{2, 2 * math.Sqrt2}, metricRows["rpc.server.duration"].ExplicitBounds, 1e-9)
+
+	assert.Equal(t, clickhouse.MetricTypeSummary, metricRows["go.gc.pause"].MetricType)
+	assert.Equal(t, "0.99", metricRows["go.gc.pause"].Attributes[MetricQuantileAttribute])
+}
+
+func TestHandler_GRPCExportMetrics(t *testing.T) {
+	ctx := context.Background()
+	inputBytes, err := os.ReadFile("./samples/metrics.json")
+	if err != nil {
+		t.Fatalf("error reading: %v", err)
+	}
+
+	req := pmetricotlp.NewExportRequest()
+	if err := req.UnmarshalJSON(inputBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	producer := MockKafkaProducer{}
+	resolver := &public.Resolver{
+		Redis:         red,
+		Store:         store.NewStore(db, red, integrations.NewIntegrationsClient(db), &storage.FilesystemClient{}, &producer, nil),
+		ProducerQueue: &producer,
+		BatchedQueue:  &producer,
+		TracesQueue:   &producer,
+		DB:            db,
+		Clickhouse:    chClient,
+	}
+	h := Handler{
+		resolver: resolver,
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := h.NewGRPCServer()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = pmetricotlp.NewGRPCClient(conn).Export(ctx, req)
+	assert.NoError(t, err)
+
+	assert.Len(t, producer.messages, 12)
+	for _, message := range producer.messages {
+		assert.Equal(t, kafkaqueue.PushMetricsFlattened, message.GetType())
+	}
+}
+
+func TestHandler_ExportLogsPartialSuccess(t *testing.T) {
+	ctx := context.Background()
+	req := plogotlp.NewExportRequest()
+	logRecords := req.Logs().ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+
+	withProject := logRecords.AppendEmpty()
+	withProject.Body().SetStr("hello")
+	withProject.Attributes().PutStr(highlight.ProjectIDAttribute, "1")
+
+	withoutProject := logRecords.AppendEmpty()
+	withoutProject.Body().SetStr("world")
+
+	producer := MockKafkaProducer{}
+	resolver := &public.Resolver{
+		Redis:         red,
+		Store:         store.NewStore(db, red, integrations.NewIntegrationsClient(db), &storage.FilesystemClient{}, &producer, nil),
+		ProducerQueue: &producer,
+		BatchedQueue:  &producer,
+		TracesQueue:   &producer,
+		DB:            db,
+		Clickhouse:    chClient,
+	}
+	h := Handler{
+		resolver: resolver,
+	}
+
+	resp, err := h.exportLogs(ctx, http.Header{}, req)
+	assert.NoError(t, err)
+	assert.Len(t, producer.messages, 1)
+	assert.Equal(t, int64(1), resp.PartialSuccess().RejectedLogRecords())
+	assert.Equal(t, "missing or invalid project id: 1", resp.PartialSuccess().ErrorMessage())
+}
+
+func TestHandler_PreviewIngest(t *testing.T) {
+	ctx := context.Background()
+	payload, err := os.ReadFile("./samples/log.json")
+	if err != nil {
+		t.Fatalf("error reading: %v", err)
+	}
+
+	producer := MockKafkaProducer{}
+	resolver := &public.Resolver{
+		Redis:         red,
+		Store:         store.NewStore(db, red, integrations.NewIntegrationsClient(db), &storage.FilesystemClient{}, &producer, nil),
+		ProducerQueue: &producer,
+		BatchedQueue:  &producer,
+		TracesQueue:   &producer,
+		DB:            db,
+		Clickhouse:    chClient,
+	}
+	h := Handler{
+		resolver: resolver,
+	}
+
+	items, err := h.PreviewIngest(ctx, 1, payload, nil)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.True(t, items[0].Ingested)
+	assert.Equal(t, "my-service", *items[0].Log.ServiceName)
+	assert.Equal(t, "bar", items[0].Log.LogAttributes["foo"])
+
+	items, err = h.PreviewIngest(ctx, 1, payload, &model.ProjectFilterSettings{
+		ProjectID:         1,
+		LogSamplingRate:   1,
+		LogExclusionQuery: pointy.String("service_name:my-service"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.False(t, items[0].Ingested)
+	assert.Equal(t, privateModel.IngestReasonFilter, *items[0].Reason)
+	assert.Equal(t, "service_name:my-service", *items[0].ExclusionQuery)
+	assert.Empty(t, producer.messages)
+
+	// errors of span events are previewed with the spans and logs of the trace
+	payload, err = os.ReadFile("./samples/traces.json")
+	if err != nil {
+		t.Fatalf("error reading: %v", err)
+	}
+	items, err = h.PreviewIngest(ctx, 1, payload, nil)
+	assert.NoError(t, err)
+	var errorItems int
+	for _, item := range items {
+		if item.Product == privateModel.ProductTypeErrors {
+			errorItems++
+			assert.NotEmpty(t, item.Error.Event)
+		}
+	}
+	assert.Equal(t, 4, errorItems)
+	assert.Empty(t, producer.messages)
+
+	_, err = h.PreviewIngest(ctx, 1, []byte(`{"resourceMetrics": []}`), nil)
+	assert.Error(t, err)
+}
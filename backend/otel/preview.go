@@ -0,0 +1,263 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+
+	e "github.com/pkg/errors"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	kafkaqueue "github.com/highlight-run/highlight/backend/kafka-queue"
+	model2 "github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/pipeline"
+	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/public-graph/graph"
+	"github.com/highlight-run/highlight/backend/public-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/redact"
+	"github.com/highlight-run/highlight/backend/redis"
+	"github.com/highlight-run/highlight/backend/store"
+	"github.com/highlight/highlight/sdk/highlight-go"
+)
+
+// NewIngestPreviewer returns a handler to preview ingestion with. Previewed items are processed by a resolver
+// whose queues discard what is submitted to them, so that they are never ingested.
+func NewIngestPreviewer(db *gorm.DB, tracer, tracerNoResources trace.Tracer, redisClient *redis.Client, clickhouseClient *clickhouse.Client, dataStore *store.Store) *Handler {
+	return New(&graph.Resolver{
+		DB:                db,
+		Tracer:            tracer,
+		TracerNoResources: tracerNoResources,
+		ProducerQueue:     &kafkaqueue.MockMessageQueue{},
+		BatchedQueue:      &kafkaqueue.MockMessageQueue{},
+		DataSyncQueue:     &kafkaqueue.MockMessageQueue{},
+		TracesQueue:       &kafkaqueue.MockMessageQueue{},
+		Redis:             redisClient,
+		Clickhouse:        clickhouseClient,
+		Store:             dataStore,
+	})
+}
+
+// PreviewIngest returns how the logs and spans of an OTLP JSON logs or traces export request would be ingested
+// by a project, without ingesting them. Settings, when set, are previewed in place of the saved filter settings.
+func (o *Handler) PreviewIngest(ctx context.Context, projectID int, payload []byte, settings *model2.ProjectFilterSettings) ([]*privateModel.IngestPreviewItem, error) {
+	var request struct {
+		ResourceLogs  json.RawMessage `json:"resourceLogs"`
+		ResourceSpans json.RawMessage `json:"resourceSpans"`
+	}
+	if err := json.Unmarshal(payload, &request); err != nil {
+		return nil, e.Wrap(err, "invalid otel export request")
+	}
+
+	ctx = graph.WithIngestPreview(ctx, settings)
+	// items are previewed for the project regardless of the project id they set
+	headers := http.Header{}
+	headers.Set(highlight.ProjectIDHeader, strconv.Itoa(projectID))
+
+	var projectLogs map[string][]*clickhouse.LogRow
+	var traceSpans map[string][]*clickhouse.TraceRow
+	var projectSessionErrors map[string]map[string][]*model.BackendErrorObjectInput
+	if request.ResourceSpans != nil {
+		req := ptraceotlp.NewExportRequest()
+		if err := req.UnmarshalJSON(payload); err != nil {
+			return nil, e.Wrap(err, "invalid otel traces export request")
+		}
+		export := o.extractTraces(ctx, headers, req)
+		projectLogs, traceSpans, projectSessionErrors = export.projectLogs, export.traceSpans, export.projectSessionErrors
+	} else if request.ResourceLogs != nil {
+		req := plogotlp.NewExportRequest()
+		if err := req.UnmarshalJSON(payload); err != nil {
+			return nil, e.Wrap(err, "invalid otel logs export request")
+		}
+		projectLogs, _ = o.extractLogs(ctx, headers, req)
+	} else {
+		return nil, e.New("payload is not an otel logs or traces export request")
+	}
+
+	items := o.previewLogs(ctx, projectLogs)
+	items = append(items, o.previewSpans(ctx, traceSpans)...)
+	return append(items, o.previewErrors(ctx, projectSessionErrors)...), nil
+}
+
+// previewLogs follows the steps of submitProjectLogs.
+func (o *Handler) previewLogs(ctx context.Context, projectLogs map[string][]*clickhouse.LogRow) []*privateModel.IngestPreviewItem {
+	pipelines := map[uint32]*pipeline.Pipeline{}
+	redactors := map[uint32]*redact.Redactor{}
+	projectIds := map[uint32]struct{}{}
+	var logRows []*clickhouse.LogRow
+	var dropped []bool
+	for _, key := range sortedKeys(projectLogs) {
+		for _, logRow := range projectLogs[key] {
+			projectID := logRow.ProjectId
+			p, ok := pipelines[projectID]
+			if !ok {
+				p = o.resolver.GetLogPipeline(ctx, int(projectID))
+				pipelines[projectID] = p
+			}
+			dropped = append(dropped, p != nil && !p.Process(ctx, logRow))
+
+			redactor, ok := redactors[logRow.ProjectId]
+			if !ok {
+				redactor = o.resolver.GetRedactor(ctx, int(logRow.ProjectId))
+				redactors[logRow.ProjectId] = redactor
+			}
+			redactor.LogRow(logRow)
+
+			projectIds[logRow.ProjectId] = struct{}{}
+			logRows = append(logRows, logRow)
+		}
+	}
+
+	quotaExceededByProject, err := o.getQuotaExceededByProject(ctx, projectIds, model2.PricingProductTypeLogs)
+	if err != nil {
+		log.WithContext(ctx).Error(err)
+		quotaExceededByProject = map[uint32]bool{}
+	}
+
+	var items []*privateModel.IngestPreviewItem
+	for idx, logRow := range logRows {
+		item := &privateModel.IngestPreviewItem{
+			Product:           privateModel.ProductTypeLogs,
+			DroppedByPipeline: dropped[idx],
+			QuotaExceeded:     quotaExceededByProject[logRow.ProjectId],
+		}
+		if !item.DroppedByPipeline {
+			item.Reason = o.resolver.GetLogIngestReason(ctx, logRow)
+			o.setExclusionQuery(ctx, item, int(logRow.ProjectId))
+		}
+		item.Ingested = !item.DroppedByPipeline && !item.QuotaExceeded && item.Reason == nil
+		item.Log = clickhouse.GetLog(logRow)
+		items = append(items, item)
+	}
+	return items
+}
+
+// previewSpans follows the steps of submitTraceSpans. Spans of tail sampled traces are only filtered,
+// since they are sampled once their trace is complete.
+func (o *Handler) previewSpans(ctx context.Context, traceSpans map[string][]*clickhouse.TraceRow) []*privateModel.IngestPreviewItem {
+	redactors := map[uint32]*redact.Redactor{}
+	projectIds := map[uint32]struct{}{}
+	var traceRows []*clickhouse.TraceRow
+	for _, traceID := range sortedKeys(traceSpans) {
+		for _, traceRow := range traceSpans[traceID] {
+			redactor, ok := redactors[traceRow.ProjectId]
+			if !ok {
+				redactor = o.resolver.GetRedactor(ctx, int(traceRow.ProjectId))
+				redactors[traceRow.ProjectId] = redactor
+			}
+			redactor.TraceRow(traceRow)
+
+			projectIds[traceRow.ProjectId] = struct{}{}
+			traceRows = append(traceRows, traceRow)
+		}
+	}
+
+	quotaExceededByProject, err := o.getQuotaExceededByProject(ctx, projectIds, model2.PricingProductTypeTraces)
+	if err != nil {
+		log.WithContext(ctx).Error(err)
+		quotaExceededByProject = map[uint32]bool{}
+	}
+
+	var items []*privateModel.IngestPreviewItem
+	for _, traceRow := range traceRows {
+		item := &privateModel.IngestPreviewItem{
+			Product:       privateModel.ProductTypeTraces,
+			QuotaExceeded: quotaExceededByProject[traceRow.ProjectId],
+			TailSampled:   o.resolver.IsTraceTailSampled(ctx, int(traceRow.ProjectId)),
+		}
+		if !item.TailSampled {
+			item.Reason = o.resolver.GetTraceIngestReason(ctx, traceRow)
+		} else if !o.resolver.IsTraceIngestedByFilter(ctx, traceRow) {
+			item.Reason = lo.ToPtr(privateModel.IngestReasonFilter)
+		}
+		o.setExclusionQuery(ctx, item, int(traceRow.ProjectId))
+		item.Ingested = !item.QuotaExceeded && item.Reason == nil
+		item.Trace = clickhouse.GetTrace(traceRow)
+		items = append(items, item)
+	}
+	return items
+}
+
+// previewErrors follows the steps of exportTraces for the errors of span events.
+func (o *Handler) previewErrors(ctx context.Context, projectSessionErrors map[string]map[string][]*model.BackendErrorObjectInput) []*privateModel.IngestPreviewItem {
+	redactors := map[int]*redact.Redactor{}
+	projectIds := map[uint32]struct{}{}
+	type previewedError struct {
+		projectID   int
+		errorObject *model.BackendErrorObjectInput
+	}
+	var previewed []previewedError
+	for _, projectVerboseID := range sortedKeys(projectSessionErrors) {
+		projectID, err := model2.FromVerboseID(projectVerboseID)
+		if err != nil {
+			continue
+		}
+		sessionErrors := projectSessionErrors[projectVerboseID]
+		for _, sessionID := range sortedKeys(sessionErrors) {
+			for _, errorObject := range sessionErrors[sessionID] {
+				redactor, ok := redactors[projectID]
+				if !ok {
+					redactor = o.resolver.GetRedactor(ctx, projectID)
+					redactors[projectID] = redactor
+				}
+				redactor.BackendError(errorObject)
+
+				projectIds[uint32(projectID)] = struct{}{}
+				previewed = append(previewed, previewedError{projectID: projectID, errorObject: errorObject})
+			}
+		}
+	}
+
+	quotaExceededByProject, err := o.getQuotaExceededByProject(ctx, projectIds, model2.PricingProductTypeErrors)
+	if err != nil {
+		log.WithContext(ctx).Error(err)
+		quotaExceededByProject = map[uint32]bool{}
+	}
+
+	var items []*privateModel.IngestPreviewItem
+	for _, projectError := range previewed {
+		errorObject := projectError.errorObject
+		item := &privateModel.IngestPreviewItem{
+			Product:       privateModel.ProductTypeErrors,
+			QuotaExceeded: quotaExceededByProject[uint32(projectError.projectID)],
+			Reason:        o.resolver.GetErrorIngestReason(ctx, projectError.projectID, errorObject),
+		}
+		o.setExclusionQuery(ctx, item, projectError.projectID)
+		item.Ingested = !item.QuotaExceeded && item.Reason == nil
+		item.Error = &privateModel.IngestPreviewError{
+			Event:       errorObject.Event,
+			Type:        errorObject.Type,
+			Source:      errorObject.Source,
+			StackTrace:  errorObject.StackTrace,
+			Timestamp:   errorObject.Timestamp,
+			Environment: errorObject.Environment,
+			TraceID:     errorObject.TraceID,
+			SpanID:      errorObject.SpanID,
+		}
+		if errorObject.Service != nil {
+			item.Error.ServiceName = errorObject.Service.Name
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// setExclusionQuery sets the exclusion query that filtered out an item.
+func (o *Handler) setExclusionQuery(ctx context.Context, item *privateModel.IngestPreviewItem, projectID int) {
+	if item.Reason != nil && *item.Reason == privateModel.IngestReasonFilter {
+		item.ExclusionQuery = lo.ToPtr(o.resolver.GetExclusionQuery(ctx, item.Product, projectID))
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,51 @@
+package otel
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	RejectedInvalidProject = "missing or invalid project id"
+	RejectedQuotaExceeded  = "billing quota exceeded"
+	RejectedIngestFilter   = "dropped by project ingest filters"
+	RejectedLogPipeline    = "dropped by project log pipeline"
+)
+
+// rejections counts the items of an export request that were not ingested by reason,
+// reported to the exporter as an OTLP partial success.
+type rejections map[string]int64
+
+func (r rejections) add(reason string, count int64) {
+	if count > 0 {
+		r[reason] += count
+	}
+}
+
+func (r rejections) merge(other rejections) {
+	for reason, count := range other {
+		r.add(reason, count)
+	}
+}
+
+func (r rejections) total() (total int64) {
+	for _, count := range r {
+		total += count
+	}
+	return
+}
+
+func (r rejections) message() string {
+	reasons := make([]string, 0, len(r))
+	for reason := range r {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+
+	var parts []string
+	for _, reason := range reasons {
+		parts = append(parts, fmt.Sprintf("%s: %d", reason, r[reason]))
+	}
+	return strings.Join(parts, ", ")
+}
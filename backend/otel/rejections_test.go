@@ -0,0 +1,19 @@
+package otel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_rejections(t *testing.T) {
+	rejected := rejections{}
+	assert.Equal(t, int64(0), rejected.total())
+
+	rejected.add(RejectedQuotaExceeded, 2)
+	rejected.add(RejectedIngestFilter, 0)
+	rejected.merge(rejections{RejectedInvalidProject: 1, RejectedQuotaExceeded: 1})
+
+	assert.Equal(t, int64(4), rejected.total())
+	assert.Equal(t, "billing quota exceeded: 3, missing or invalid project id: 1", rejected.message())
+}
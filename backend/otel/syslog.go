@@ -2,39 +2,28 @@ package otel
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/influxdata/go-syslog/v3"
+	"github.com/influxdata/go-syslog/v3/rfc3164"
 	"github.com/influxdata/go-syslog/v3/rfc5424"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
-func extractSyslog(fields *extractedFields) {
+// structured data element carrying the highlight project of a syslog message, ie.
+// <165>1 2003-10-11T22:14:15.003Z mymachine.example.com app - - [highlight@53479 project="1jdkoe52"] message
+const (
+	syslogProjectSDID  = "highlight"
+	syslogProjectParam = "project"
+)
+
+// extractSyslog parses an rfc5424 syslog message. Messages received by a syslog listener that are not rfc5424
+// are parsed as rfc3164, which is only done for the listener since any text parses as a best effort rfc3164 message.
+func extractSyslog(fields *extractedFields, rfc3164Fallback bool) {
 	p := rfc5424.NewParser(rfc5424.WithBestEffort())
 	message, err := p.Parse([]byte(fields.logBody))
 	if msg, ok := message.(*rfc5424.SyslogMessage); err == nil && ok {
-		if msg.Message != nil {
-			fields.logBody = *msg.Message
-		}
-		if msg.Facility != nil {
-			fields.attrs["facility"] = strconv.Itoa(int(*msg.Facility))
-		}
-		if msg.Severity != nil {
-			fields.logSeverity = plog.SeverityNumber(*msg.Severity).String()
-		}
-		if msg.Priority != nil {
-			fields.attrs["priority"] = strconv.Itoa(int(*msg.Priority))
-		}
-		if msg.Timestamp != nil {
-			fields.timestamp = *msg.Timestamp
-		}
-		if msg.Hostname != nil {
-			fields.attrs["hostname"] = *msg.Hostname
-		}
-		if msg.Appname != nil {
-			fields.attrs["app_name"] = *msg.Appname
-		}
-		if msg.ProcID != nil {
-			fields.attrs["proc_id"] = *msg.ProcID
-		}
+		extractSyslogBase(fields, &msg.Base)
 		if msg.MsgID != nil {
 			fields.attrs["msg_id"] = *msg.MsgID
 		}
@@ -42,9 +31,51 @@ func extractSyslog(fields *extractedFields) {
 
 			for topLevelKey, vMap := range *msg.StructuredData {
 				for k, v := range vMap {
+					if k == syslogProjectParam && strings.Split(topLevelKey, "@")[0] == syslogProjectSDID {
+						fields.projectID = v
+						continue
+					}
 					fields.attrs[topLevelKey+"."+k] = v
 				}
 			}
 		}
+		return
+	}
+	if !rfc3164Fallback {
+		return
+	}
+
+	// fall back to the legacy bsd format still sent by network appliances and older hosts
+	p = rfc3164.NewParser(rfc3164.WithBestEffort(), rfc3164.WithYear(rfc3164.CurrentYear{}))
+	message, err = p.Parse([]byte(fields.logBody))
+	if msg, ok := message.(*rfc3164.SyslogMessage); err == nil && ok {
+		extractSyslogBase(fields, &msg.Base)
+	}
+}
+
+func extractSyslogBase(fields *extractedFields, msg *syslog.Base) {
+	if msg.Message != nil {
+		fields.logBody = *msg.Message
+	}
+	if msg.Facility != nil {
+		fields.attrs["facility"] = strconv.Itoa(int(*msg.Facility))
+	}
+	if msg.Severity != nil {
+		fields.logSeverity = plog.SeverityNumber(*msg.Severity).String()
+	}
+	if msg.Priority != nil {
+		fields.attrs["priority"] = strconv.Itoa(int(*msg.Priority))
+	}
+	if msg.Timestamp != nil {
+		fields.timestamp = *msg.Timestamp
+	}
+	if msg.Hostname != nil {
+		fields.attrs["hostname"] = *msg.Hostname
+	}
+	if msg.Appname != nil {
+		fields.attrs["app_name"] = *msg.Appname
+	}
+	if msg.ProcID != nil {
+		fields.attrs["proc_id"] = *msg.ProcID
 	}
 }
@@ -0,0 +1,335 @@
+package otel
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+)
+
+// maxSyslogMessageSize is the largest syslog message accepted, matching the rsyslog default max message size.
+const maxSyslogMessageSize = 64 * 1024
+
+const (
+	syslogBatchSize     = 1000
+	syslogFlushInterval = time.Second
+	// syslogReadTimeout is the time a tcp connection has to send a message before it is closed
+	syslogReadTimeout = 5 * time.Minute
+	// maxSyslogConnections is the maximum number of open tcp connections of a listener
+	maxSyslogConnections = 1024
+	// maxSyslogOctetCountDigits bounds the digits peeked for an octet count, counts over maxSyslogMessageSize are rejected
+	maxSyslogOctetCountDigits = 10
+	// syslogScopeName marks the logs received by a syslog listener, which are parsed as rfc3164 when they are not rfc5424
+	syslogScopeName = "highlight.syslog"
+	// syslogDefaultPriority is added to messages without a priority, as described by rfc3164 for relays
+	syslogDefaultPriority = "<13>"
+)
+
+// SyslogListener is a port accepting syslog messages, optionally over tls.
+// Messages are written to the project set by their structured data or otherwise to the project of the listener.
+type SyslogListener struct {
+	Port         string
+	ProjectToken string
+	TLSConfig    *tls.Config
+}
+
+// NewSyslogListeners parses a comma separated list of syslog ports, each optionally mapped to a project, ie. `514,5514=1jdkoe52`.
+func NewSyslogListeners(ports string, tlsConfig *tls.Config) ([]*SyslogListener, error) {
+	var listeners []*SyslogListener
+	for _, port := range strings.Split(ports, ",") {
+		port, projectToken, _ := strings.Cut(strings.TrimSpace(port), "=")
+		if port == "" {
+			continue
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return nil, e.Errorf("invalid syslog port %s", port)
+		}
+		listeners = append(listeners, &SyslogListener{
+			Port:         port,
+			ProjectToken: projectToken,
+			TLSConfig:    tlsConfig,
+		})
+	}
+	return listeners, nil
+}
+
+type syslogServer struct {
+	export       func(ctx context.Context, req plogotlp.ExportRequest) error
+	projectToken string
+
+	mu   sync.Mutex
+	logs plog.Logs
+
+	connMu sync.Mutex
+	conns  map[net.Conn]struct{}
+}
+
+func newSyslogServer(projectToken string, export func(ctx context.Context, req plogotlp.ExportRequest) error) *syslogServer {
+	return &syslogServer{
+		export:       export,
+		projectToken: projectToken,
+		logs:         plog.NewLogs(),
+		conns:        map[net.Conn]struct{}{},
+	}
+}
+
+func (s *syslogServer) add(ctx context.Context, message string) {
+	message = strings.TrimRight(message, "\r\n\x00")
+	if message == "" {
+		return
+	}
+	if !strings.HasPrefix(message, "<") {
+		message = syslogDefaultPriority + message
+	}
+	// the project of the port is prepended as a drain token, like render and heroku log drains,
+	// so that a project set in the structured data of the message takes precedence.
+	if s.projectToken != "" {
+		message = s.projectToken + " " + message
+	}
+
+	s.mu.Lock()
+	if s.logs.ResourceLogs().Len() == 0 {
+		s.logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().Scope().SetName(syslogScopeName)
+	}
+	record := s.logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.SetObservedTimestamp(record.Timestamp())
+	record.Body().SetStr(message)
+	full := s.logs.LogRecordCount() >= syslogBatchSize
+	s.mu.Unlock()
+
+	if full {
+		s.flush(ctx)
+	}
+}
+
+func (s *syslogServer) flush(ctx context.Context) {
+	s.mu.Lock()
+	logs := s.logs
+	s.logs = plog.NewLogs()
+	s.mu.Unlock()
+
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := s.export(ctx, plogotlp.NewExportRequestFromLogs(logs)); err != nil {
+		log.WithContext(ctx).WithError(err).WithField("count", logs.LogRecordCount()).Error("failed to export syslog messages")
+	}
+}
+
+// readSyslogFrame reads a syslog message framed with either octet counting or a trailing newline, as described by rfc6587.
+// A message is octet counted when it starts with a count followed by a space and the priority of the message.
+func readSyslogFrame(r *bufio.Reader) (string, error) {
+	counted, err := isOctetCounted(r)
+	if err != nil {
+		return "", err
+	}
+
+	if counted {
+		length, err := r.ReadString(' ')
+		if err != nil {
+			return "", err
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+		if err != nil || n > maxSyslogMessageSize {
+			return "", e.Errorf("invalid syslog octet count %q", length)
+		}
+		message := make([]byte, n)
+		if _, err := io.ReadFull(r, message); err != nil {
+			return "", err
+		}
+		return string(message), nil
+	}
+
+	line, err := r.ReadSlice('\n')
+	if errors.Is(err, io.EOF) && len(line) > 0 {
+		return string(line), nil
+	}
+	return string(line), err
+}
+
+// isOctetCounted peeks at the start of the next frame, only reading the bytes needed to tell the framing apart.
+func isOctetCounted(r *bufio.Reader) (bool, error) {
+	for i := 1; ; i++ {
+		peek, err := r.Peek(i)
+		if err != nil {
+			if len(peek) > 0 && errors.Is(err, io.EOF) {
+				return false, nil
+			}
+			return false, err
+		}
+		c := peek[i-1]
+		switch {
+		case c >= '0' && c <= '9' && i <= maxSyslogOctetCountDigits && (i > 1 || c != '0'):
+			continue
+		case c == ' ' && i > 1:
+			next, err := r.Peek(i + 1)
+			if err != nil {
+				if len(next) > 0 && errors.Is(err, io.EOF) {
+					return false, nil
+				}
+				return false, err
+			}
+			return next[i] == '<', nil
+		}
+		return false, nil
+	}
+}
+
+func (s *syslogServer) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReaderSize(conn, maxSyslogMessageSize)
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(syslogReadTimeout)); err != nil {
+			return
+		}
+		message, err := readSyslogFrame(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				log.WithContext(ctx).WithError(err).Warn("closing invalid syslog connection")
+			}
+			return
+		}
+		s.add(ctx, message)
+	}
+}
+
+// serveTCP serves the connections of the listener until it is closed, then closes the open connections
+// and returns once they are served.
+func (s *syslogServer) serveTCP(ctx context.Context, lis net.Listener) error {
+	var wg sync.WaitGroup
+	defer func() {
+		s.connMu.Lock()
+		for conn := range s.conns {
+			_ = conn.Close()
+		}
+		s.connMu.Unlock()
+		wg.Wait()
+	}()
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return e.Wrap(err, "failed to accept syslog connection")
+		}
+
+		s.connMu.Lock()
+		if len(s.conns) >= maxSyslogConnections {
+			s.connMu.Unlock()
+			log.WithContext(ctx).WithField("remote_addr", conn.RemoteAddr().String()).Warn("rejecting syslog connection over the connection limit")
+			_ = conn.Close()
+			continue
+		}
+		s.conns[conn] = struct{}{}
+		s.connMu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, conn)
+			s.connMu.Lock()
+			delete(s.conns, conn)
+			s.connMu.Unlock()
+		}()
+	}
+}
+
+// serveUDP reads one syslog message per datagram, as described by rfc5426.
+func (s *syslogServer) serveUDP(ctx context.Context, conn net.PacketConn) error {
+	buf := make([]byte, maxSyslogMessageSize)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return e.Wrap(err, "failed to read syslog udp packet")
+		}
+		s.add(ctx, string(buf[:n]))
+	}
+}
+
+// ListenSyslog serves rfc5424 and rfc3164 syslog messages on the port of the listener until the context is done.
+// Plain listeners accept udp and tcp, while tls listeners only accept tcp.
+// Returns once the connections are closed and the buffered messages are exported.
+func (o *Handler) ListenSyslog(ctx context.Context, listener *SyslogListener) error {
+	s := newSyslogServer(listener.ProjectToken, func(ctx context.Context, req plogotlp.ExportRequest) error {
+		_, err := o.exportLogs(ctx, http.Header{}, req)
+		return err
+	})
+
+	var lis net.Listener
+	var err error
+	if listener.TLSConfig != nil {
+		lis, err = tls.Listen("tcp", ":"+listener.Port, listener.TLSConfig)
+	} else {
+		lis, err = net.Listen("tcp", ":"+listener.Port)
+	}
+	if err != nil {
+		return e.Wrapf(err, "failed to listen for syslog tcp on port %s", listener.Port)
+	}
+	defer lis.Close()
+
+	var wg sync.WaitGroup
+	var conn net.PacketConn
+	if listener.TLSConfig == nil {
+		conn, err = net.ListenPacket("udp", ":"+listener.Port)
+		if err != nil {
+			return e.Wrapf(err, "failed to listen for syslog udp on port %s", listener.Port)
+		}
+		defer conn.Close()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.serveUDP(ctx, conn); err != nil {
+				log.WithContext(ctx).WithError(err).Error("syslog udp listener failed")
+			}
+		}()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(syslogFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = lis.Close()
+				if conn != nil {
+					_ = conn.Close()
+				}
+				return
+			case <-stopped:
+				return
+			case <-ticker.C:
+				s.flush(ctx)
+			}
+		}
+	}()
+
+	err = s.serveTCP(ctx, lis)
+	close(stopped)
+	if conn != nil {
+		_ = conn.Close()
+	}
+	wg.Wait()
+	s.flush(context.WithoutCancel(ctx))
+	return err
+}
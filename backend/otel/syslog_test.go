@@ -1,16 +1,24 @@
 package otel
 
 import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 )
 
 func Test_extractSyslog(t *testing.T) {
 	fields := newExtractedFields()
 
 	fields.logBody = "<1>1 2023-07-27T05:43:22.401882Z render render-log-endpoint-test 1 render-log-endpoint-test - Render test log"
-	extractSyslog(fields)
+	extractSyslog(fields, false)
 	assert.Equal(t, "render", fields.attrs["hostname"])
 	assert.Equal(t, "Render test log", fields.logBody)
 }
@@ -19,10 +27,129 @@ func Test_extractSyslogWithStructuredData(t *testing.T) {
 	fields := newExtractedFields()
 
 	fields.logBody = "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut=\"3\" eventSource=\"Application\" eventID=\"1011\"] BOMAn application event log entry"
-	extractSyslog(fields)
+	extractSyslog(fields, false)
 	assert.Equal(t, "mymachine.example.com", fields.attrs["hostname"])
 	assert.Equal(t, "BOMAn application event log entry", fields.logBody)
 	assert.Equal(t, "3", fields.attrs["exampleSDID@32473.iut"])
 	assert.Equal(t, "Application", fields.attrs["exampleSDID@32473.eventSource"])
 	assert.Equal(t, "1011", fields.attrs["exampleSDID@32473.eventID"])
 }
+
+func Test_extractSyslogRFC3164(t *testing.T) {
+	fields := newExtractedFields()
+
+	fields.logBody = "<34>Oct 11 22:14:15 mymachine su[230]: 'su root' failed for lonvick on /dev/pts/8"
+	extractSyslog(fields, true)
+	assert.Equal(t, "mymachine", fields.attrs["hostname"])
+	assert.Equal(t, "su", fields.attrs["app_name"])
+	assert.Equal(t, "230", fields.attrs["proc_id"])
+	assert.Equal(t, "4", fields.attrs["facility"])
+	assert.Equal(t, "'su root' failed for lonvick on /dev/pts/8", fields.logBody)
+	assert.Equal(t, time.October, fields.timestamp.Month())
+}
+
+func Test_extractSyslogRFC3164OutsideListener(t *testing.T) {
+	fields := newExtractedFields()
+
+	body := "<34>Oct 11 22:14:15 mymachine su[230]: 'su root' failed for lonvick on /dev/pts/8"
+	fields.logBody = body
+	extractSyslog(fields, false)
+	assert.Equal(t, body, fields.logBody)
+	assert.NotContains(t, fields.attrs, "hostname")
+}
+
+func Test_extractSyslogProject(t *testing.T) {
+	fields := newExtractedFields()
+
+	fields.logBody = "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [highlight@53479 project=\"1jdkoe52\"][exampleSDID@32473 iut=\"3\"] message"
+	extractSyslog(fields, false)
+	assert.Equal(t, "1jdkoe52", fields.projectID)
+	assert.Equal(t, "3", fields.attrs["exampleSDID@32473.iut"])
+	assert.NotContains(t, fields.attrs, "highlight@53479.project")
+}
+
+func Test_readSyslogFrame(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("18 <1>1 - - - - - - a<1>1 - - - - - - b\r\n<1>1 - - - - - - c"))
+	for _, expected := range []string{"<1>1 - - - - - - a", "<1>1 - - - - - - b\r\n", "<1>1 - - - - - - c"} {
+		message, err := readSyslogFrame(r)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, message)
+	}
+	_, err := readSyslogFrame(r)
+	assert.ErrorIs(t, err, io.EOF)
+
+	_, err = readSyslogFrame(bufio.NewReader(strings.NewReader("99999999 <1>1")))
+	assert.Error(t, err)
+
+	// messages starting with digits are only octet counted when the count is followed by a priority
+	r = bufio.NewReader(strings.NewReader("10 dropped packets\n1 2\n5"))
+	for _, expected := range []string{"10 dropped packets\n", "1 2\n", "5"} {
+		message, err := readSyslogFrame(r)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, message)
+	}
+}
+
+func TestNewSyslogListeners(t *testing.T) {
+	listeners, err := NewSyslogListeners("514, 5514=1jdkoe52", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*SyslogListener{{Port: "514"}, {Port: "5514", ProjectToken: "1jdkoe52"}}, listeners)
+
+	_, err = NewSyslogListeners("syslog", nil)
+	assert.Error(t, err)
+}
+
+func TestSyslogServer(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	s := newSyslogServer("1", func(ctx context.Context, req plogotlp.ExportRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		resourceLogs := req.Logs().ResourceLogs()
+		for i := 0; i < resourceLogs.Len(); i++ {
+			scopeLogs := resourceLogs.At(i).ScopeLogs()
+			for j := 0; j < scopeLogs.Len(); j++ {
+				assert.Equal(t, syslogScopeName, scopeLogs.At(j).Scope().Name())
+				records := scopeLogs.At(j).LogRecords()
+				for k := 0; k < records.Len(); k++ {
+					bodies = append(bodies, records.At(k).Body().Str())
+				}
+			}
+		}
+		return nil
+	})
+
+	ctx := context.Background()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		_ = s.serveTCP(ctx, lis)
+	}()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer conn.Close()
+	go func() {
+		_ = s.serveUDP(ctx, conn)
+	}()
+
+	client, err := net.Dial("tcp", lis.Addr().String())
+	assert.NoError(t, err)
+	_, err = client.Write([]byte("23 <34>Oct 11 22:14:15 a b<34>Oct 11 22:14:15 c d\n"))
+	assert.NoError(t, err)
+	_ = client.Close()
+
+	client, err = net.Dial("udp", conn.LocalAddr().String())
+	assert.NoError(t, err)
+	_, err = client.Write([]byte("Oct 11 22:14:15 e f\n"))
+	assert.NoError(t, err)
+	_ = client.Close()
+
+	assert.Eventually(t, func() bool {
+		s.flush(ctx)
+		mu.Lock()
+		defer mu.Unlock()
+		return len(bodies) == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.ElementsMatch(t, []string{"1 <34>Oct 11 22:14:15 a b", "1 <34>Oct 11 22:14:15 c d", "1 <13>Oct 11 22:14:15 e f"}, bodies)
+}
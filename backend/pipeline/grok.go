@@ -0,0 +1,118 @@
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	e "github.com/pkg/errors"
+)
+
+// grokPatterns are the commonly used patterns of the logstash grok library.
+var grokPatterns = map[string]string{
+	"WORD":              `\b\w+\b`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"QUOTEDSTRING":      `"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'`,
+	"UUID":              `[A-Fa-f0-9]{8}-(?:[A-Fa-f0-9]{4}-){3}[A-Fa-f0-9]{12}`,
+	"INT":               `[+-]?\d+`,
+	"POSINT":            `\b[1-9]\d*\b`,
+	"NONNEGINT":         `\b\d+\b`,
+	"NUMBER":            `[+-]?(?:\d+(?:\.\d*)?|\.\d+)`,
+	"BASE16NUM":         `(?:0[xX])?[0-9A-Fa-f]+`,
+	"USERNAME":          `[a-zA-Z0-9._-]+`,
+	"USER":              `%{USERNAME}`,
+	"EMAILADDRESS":      `[a-zA-Z0-9!#$%&'*+\-/=?^_{|}~.]+@%{HOSTNAME}`,
+	"IPV4":              `(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)`,
+	"IPV6":              `(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}`,
+	"IP":                `(?:%{IPV6}|%{IPV4})`,
+	"HOSTNAME":          `\b[0-9A-Za-z][0-9A-Za-z-]{0,62}(?:\.[0-9A-Za-z][0-9A-Za-z-]{0,62})*\.?\b`,
+	"IPORHOST":          `(?:%{IP}|%{HOSTNAME})`,
+	"HOSTPORT":          `%{IPORHOST}:%{POSINT}`,
+	"PATH":              `(?:/[^\s?#]*)+`,
+	"URIPROTO":          `[A-Za-z][A-Za-z0-9+.-]+`,
+	"URIPATH":           `(?:/[A-Za-z0-9$.+!*'(){},~:;=@#%&_\-]*)+`,
+	"URIPARAM":          `\?[A-Za-z0-9$.+!*'|(){},~@#%&/=:;_?\-\[\]<>]*`,
+	"URIPATHPARAM":      `%{URIPATH}(?:%{URIPARAM})?`,
+	"URI":               `%{URIPROTO}://(?:%{USER}(?::[^@]*)?@)?(?:%{IPORHOST}(?::%{POSINT})?)?(?:%{URIPATHPARAM})?`,
+	"MONTH":             `\b(?:[Jj]an(?:uary)?|[Ff]eb(?:ruary)?|[Mm]ar(?:ch)?|[Aa]pr(?:il)?|[Mm]ay|[Jj]une?|[Jj]uly?|[Aa]ug(?:ust)?|[Ss]ep(?:tember)?|[Oo]ct(?:ober)?|[Nn]ov(?:ember)?|[Dd]ec(?:ember)?)\b`,
+	"MONTHNUM":          `(?:0?[1-9]|1[0-2])`,
+	"MONTHDAY":          `(?:(?:0[1-9])|(?:[12][0-9])|(?:3[01])|[1-9])`,
+	"DAY":               `(?:Mon(?:day)?|Tue(?:sday)?|Wed(?:nesday)?|Thu(?:rsday)?|Fri(?:day)?|Sat(?:urday)?|Sun(?:day)?)`,
+	"YEAR":              `\d\d(?:\d\d)?`,
+	"HOUR":              `(?:2[0123]|[01]?[0-9])`,
+	"MINUTE":            `(?:[0-5][0-9])`,
+	"SECOND":            `(?:(?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?)`,
+	"TIME":              `%{HOUR}:%{MINUTE}(?::%{SECOND})?`,
+	"ISO8601_TIMEZONE":  `(?:Z|[+-]%{HOUR}(?::?%{MINUTE}))`,
+	"TIMESTAMP_ISO8601": `%{YEAR}-%{MONTHNUM}-%{MONTHDAY}[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?`,
+	"HTTPDATE":          `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+	"SYSLOGTIMESTAMP":   `%{MONTH} +%{MONTHDAY} %{TIME}`,
+	"LOGLEVEL":          `(?i:trace|debug|info(?:rmation)?|notice|warn(?:ing)?|err(?:or)?|crit(?:ical)?|fatal|severe|panic|emerg(?:ency)?|alert)`,
+}
+
+var grokReference = regexp.MustCompile(`%{(\w+)(?::([\w.@\-\[\]]+))?}`)
+
+// maxGrokDepth bounds the expansion of patterns referencing other patterns.
+const maxGrokDepth = 16
+
+// compileGrok compiles a grok pattern into a regex. Named references such as %{IP:client}
+// become capture groups, returned with the attribute names they are extracted to by capture index.
+func compileGrok(pattern string) (*regexp.Regexp, []string, error) {
+	var names []string
+	expanded, err := expandGrok(pattern, 0, &names)
+	if err != nil {
+		return nil, nil, err
+	}
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, nil, e.Wrap(err, "invalid grok pattern")
+	}
+	// map the captures to attributes, ignoring any unnamed groups of the pattern
+	groups := make([]string, len(re.SubexpNames()))
+	for idx, group := range re.SubexpNames() {
+		if n, err := strconv.Atoi(strings.TrimPrefix(group, "g")); err == nil && strings.HasPrefix(group, "g") {
+			groups[idx] = names[n]
+		}
+	}
+	return re, groups, nil
+}
+
+func expandGrok(pattern string, depth int, names *[]string) (string, error) {
+	if depth > maxGrokDepth {
+		return "", e.New("grok pattern is too deeply nested")
+	}
+	var err error
+	expanded := grokReference.ReplaceAllStringFunc(pattern, func(ref string) string {
+		match := grokReference.FindStringSubmatch(ref)
+		definition, ok := grokPatterns[match[1]]
+		if !ok {
+			err = fmt.Errorf("unknown grok pattern %s", match[1])
+			return ""
+		}
+		inner, innerErr := expandGrok(definition, depth+1, nil)
+		if innerErr != nil {
+			err = innerErr
+			return ""
+		}
+		// only the references of the configured pattern are captured
+		if match[2] == "" || names == nil {
+			return "(?:" + inner + ")"
+		}
+		// attribute names may not be valid regex group names, so groups are named by index
+		*names = append(*names, match[2])
+		return fmt.Sprintf("(?P<g%d>%s)", len(*names)-1, inner)
+	})
+	if err != nil {
+		return "", err
+	}
+	return expanded, nil
+}
+
+// isGrok returns whether a parse pattern is a grok pattern rather than a regex.
+func isGrok(pattern string) bool {
+	return strings.Contains(pattern, "%{")
+}
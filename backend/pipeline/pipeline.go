@@ -0,0 +1,219 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	e "github.com/pkg/errors"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	"github.com/highlight-run/highlight/backend/parser"
+	"github.com/highlight-run/highlight/backend/parser/listener"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	hlog "github.com/highlight/highlight/sdk/highlight-go/log"
+)
+
+// json body fields replacing the body of an expanded log
+var messageFields = []string{"message", "msg"}
+
+type stage struct {
+	*modelInputs.LogPipelineStage
+	filters listener.Filters
+	pattern *regexp.Regexp
+	// groups are the attributes set by the pattern captures, indexed by capture
+	groups   []string
+	severity map[string]modelInputs.LogLevel
+}
+
+// Pipeline processes the logs of a project with the stages configured in its filter settings.
+type Pipeline struct {
+	stages []*stage
+}
+
+// New compiles the stages of a log pipeline, returning an error for invalid stages.
+func New(stages model.LogPipeline) (*Pipeline, error) {
+	p := &Pipeline{}
+	for idx, s := range stages {
+		compiled, err := compileStage(s)
+		if err != nil {
+			return nil, e.Wrapf(err, "invalid log pipeline stage %d", idx)
+		}
+		p.stages = append(p.stages, compiled)
+	}
+	return p, nil
+}
+
+func compileStage(s *modelInputs.LogPipelineStage) (*stage, error) {
+	if !s.Type.IsValid() {
+		return nil, fmt.Errorf("unknown stage type %s", s.Type)
+	}
+	compiled := &stage{LogPipelineStage: s}
+	if s.Query != "" {
+		compiled.filters = parser.Parse(s.Query, clickhouse.LogsTableConfig)
+	}
+
+	switch s.Type {
+	case modelInputs.LogPipelineStageTypeParse:
+		if s.Pattern == "" {
+			return nil, e.New("parse stage requires a pattern")
+		}
+		var err error
+		if isGrok(s.Pattern) {
+			compiled.pattern, compiled.groups, err = compileGrok(s.Pattern)
+		} else {
+			compiled.pattern, err = regexp.Compile(s.Pattern)
+			if err == nil {
+				compiled.groups = compiled.pattern.SubexpNames()
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	case modelInputs.LogPipelineStageTypeRename, modelInputs.LogPipelineStageTypeCopy:
+		if s.Source == "" || s.Target == "" {
+			return nil, fmt.Errorf("%s stage requires a source and a target", strings.ToLower(s.Type.String()))
+		}
+	case modelInputs.LogPipelineStageTypeDrop:
+		if s.Source == "" {
+			return nil, e.New("drop stage requires a source")
+		}
+	case modelInputs.LogPipelineStageTypeDropLog:
+		if s.Query == "" {
+			return nil, e.New("drop log stage requires a query")
+		}
+	case modelInputs.LogPipelineStageTypeRoute:
+		if s.ProjectID == nil {
+			return nil, e.New("route stage requires a project")
+		}
+	case modelInputs.LogPipelineStageTypeSeverity:
+		compiled.severity = map[string]modelInputs.LogLevel{}
+		for _, m := range s.SeverityMapping {
+			compiled.severity[strings.ToLower(m.From)] = m.Level
+		}
+	}
+	return compiled, nil
+}
+
+// Process applies the stages to a log, returning false when the log is dropped by a drop log stage.
+// A route stage moves the log to another project and ends the processing of the log,
+// so that the pipeline of the destination project is not applied.
+func (p *Pipeline) Process(ctx context.Context, logRow *clickhouse.LogRow) bool {
+	if logRow.LogAttributes == nil {
+		logRow.LogAttributes = map[string]string{}
+	}
+	for _, s := range p.stages {
+		if s.filters != nil && !clickhouse.LogMatchesQuery(logRow, s.filters) {
+			continue
+		}
+		switch s.Type {
+		case modelInputs.LogPipelineStageTypeParse:
+			s.parse(logRow)
+		case modelInputs.LogPipelineStageTypeParseJSON:
+			s.parseJSON(ctx, logRow)
+		case modelInputs.LogPipelineStageTypeRename:
+			if v, ok := logRow.LogAttributes[s.Source]; ok {
+				delete(logRow.LogAttributes, s.Source)
+				logRow.LogAttributes[s.Target] = v
+			}
+		case modelInputs.LogPipelineStageTypeCopy:
+			if v, ok := logRow.LogAttributes[s.Source]; ok {
+				logRow.LogAttributes[s.Target] = v
+			}
+		case modelInputs.LogPipelineStageTypeDrop:
+			// dropping a key also drops the attributes nested under it
+			for k := range logRow.LogAttributes {
+				if k == s.Source || strings.HasPrefix(k, s.Source+".") {
+					delete(logRow.LogAttributes, k)
+				}
+			}
+		case modelInputs.LogPipelineStageTypeDropLog:
+			return false
+		case modelInputs.LogPipelineStageTypeSeverity:
+			s.remapSeverity(logRow)
+		case modelInputs.LogPipelineStageTypeRoute:
+			logRow.ProjectId = uint32(*s.ProjectID)
+			return true
+		}
+	}
+	return true
+}
+
+func (s *stage) source(logRow *clickhouse.LogRow) (string, bool) {
+	if s.Source == "" {
+		return logRow.Body, true
+	}
+	v, ok := logRow.LogAttributes[s.Source]
+	return v, ok
+}
+
+func (s *stage) parse(logRow *clickhouse.LogRow) {
+	value, ok := s.source(logRow)
+	if !ok {
+		return
+	}
+	match := s.pattern.FindStringSubmatch(value)
+	if match == nil {
+		return
+	}
+	for idx, name := range s.groups {
+		if name == "" || match[idx] == "" {
+			continue
+		}
+		logRow.LogAttributes[name] = match[idx]
+	}
+}
+
+// parseJSON expands a json object into attributes. When the body is expanded,
+// its message field becomes the body of the log.
+func (s *stage) parseJSON(ctx context.Context, logRow *clickhouse.LogRow) {
+	value, ok := s.source(logRow)
+	if !ok {
+		return
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &obj); err != nil {
+		return
+	}
+
+	for k, v := range obj {
+		if s.Target != "" {
+			k = s.Target + "." + k
+		}
+		for key, value := range hlog.FormatLogAttributes(k, v) {
+			logRow.LogAttributes[key] = value
+		}
+	}
+	if s.Source != "" {
+		return
+	}
+	for _, field := range messageFields {
+		if s.Target != "" {
+			field = s.Target + "." + field
+		}
+		if message, ok := logRow.LogAttributes[field]; ok {
+			delete(logRow.LogAttributes, field)
+			clickhouse.WithBody(ctx, message)(logRow)
+			return
+		}
+	}
+}
+
+// remapSeverity sets the level of a log from the mapping of the source attribute value, or the current level
+// when no source is set. Unmapped source values are parsed as levels, and the stage level is the fallback.
+func (s *stage) remapSeverity(logRow *clickhouse.LogRow) {
+	value, ok := logRow.SeverityText, true
+	if s.Source != "" {
+		value, ok = logRow.LogAttributes[s.Source]
+	}
+	if level, mapped := s.severity[strings.ToLower(value)]; ok && mapped {
+		clickhouse.WithSeverityText(level.String())(logRow)
+	} else if s.Level != nil {
+		clickhouse.WithSeverityText(s.Level.String())(logRow)
+	} else if ok && s.Source != "" {
+		clickhouse.WithSeverityText(value)(logRow)
+	}
+}
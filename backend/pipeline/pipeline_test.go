@@ -0,0 +1,169 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+)
+
+func newLogRow(body string, attributes map[string]string) *clickhouse.LogRow {
+	return clickhouse.NewLogRow(time.Now(), 1, clickhouse.WithBody(context.TODO(), body), clickhouse.WithLogAttributes(attributes))
+}
+
+func process(t *testing.T, stages model.LogPipeline, logRow *clickhouse.LogRow) bool {
+	p, err := New(stages)
+	require.NoError(t, err)
+	return p.Process(context.TODO(), logRow)
+}
+
+func TestCompileGrok(t *testing.T) {
+	re, groups, err := compileGrok(`%{IPORHOST:client.ip} - (\w+) \[%{HTTPDATE:time}\] "%{WORD:http.method} %{URIPATHPARAM:http.target}" %{NUMBER:http.status_code}`)
+	require.NoError(t, err)
+
+	match := re.FindStringSubmatch(`10.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif?a=b" 200`)
+	require.NotNil(t, match)
+	values := map[string]string{}
+	for idx, name := range groups {
+		if name != "" {
+			values[name] = match[idx]
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"client.ip":        "10.0.0.1",
+		"time":             "10/Oct/2000:13:55:36 -0700",
+		"http.method":      "GET",
+		"http.target":      "/apache_pb.gif?a=b",
+		"http.status_code": "200",
+	}, values)
+
+	_, _, err = compileGrok(`%{NOTAPATTERN:foo}`)
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	for name, stage := range map[string]*modelInputs.LogPipelineStage{
+		"unknown type":      {Type: "Unknown"},
+		"missing pattern":   {Type: modelInputs.LogPipelineStageTypeParse},
+		"invalid regex":     {Type: modelInputs.LogPipelineStageTypeParse, Pattern: `(`},
+		"missing target":    {Type: modelInputs.LogPipelineStageTypeRename, Source: "a"},
+		"missing drop key":  {Type: modelInputs.LogPipelineStageTypeDrop},
+		"drop log no query": {Type: modelInputs.LogPipelineStageTypeDropLog},
+		"unknown grok name": {Type: modelInputs.LogPipelineStageTypeParse, Pattern: `%{FOO:bar}`},
+		"missing project":   {Type: modelInputs.LogPipelineStageTypeRoute, Query: `env=dev`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(model.LogPipeline{stage})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestProcessParse(t *testing.T) {
+	logRow := newLogRow("user=alice took 35ms", nil)
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeParse, Pattern: `user=(?P<user>\w+) took (?P<duration>\d+)ms`},
+	}, logRow))
+	assert.Equal(t, map[string]string{"user": "alice", "duration": "35"}, logRow.LogAttributes)
+
+	logRow = newLogRow("body", map[string]string{"line": "GET 200"})
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeParse, Source: "line", Pattern: `%{WORD:method} %{INT:status}`},
+	}, logRow))
+	assert.Equal(t, map[string]string{"line": "GET 200", "method": "GET", "status": "200"}, logRow.LogAttributes)
+}
+
+func TestProcessParseJSON(t *testing.T) {
+	logRow := newLogRow(`{"msg": "hello", "user": {"id": 1}, "level": "warn"}`, nil)
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeParseJSON},
+	}, logRow))
+	assert.Equal(t, "hello", logRow.Body)
+	assert.Equal(t, map[string]string{"user.id": "1", "level": "warn"}, logRow.LogAttributes)
+
+	logRow = newLogRow("body", map[string]string{"payload": `{"a": "b"}`})
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeParseJSON, Source: "payload", Target: "payload"},
+	}, logRow))
+	assert.Equal(t, "body", logRow.Body)
+	assert.Equal(t, "b", logRow.LogAttributes["payload.a"])
+
+	logRow = newLogRow("not json", nil)
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeParseJSON},
+	}, logRow))
+	assert.Equal(t, "not json", logRow.Body)
+	assert.Empty(t, logRow.LogAttributes)
+}
+
+func TestProcessAttributes(t *testing.T) {
+	logRow := newLogRow("body", map[string]string{"a": "1", "b": "2", "secret": "x", "secret.nested": "y", "secrets": "z"})
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeRename, Source: "a", Target: "renamed"},
+		{Type: modelInputs.LogPipelineStageTypeCopy, Source: "b", Target: "copied"},
+		{Type: modelInputs.LogPipelineStageTypeDrop, Source: "secret"},
+	}, logRow))
+	assert.Equal(t, map[string]string{"renamed": "1", "b": "2", "copied": "2", "secrets": "z"}, logRow.LogAttributes)
+}
+
+func TestProcessSeverity(t *testing.T) {
+	mapping := []*modelInputs.LogSeverityMapping{{From: "E", Level: modelInputs.LogLevelError}}
+
+	logRow := newLogRow("body", map[string]string{"lvl": "e"})
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeSeverity, Source: "lvl", SeverityMapping: mapping},
+	}, logRow))
+	assert.Equal(t, modelInputs.LogLevelError.String(), logRow.SeverityText)
+
+	logRow = newLogRow("body", map[string]string{"lvl": "warning"})
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeSeverity, Source: "lvl", SeverityMapping: mapping},
+	}, logRow))
+	assert.Equal(t, modelInputs.LogLevelWarn.String(), logRow.SeverityText)
+
+	fatal := modelInputs.LogLevelFatal
+	logRow = newLogRow("goroutine panic", nil)
+	assert.True(t, process(t, model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeSeverity, Query: `panic`, Level: &fatal},
+	}, logRow))
+	assert.Equal(t, modelInputs.LogLevelFatal.String(), logRow.SeverityText)
+}
+
+func TestProcessDropLog(t *testing.T) {
+	stages := model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeDropLog, Query: `healthcheck`},
+		{Type: modelInputs.LogPipelineStageTypeCopy, Source: "service", Target: "copied"},
+	}
+
+	logRow := newLogRow("GET /healthcheck", map[string]string{"service": "api"})
+	assert.False(t, process(t, stages, logRow))
+	assert.NotContains(t, logRow.LogAttributes, "copied")
+
+	logRow = newLogRow("GET /users", map[string]string{"service": "api"})
+	assert.True(t, process(t, stages, logRow))
+	assert.Equal(t, "api", logRow.LogAttributes["copied"])
+}
+
+func TestProcessRoute(t *testing.T) {
+	stages := model.LogPipeline{
+		{Type: modelInputs.LogPipelineStageTypeRoute, Query: `service=billing`, ProjectID: ptr.Int(2)},
+		{Type: modelInputs.LogPipelineStageTypeCopy, Source: "service", Target: "copied"},
+	}
+
+	logRow := newLogRow("body", map[string]string{"service": "billing"})
+	assert.True(t, process(t, stages, logRow))
+	assert.Equal(t, uint32(2), logRow.ProjectId)
+	assert.NotContains(t, logRow.LogAttributes, "copied")
+
+	logRow = newLogRow("body", map[string]string{"service": "api"})
+	assert.True(t, process(t, stages, logRow))
+	assert.Equal(t, uint32(1), logRow.ProjectId)
+	assert.Equal(t, "api", logRow.LogAttributes["copied"])
+}
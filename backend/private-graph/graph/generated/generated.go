@@ -186,10 +186,12 @@ type ComplexityRoot struct {
 		FilterChromeExtension             func(childComplexity int) int
 		FilterSessionsWithoutError        func(childComplexity int) int
 		ID                                func(childComplexity int) int
+		LogPipeline                       func(childComplexity int) int
 		Name                              func(childComplexity int) int
 		RageClickCount                    func(childComplexity int) int
 		RageClickRadiusPixels             func(childComplexity int) int
 		RageClickWindowSeconds            func(childComplexity int) int
+		RedactionRules                    func(childComplexity int) int
 		Sampling                          func(childComplexity int) int
 		VerboseID                         func(childComplexity int) int
 		WorkspaceID                       func(childComplexity int) int
@@ -640,6 +642,36 @@ type ComplexityRoot struct {
 		RangeStart func(childComplexity int) int
 	}
 
+	IngestDrop struct {
+		Count  func(childComplexity int) int
+		Reason func(childComplexity int) int
+	}
+
+	IngestPreviewError struct {
+		Environment func(childComplexity int) int
+		Event       func(childComplexity int) int
+		ServiceName func(childComplexity int) int
+		Source      func(childComplexity int) int
+		SpanID      func(childComplexity int) int
+		StackTrace  func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		TraceID     func(childComplexity int) int
+		Type        func(childComplexity int) int
+	}
+
+	IngestPreviewItem struct {
+		DroppedByPipeline func(childComplexity int) int
+		Error             func(childComplexity int) int
+		ExclusionQuery    func(childComplexity int) int
+		Ingested          func(childComplexity int) int
+		Log               func(childComplexity int) int
+		Product           func(childComplexity int) int
+		QuotaExceeded     func(childComplexity int) int
+		Reason            func(childComplexity int) int
+		TailSampled       func(childComplexity int) int
+		Trace             func(childComplexity int) int
+	}
+
 	IntegrationProjectMapping struct {
 		ExternalID func(childComplexity int) int
 		ProjectID  func(childComplexity int) int
@@ -763,6 +795,22 @@ type ComplexityRoot struct {
 		Timestamp func(childComplexity int) int
 	}
 
+	LogPipelineStage struct {
+		Level           func(childComplexity int) int
+		Pattern         func(childComplexity int) int
+		ProjectID       func(childComplexity int) int
+		Query           func(childComplexity int) int
+		SeverityMapping func(childComplexity int) int
+		Source          func(childComplexity int) int
+		Target          func(childComplexity int) int
+		Type            func(childComplexity int) int
+	}
+
+	LogSeverityMapping struct {
+		From  func(childComplexity int) int
+		Level func(childComplexity int) int
+	}
+
 	LogsHistogram struct {
 		Buckets      func(childComplexity int) int
 		ObjectCount  func(childComplexity int) int
@@ -887,7 +935,7 @@ type ComplexityRoot struct {
 		DeleteSessions                        func(childComplexity int, projectID int, params model.QueryInput, sessionCount int) int
 		DeleteVisualization                   func(childComplexity int, id int) int
 		EditProject                           func(childComplexity int, id int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool) int
-		EditProjectSettings                   func(childComplexity int, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *model.SamplingInput) int
+		EditProjectSettings                   func(childComplexity int, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *model.SamplingInput, logPipeline []*model.LogPipelineStageInput, redactionRules []*model.RedactionRuleInput) int
 		EditSavedSegment                      func(childComplexity int, id int, projectID int, name string, entityType model.SavedSegmentEntityType, query string) int
 		EditServiceGithubSettings             func(childComplexity int, id int, projectID int, githubRepoPath *string, buildPrefix *string, githubPrefix *string) int
 		EditWorkspace                         func(childComplexity int, id int, name *string) int
@@ -1068,6 +1116,8 @@ type ComplexityRoot struct {
 		HeightLists                      func(childComplexity int, projectID int) int
 		HeightWorkspaces                 func(childComplexity int, workspaceID int) int
 		IdentifierSuggestion             func(childComplexity int, projectID int, query string) int
+		IngestDrops                      func(childComplexity int, workspaceID int, productType model.ProductType, dateRange model.DateRangeRequiredInput) int
+		IngestPreview                    func(childComplexity int, projectID int, payload string, sampling *model.SamplingInput) int
 		IntegrationProjectMappings       func(childComplexity int, workspaceID int, integrationType *model.IntegrationType) int
 		IsIntegratedWith                 func(childComplexity int, integrationType model.IntegrationType, projectID int) int
 		IsProjectIntegratedWith          func(childComplexity int, integrationType model.IntegrationType, projectID int) int
@@ -1196,6 +1246,12 @@ type ComplexityRoot struct {
 		UserProperties  func(childComplexity int) int
 	}
 
+	RedactionRule struct {
+		Mode    func(childComplexity int) int
+		Pattern func(childComplexity int) int
+		Type    func(childComplexity int) int
+	}
+
 	ReferrerTablePayload struct {
 		Count   func(childComplexity int) int
 		Host    func(childComplexity int) int
@@ -1207,18 +1263,33 @@ type ComplexityRoot struct {
 	}
 
 	Sampling struct {
-		ErrorExclusionQuery    func(childComplexity int) int
-		ErrorMinuteRateLimit   func(childComplexity int) int
-		ErrorSamplingRate      func(childComplexity int) int
-		LogExclusionQuery      func(childComplexity int) int
-		LogMinuteRateLimit     func(childComplexity int) int
-		LogSamplingRate        func(childComplexity int) int
-		SessionExclusionQuery  func(childComplexity int) int
-		SessionMinuteRateLimit func(childComplexity int) int
-		SessionSamplingRate    func(childComplexity int) int
-		TraceExclusionQuery    func(childComplexity int) int
-		TraceMinuteRateLimit   func(childComplexity int) int
-		TraceSamplingRate      func(childComplexity int) int
+		ErrorExclusionQuery            func(childComplexity int) int
+		ErrorMinuteRateLimit           func(childComplexity int) int
+		ErrorMinuteRateLimitBurst      func(childComplexity int) int
+		ErrorMinuteRateLimitKey        func(childComplexity int) int
+		ErrorSamplingRate              func(childComplexity int) int
+		LogAdaptiveSamplingKey         func(childComplexity int) int
+		LogAdaptiveSamplingTarget      func(childComplexity int) int
+		LogExclusionQuery              func(childComplexity int) int
+		LogMinuteRateLimit             func(childComplexity int) int
+		LogMinuteRateLimitBurst        func(childComplexity int) int
+		LogMinuteRateLimitKey          func(childComplexity int) int
+		LogSamplingRate                func(childComplexity int) int
+		SessionExclusionQuery          func(childComplexity int) int
+		SessionMinuteRateLimit         func(childComplexity int) int
+		SessionMinuteRateLimitBurst    func(childComplexity int) int
+		SessionMinuteRateLimitKey      func(childComplexity int) int
+		SessionSamplingRate            func(childComplexity int) int
+		TraceAdaptiveSamplingKey       func(childComplexity int) int
+		TraceAdaptiveSamplingTarget    func(childComplexity int) int
+		TraceExclusionQuery            func(childComplexity int) int
+		TraceMinuteRateLimit           func(childComplexity int) int
+		TraceMinuteRateLimitBurst      func(childComplexity int) int
+		TraceMinuteRateLimitKey        func(childComplexity int) int
+		TraceSamplingRate              func(childComplexity int) int
+		TraceTailSampling              func(childComplexity int) int
+		TraceTailSamplingMinDurationMs func(childComplexity int) int
+		TraceTailSamplingQuery         func(childComplexity int) int
 	}
 
 	SanitizedAdmin struct {
@@ -1782,7 +1853,7 @@ type MutationResolver interface {
 	CreateProject(ctx context.Context, name string, workspaceID int) (*model1.Project, error)
 	CreateWorkspace(ctx context.Context, name string, promoCode *string) (*model1.Workspace, error)
 	EditProject(ctx context.Context, id int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool) (*model1.Project, error)
-	EditProjectSettings(ctx context.Context, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *model.SamplingInput) (*model.AllProjectSettings, error)
+	EditProjectSettings(ctx context.Context, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *model.SamplingInput, logPipeline []*model.LogPipelineStageInput, redactionRules []*model.RedactionRuleInput) (*model.AllProjectSettings, error)
 	EditWorkspace(ctx context.Context, id int, name *string) (*model1.Workspace, error)
 	EditWorkspaceSettings(ctx context.Context, workspaceID int, aiApplication *bool, aiInsights *bool, aiQueryBuilder *bool) (*model1.AllWorkspaceSettings, error)
 	ExportSession(ctx context.Context, sessionSecureID string) (bool, error)
@@ -1928,6 +1999,7 @@ type QueryResolver interface {
 	BillingDetailsForProject(ctx context.Context, projectID int) (*model.BillingDetails, error)
 	BillingDetails(ctx context.Context, workspaceID int) (*model.BillingDetails, error)
 	UsageHistory(ctx context.Context, workspaceID int, productType model.ProductType, dateRange *model.DateRangeRequiredInput) (*model.UsageHistory, error)
+	IngestDrops(ctx context.Context, workspaceID int, productType model.ProductType, dateRange model.DateRangeRequiredInput) ([]*model.IngestDrop, error)
 	FieldSuggestion(ctx context.Context, projectID int, name string, query string) ([]*model1.Field, error)
 	PropertySuggestion(ctx context.Context, projectID int, query string, typeArg string) ([]*model1.Field, error)
 	ErrorFieldSuggestion(ctx context.Context, projectID int, name string, query string) ([]*model1.ErrorField, error)
@@ -1974,6 +2046,7 @@ type QueryResolver interface {
 	GithubIssueLabels(ctx context.Context, workspaceID int, repository string) ([]string, error)
 	Project(ctx context.Context, id int) (*model1.Project, error)
 	ProjectSettings(ctx context.Context, projectID int) (*model.AllProjectSettings, error)
+	IngestPreview(ctx context.Context, projectID int, payload string, sampling *model.SamplingInput) ([]*model.IngestPreviewItem, error)
 	Workspace(ctx context.Context, id int) (*model1.Workspace, error)
 	WorkspaceForInviteLink(ctx context.Context, secret string) (*model.WorkspaceForInviteLink, error)
 	WorkspaceInviteLinks(ctx context.Context, workspaceID int) (*model1.WorkspaceInviteLink, error)
@@ -2716,6 +2789,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AllProjectSettings.ID(childComplexity), true
 
+	case "AllProjectSettings.log_pipeline":
+		if e.complexity.AllProjectSettings.LogPipeline == nil {
+			break
+		}
+
+		return e.complexity.AllProjectSettings.LogPipeline(childComplexity), true
+
 	case "AllProjectSettings.name":
 		if e.complexity.AllProjectSettings.Name == nil {
 			break
@@ -2744,6 +2824,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AllProjectSettings.RageClickWindowSeconds(childComplexity), true
 
+	case "AllProjectSettings.redaction_rules":
+		if e.complexity.AllProjectSettings.RedactionRules == nil {
+			break
+		}
+
+		return e.complexity.AllProjectSettings.RedactionRules(childComplexity), true
+
 	case "AllProjectSettings.sampling":
 		if e.complexity.AllProjectSettings.Sampling == nil {
 			break
@@ -4830,6 +4917,153 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.HistogramBucket.RangeStart(childComplexity), true
 
+	case "IngestDrop.count":
+		if e.complexity.IngestDrop.Count == nil {
+			break
+		}
+
+		return e.complexity.IngestDrop.Count(childComplexity), true
+
+	case "IngestDrop.reason":
+		if e.complexity.IngestDrop.Reason == nil {
+			break
+		}
+
+		return e.complexity.IngestDrop.Reason(childComplexity), true
+
+	case "IngestPreviewError.environment":
+		if e.complexity.IngestPreviewError.Environment == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.Environment(childComplexity), true
+
+	case "IngestPreviewError.event":
+		if e.complexity.IngestPreviewError.Event == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.Event(childComplexity), true
+
+	case "IngestPreviewError.service_name":
+		if e.complexity.IngestPreviewError.ServiceName == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.ServiceName(childComplexity), true
+
+	case "IngestPreviewError.source":
+		if e.complexity.IngestPreviewError.Source == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.Source(childComplexity), true
+
+	case "IngestPreviewError.span_id":
+		if e.complexity.IngestPreviewError.SpanID == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.SpanID(childComplexity), true
+
+	case "IngestPreviewError.stack_trace":
+		if e.complexity.IngestPreviewError.StackTrace == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.StackTrace(childComplexity), true
+
+	case "IngestPreviewError.timestamp":
+		if e.complexity.IngestPreviewError.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.Timestamp(childComplexity), true
+
+	case "IngestPreviewError.trace_id":
+		if e.complexity.IngestPreviewError.TraceID == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.TraceID(childComplexity), true
+
+	case "IngestPreviewError.type":
+		if e.complexity.IngestPreviewError.Type == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewError.Type(childComplexity), true
+
+	case "IngestPreviewItem.dropped_by_pipeline":
+		if e.complexity.IngestPreviewItem.DroppedByPipeline == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.DroppedByPipeline(childComplexity), true
+
+	case "IngestPreviewItem.error":
+		if e.complexity.IngestPreviewItem.Error == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Error(childComplexity), true
+
+	case "IngestPreviewItem.exclusion_query":
+		if e.complexity.IngestPreviewItem.ExclusionQuery == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.ExclusionQuery(childComplexity), true
+
+	case "IngestPreviewItem.ingested":
+		if e.complexity.IngestPreviewItem.Ingested == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Ingested(childComplexity), true
+
+	case "IngestPreviewItem.log":
+		if e.complexity.IngestPreviewItem.Log == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Log(childComplexity), true
+
+	case "IngestPreviewItem.product":
+		if e.complexity.IngestPreviewItem.Product == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Product(childComplexity), true
+
+	case "IngestPreviewItem.quota_exceeded":
+		if e.complexity.IngestPreviewItem.QuotaExceeded == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.QuotaExceeded(childComplexity), true
+
+	case "IngestPreviewItem.reason":
+		if e.complexity.IngestPreviewItem.Reason == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Reason(childComplexity), true
+
+	case "IngestPreviewItem.tail_sampled":
+		if e.complexity.IngestPreviewItem.TailSampled == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.TailSampled(childComplexity), true
+
+	case "IngestPreviewItem.trace":
+		if e.complexity.IngestPreviewItem.Trace == nil {
+			break
+		}
+
+		return e.complexity.IngestPreviewItem.Trace(childComplexity), true
+
 	case "IntegrationProjectMapping.external_id":
 		if e.complexity.IntegrationProjectMapping.ExternalID == nil {
 			break
@@ -5355,6 +5589,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.LogLine.Timestamp(childComplexity), true
 
+	case "LogPipelineStage.level":
+		if e.complexity.LogPipelineStage.Level == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Level(childComplexity), true
+
+	case "LogPipelineStage.pattern":
+		if e.complexity.LogPipelineStage.Pattern == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Pattern(childComplexity), true
+
+	case "LogPipelineStage.project_id":
+		if e.complexity.LogPipelineStage.ProjectID == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.ProjectID(childComplexity), true
+
+	case "LogPipelineStage.query":
+		if e.complexity.LogPipelineStage.Query == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Query(childComplexity), true
+
+	case "LogPipelineStage.severity_mapping":
+		if e.complexity.LogPipelineStage.SeverityMapping == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.SeverityMapping(childComplexity), true
+
+	case "LogPipelineStage.source":
+		if e.complexity.LogPipelineStage.Source == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Source(childComplexity), true
+
+	case "LogPipelineStage.target":
+		if e.complexity.LogPipelineStage.Target == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Target(childComplexity), true
+
+	case "LogPipelineStage.type":
+		if e.complexity.LogPipelineStage.Type == nil {
+			break
+		}
+
+		return e.complexity.LogPipelineStage.Type(childComplexity), true
+
+	case "LogSeverityMapping.from":
+		if e.complexity.LogSeverityMapping.From == nil {
+			break
+		}
+
+		return e.complexity.LogSeverityMapping.From(childComplexity), true
+
+	case "LogSeverityMapping.level":
+		if e.complexity.LogSeverityMapping.Level == nil {
+			break
+		}
+
+		return e.complexity.LogSeverityMapping.Level(childComplexity), true
+
 	case "LogsHistogram.buckets":
 		if e.complexity.LogsHistogram.Buckets == nil {
 			break
@@ -6149,7 +6453,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.EditProjectSettings(childComplexity, args["projectId"].(int), args["name"].(*string), args["billing_email"].(*string), args["excluded_users"].(pq.StringArray), args["error_filters"].(pq.StringArray), args["error_json_paths"].(pq.StringArray), args["rage_click_window_seconds"].(*int), args["rage_click_radius_pixels"].(*int), args["rage_click_count"].(*int), args["filter_chrome_extension"].(*bool), args["filterSessionsWithoutError"].(*bool), args["autoResolveStaleErrorsDayInterval"].(*int), args["sampling"].(*model.SamplingInput)), true
+		return e.complexity.Mutation.EditProjectSettings(childComplexity, args["projectId"].(int), args["name"].(*string), args["billing_email"].(*string), args["excluded_users"].(pq.StringArray), args["error_filters"].(pq.StringArray), args["error_json_paths"].(pq.StringArray), args["rage_click_window_seconds"].(*int), args["rage_click_radius_pixels"].(*int), args["rage_click_count"].(*int), args["filter_chrome_extension"].(*bool), args["filterSessionsWithoutError"].(*bool), args["autoResolveStaleErrorsDayInterval"].(*int), args["sampling"].(*model.SamplingInput), args["log_pipeline"].([]*model.LogPipelineStageInput), args["redaction_rules"].([]*model.RedactionRuleInput)), true
 
 	case "Mutation.editSavedSegment":
 		if e.complexity.Mutation.EditSavedSegment == nil {
@@ -7849,6 +8153,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.IdentifierSuggestion(childComplexity, args["project_id"].(int), args["query"].(string)), true
 
+	case "Query.ingestDrops":
+		if e.complexity.Query.IngestDrops == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ingestDrops_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IngestDrops(childComplexity, args["workspace_id"].(int), args["product_type"].(model.ProductType), args["date_range"].(model.DateRangeRequiredInput)), true
+
+	case "Query.ingest_preview":
+		if e.complexity.Query.IngestPreview == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ingest_preview_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IngestPreview(childComplexity, args["project_id"].(int), args["payload"].(string), args["sampling"].(*model.SamplingInput)), true
+
 	case "Query.integration_project_mappings":
 		if e.complexity.Query.IntegrationProjectMappings == nil {
 			break
@@ -9117,6 +9445,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RageClickEventForProject.UserProperties(childComplexity), true
 
+	case "RedactionRule.mode":
+		if e.complexity.RedactionRule.Mode == nil {
+			break
+		}
+
+		return e.complexity.RedactionRule.Mode(childComplexity), true
+
+	case "RedactionRule.pattern":
+		if e.complexity.RedactionRule.Pattern == nil {
+			break
+		}
+
+		return e.complexity.RedactionRule.Pattern(childComplexity), true
+
+	case "RedactionRule.type":
+		if e.complexity.RedactionRule.Type == nil {
+			break
+		}
+
+		return e.complexity.RedactionRule.Type(childComplexity), true
+
 	case "ReferrerTablePayload.count":
 		if e.complexity.ReferrerTablePayload.Count == nil {
 			break
@@ -9159,6 +9508,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.ErrorMinuteRateLimit(childComplexity), true
 
+	case "Sampling.error_minute_rate_limit_burst":
+		if e.complexity.Sampling.ErrorMinuteRateLimitBurst == nil {
+			break
+		}
+
+		return e.complexity.Sampling.ErrorMinuteRateLimitBurst(childComplexity), true
+
+	case "Sampling.error_minute_rate_limit_key":
+		if e.complexity.Sampling.ErrorMinuteRateLimitKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.ErrorMinuteRateLimitKey(childComplexity), true
+
 	case "Sampling.error_sampling_rate":
 		if e.complexity.Sampling.ErrorSamplingRate == nil {
 			break
@@ -9166,6 +9529,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.ErrorSamplingRate(childComplexity), true
 
+	case "Sampling.log_adaptive_sampling_key":
+		if e.complexity.Sampling.LogAdaptiveSamplingKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.LogAdaptiveSamplingKey(childComplexity), true
+
+	case "Sampling.log_adaptive_sampling_target":
+		if e.complexity.Sampling.LogAdaptiveSamplingTarget == nil {
+			break
+		}
+
+		return e.complexity.Sampling.LogAdaptiveSamplingTarget(childComplexity), true
+
 	case "Sampling.log_exclusion_query":
 		if e.complexity.Sampling.LogExclusionQuery == nil {
 			break
@@ -9180,6 +9557,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.LogMinuteRateLimit(childComplexity), true
 
+	case "Sampling.log_minute_rate_limit_burst":
+		if e.complexity.Sampling.LogMinuteRateLimitBurst == nil {
+			break
+		}
+
+		return e.complexity.Sampling.LogMinuteRateLimitBurst(childComplexity), true
+
+	case "Sampling.log_minute_rate_limit_key":
+		if e.complexity.Sampling.LogMinuteRateLimitKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.LogMinuteRateLimitKey(childComplexity), true
+
 	case "Sampling.log_sampling_rate":
 		if e.complexity.Sampling.LogSamplingRate == nil {
 			break
@@ -9201,6 +9592,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.SessionMinuteRateLimit(childComplexity), true
 
+	case "Sampling.session_minute_rate_limit_burst":
+		if e.complexity.Sampling.SessionMinuteRateLimitBurst == nil {
+			break
+		}
+
+		return e.complexity.Sampling.SessionMinuteRateLimitBurst(childComplexity), true
+
+	case "Sampling.session_minute_rate_limit_key":
+		if e.complexity.Sampling.SessionMinuteRateLimitKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.SessionMinuteRateLimitKey(childComplexity), true
+
 	case "Sampling.session_sampling_rate":
 		if e.complexity.Sampling.SessionSamplingRate == nil {
 			break
@@ -9208,6 +9613,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.SessionSamplingRate(childComplexity), true
 
+	case "Sampling.trace_adaptive_sampling_key":
+		if e.complexity.Sampling.TraceAdaptiveSamplingKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceAdaptiveSamplingKey(childComplexity), true
+
+	case "Sampling.trace_adaptive_sampling_target":
+		if e.complexity.Sampling.TraceAdaptiveSamplingTarget == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceAdaptiveSamplingTarget(childComplexity), true
+
 	case "Sampling.trace_exclusion_query":
 		if e.complexity.Sampling.TraceExclusionQuery == nil {
 			break
@@ -9222,6 +9641,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.TraceMinuteRateLimit(childComplexity), true
 
+	case "Sampling.trace_minute_rate_limit_burst":
+		if e.complexity.Sampling.TraceMinuteRateLimitBurst == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceMinuteRateLimitBurst(childComplexity), true
+
+	case "Sampling.trace_minute_rate_limit_key":
+		if e.complexity.Sampling.TraceMinuteRateLimitKey == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceMinuteRateLimitKey(childComplexity), true
+
 	case "Sampling.trace_sampling_rate":
 		if e.complexity.Sampling.TraceSamplingRate == nil {
 			break
@@ -9229,6 +9662,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Sampling.TraceSamplingRate(childComplexity), true
 
+	case "Sampling.trace_tail_sampling":
+		if e.complexity.Sampling.TraceTailSampling == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceTailSampling(childComplexity), true
+
+	case "Sampling.trace_tail_sampling_min_duration_ms":
+		if e.complexity.Sampling.TraceTailSamplingMinDurationMs == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceTailSamplingMinDurationMs(childComplexity), true
+
+	case "Sampling.trace_tail_sampling_query":
+		if e.complexity.Sampling.TraceTailSamplingQuery == nil {
+			break
+		}
+
+		return e.complexity.Sampling.TraceTailSamplingQuery(childComplexity), true
+
 	case "SanitizedAdmin.email":
 		if e.complexity.SanitizedAdmin.Email == nil {
 			break
@@ -11570,10 +12024,13 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputIntegrationProjectMappingInput,
 		ec.unmarshalInputLengthRangeInput,
 		ec.unmarshalInputLogAlertInput,
+		ec.unmarshalInputLogPipelineStageInput,
+		ec.unmarshalInputLogSeverityMappingInput,
 		ec.unmarshalInputMetricTagFilterInput,
 		ec.unmarshalInputMicrosoftTeamsChannelInput,
 		ec.unmarshalInputNetworkHistogramParamsInput,
 		ec.unmarshalInputQueryInput,
+		ec.unmarshalInputRedactionRuleInput,
 		ec.unmarshalInputSamplingInput,
 		ec.unmarshalInputSanitizedAdminInput,
 		ec.unmarshalInputSanitizedSlackChannelInput,
@@ -11867,6 +12324,11 @@ type UsageHistory {
 	usage: MetricsBuckets!
 }
 
+type IngestDrop {
+	reason: IngestReason!
+	count: UInt64!
+}
+
 type Invoice {
 	amountDue: Int64
 	amountPaid: Int64
@@ -11934,6 +12396,7 @@ enum ProductType {
 	Traces
 	Metrics
 	Events
+	IngestDrops
 }
 
 enum SuggestionType {
@@ -11946,6 +12409,7 @@ enum IngestReason {
 	Sample
 	Rate
 	Filter
+	Quota
 }
 
 enum SubscriptionInterval {
@@ -12107,10 +12571,25 @@ type Sampling {
 	error_minute_rate_limit: Int64
 	log_minute_rate_limit: Int64
 	trace_minute_rate_limit: Int64
+	session_minute_rate_limit_burst: Int64
+	error_minute_rate_limit_burst: Int64
+	log_minute_rate_limit_burst: Int64
+	trace_minute_rate_limit_burst: Int64
+	session_minute_rate_limit_key: String
+	error_minute_rate_limit_key: String
+	log_minute_rate_limit_key: String
+	trace_minute_rate_limit_key: String
 	session_exclusion_query: String
 	error_exclusion_query: String
 	log_exclusion_query: String
 	trace_exclusion_query: String
+	trace_tail_sampling: Boolean!
+	trace_tail_sampling_min_duration_ms: Int64
+	trace_tail_sampling_query: String
+	log_adaptive_sampling_target: Int64
+	log_adaptive_sampling_key: String
+	trace_adaptive_sampling_target: Int64
+	trace_adaptive_sampling_key: String
 }
 
 input SamplingInput {
@@ -12122,10 +12601,119 @@ input SamplingInput {
 	error_minute_rate_limit: Int64
 	log_minute_rate_limit: Int64
 	trace_minute_rate_limit: Int64
+	session_minute_rate_limit_burst: Int64
+	error_minute_rate_limit_burst: Int64
+	log_minute_rate_limit_burst: Int64
+	trace_minute_rate_limit_burst: Int64
+	session_minute_rate_limit_key: String
+	error_minute_rate_limit_key: String
+	log_minute_rate_limit_key: String
+	trace_minute_rate_limit_key: String
 	session_exclusion_query: String
 	error_exclusion_query: String
 	log_exclusion_query: String
 	trace_exclusion_query: String
+	trace_tail_sampling: Boolean
+	trace_tail_sampling_min_duration_ms: Int64
+	trace_tail_sampling_query: String
+	log_adaptive_sampling_target: Int64
+	log_adaptive_sampling_key: String
+	trace_adaptive_sampling_target: Int64
+	trace_adaptive_sampling_key: String
+}
+
+type IngestPreviewError {
+	event: String!
+	type: String!
+	source: String!
+	stack_trace: String!
+	timestamp: Timestamp!
+	service_name: String!
+	environment: String!
+	trace_id: String
+	span_id: String
+}
+
+type IngestPreviewItem {
+	product: ProductType!
+	log: Log
+	trace: Trace
+	error: IngestPreviewError
+	ingested: Boolean!
+	reason: IngestReason
+	exclusion_query: String
+	quota_exceeded: Boolean!
+	dropped_by_pipeline: Boolean!
+	tail_sampled: Boolean!
+}
+
+enum LogPipelineStageType {
+	Parse
+	ParseJSON
+	Rename
+	Copy
+	Drop
+	DropLog
+	Severity
+	Route
+}
+
+type LogSeverityMapping {
+	from: String!
+	level: LogLevel!
+}
+
+input LogSeverityMappingInput {
+	from: String!
+	level: LogLevel!
+}
+
+type LogPipelineStage {
+	type: LogPipelineStageType!
+	query: String!
+	pattern: String!
+	source: String!
+	target: String!
+	level: LogLevel
+	severity_mapping: [LogSeverityMapping!]
+	project_id: ID
+}
+
+input LogPipelineStageInput {
+	type: LogPipelineStageType!
+	query: String
+	pattern: String
+	source: String
+	target: String
+	level: LogLevel
+	severity_mapping: [LogSeverityMappingInput!]
+	project_id: ID
+}
+
+enum RedactionRuleType {
+	Email
+	CreditCard
+	JWT
+	AWSKey
+	Regex
+	AttributeKey
+}
+
+enum RedactionMode {
+	Mask
+	Hash
+}
+
+type RedactionRule {
+	type: RedactionRuleType!
+	pattern: String!
+	mode: RedactionMode!
+}
+
+input RedactionRuleInput {
+	type: RedactionRuleType!
+	pattern: String
+	mode: RedactionMode!
 }
 
 type SocialLink {
@@ -12252,6 +12840,8 @@ type AllProjectSettings {
 	filterSessionsWithoutError: Boolean!
 	autoResolveStaleErrorsDayInterval: Int!
 	sampling: Sampling!
+	log_pipeline: [LogPipelineStage!]!
+	redaction_rules: [RedactionRule!]!
 }
 
 type AllWorkspaceSettings {
@@ -12840,6 +13430,8 @@ enum MetricAggregator {
 	P99
 	Max
 	Sum
+	Rate
+	Increase
 	None
 }
 
@@ -13948,6 +14540,11 @@ type Query {
 		product_type: ProductType!
 		date_range: DateRangeRequiredInput
 	): UsageHistory!
+	ingestDrops(
+		workspace_id: ID!
+		product_type: ProductType!
+		date_range: DateRangeRequiredInput!
+	): [IngestDrop!]!
 	# gets all the projects of a user
 	field_suggestion(project_id: ID!, name: String!, query: String!): [Field]
 	property_suggestion(project_id: ID!, query: String!, type: String!): [Field]
@@ -14023,6 +14620,11 @@ type Query {
 	github_issue_labels(workspace_id: ID!, repository: String!): [String!]!
 	project(id: ID!): Project
 	projectSettings(projectId: ID!): AllProjectSettings
+	ingest_preview(
+		project_id: ID!
+		payload: String!
+		sampling: SamplingInput
+	): [IngestPreviewItem!]!
 	workspace(id: ID!): Workspace
 	workspace_for_invite_link(secret: String!): WorkspaceForInviteLink!
 	workspace_invite_links(workspace_id: ID!): WorkspaceInviteLink!
@@ -14330,6 +14932,8 @@ type Mutation {
 		filterSessionsWithoutError: Boolean
 		autoResolveStaleErrorsDayInterval: Int
 		sampling: SamplingInput
+		log_pipeline: [LogPipelineStageInput!]
+		redaction_rules: [RedactionRuleInput!]
 	): AllProjectSettings
 	editWorkspace(id: ID!, name: String): Workspace
 	editWorkspaceSettings(
@@ -16547,6 +17151,24 @@ func (ec *executionContext) field_Mutation_editProjectSettings_args(ctx context.
 		}
 	}
 	args["sampling"] = arg12
+	var arg13 []*model.LogPipelineStageInput
+	if tmp, ok := rawArgs["log_pipeline"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("log_pipeline"))
+		arg13, err = ec.unmarshalOLogPipelineStageInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageInputᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["log_pipeline"] = arg13
+	var arg14 []*model.RedactionRuleInput
+	if tmp, ok := rawArgs["redaction_rules"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("redaction_rules"))
+		arg14, err = ec.unmarshalORedactionRuleInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleInputᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["redaction_rules"] = arg14
 	return args, nil
 }
 
@@ -20396,6 +21018,72 @@ func (ec *executionContext) field_Query_identifier_suggestion_args(ctx context.C
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_ingestDrops_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["workspace_id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("workspace_id"))
+		arg0, err = ec.unmarshalNID2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["workspace_id"] = arg0
+	var arg1 model.ProductType
+	if tmp, ok := rawArgs["product_type"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("product_type"))
+		arg1, err = ec.unmarshalNProductType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐProductType(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["product_type"] = arg1
+	var arg2 model.DateRangeRequiredInput
+	if tmp, ok := rawArgs["date_range"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date_range"))
+		arg2, err = ec.unmarshalNDateRangeRequiredInput2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐDateRangeRequiredInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["date_range"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_ingest_preview_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["project_id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("project_id"))
+		arg0, err = ec.unmarshalNID2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["project_id"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["payload"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("payload"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["payload"] = arg1
+	var arg2 *model.SamplingInput
+	if tmp, ok := rawArgs["sampling"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sampling"))
+		arg2, err = ec.unmarshalOSamplingInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐSamplingInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sampling"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_integration_project_mappings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -27209,6 +27897,22 @@ func (ec *executionContext) fieldContext_AllProjectSettings_sampling(ctx context
 				return ec.fieldContext_Sampling_log_minute_rate_limit(ctx, field)
 			case "trace_minute_rate_limit":
 				return ec.fieldContext_Sampling_trace_minute_rate_limit(ctx, field)
+			case "session_minute_rate_limit_burst":
+				return ec.fieldContext_Sampling_session_minute_rate_limit_burst(ctx, field)
+			case "error_minute_rate_limit_burst":
+				return ec.fieldContext_Sampling_error_minute_rate_limit_burst(ctx, field)
+			case "log_minute_rate_limit_burst":
+				return ec.fieldContext_Sampling_log_minute_rate_limit_burst(ctx, field)
+			case "trace_minute_rate_limit_burst":
+				return ec.fieldContext_Sampling_trace_minute_rate_limit_burst(ctx, field)
+			case "session_minute_rate_limit_key":
+				return ec.fieldContext_Sampling_session_minute_rate_limit_key(ctx, field)
+			case "error_minute_rate_limit_key":
+				return ec.fieldContext_Sampling_error_minute_rate_limit_key(ctx, field)
+			case "log_minute_rate_limit_key":
+				return ec.fieldContext_Sampling_log_minute_rate_limit_key(ctx, field)
+			case "trace_minute_rate_limit_key":
+				return ec.fieldContext_Sampling_trace_minute_rate_limit_key(ctx, field)
 			case "session_exclusion_query":
 				return ec.fieldContext_Sampling_session_exclusion_query(ctx, field)
 			case "error_exclusion_query":
@@ -27217,6 +27921,20 @@ func (ec *executionContext) fieldContext_AllProjectSettings_sampling(ctx context
 				return ec.fieldContext_Sampling_log_exclusion_query(ctx, field)
 			case "trace_exclusion_query":
 				return ec.fieldContext_Sampling_trace_exclusion_query(ctx, field)
+			case "trace_tail_sampling":
+				return ec.fieldContext_Sampling_trace_tail_sampling(ctx, field)
+			case "trace_tail_sampling_min_duration_ms":
+				return ec.fieldContext_Sampling_trace_tail_sampling_min_duration_ms(ctx, field)
+			case "trace_tail_sampling_query":
+				return ec.fieldContext_Sampling_trace_tail_sampling_query(ctx, field)
+			case "log_adaptive_sampling_target":
+				return ec.fieldContext_Sampling_log_adaptive_sampling_target(ctx, field)
+			case "log_adaptive_sampling_key":
+				return ec.fieldContext_Sampling_log_adaptive_sampling_key(ctx, field)
+			case "trace_adaptive_sampling_target":
+				return ec.fieldContext_Sampling_trace_adaptive_sampling_target(ctx, field)
+			case "trace_adaptive_sampling_key":
+				return ec.fieldContext_Sampling_trace_adaptive_sampling_key(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Sampling", field.Name)
 		},
@@ -27224,6 +27942,120 @@ func (ec *executionContext) fieldContext_AllProjectSettings_sampling(ctx context
 	return fc, nil
 }
 
+func (ec *executionContext) _AllProjectSettings_log_pipeline(ctx context.Context, field graphql.CollectedField, obj *model.AllProjectSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AllProjectSettings_log_pipeline(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogPipeline, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.LogPipelineStage)
+	fc.Result = res
+	return ec.marshalNLogPipelineStage2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AllProjectSettings_log_pipeline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AllProjectSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_LogPipelineStage_type(ctx, field)
+			case "query":
+				return ec.fieldContext_LogPipelineStage_query(ctx, field)
+			case "pattern":
+				return ec.fieldContext_LogPipelineStage_pattern(ctx, field)
+			case "source":
+				return ec.fieldContext_LogPipelineStage_source(ctx, field)
+			case "target":
+				return ec.fieldContext_LogPipelineStage_target(ctx, field)
+			case "level":
+				return ec.fieldContext_LogPipelineStage_level(ctx, field)
+			case "severity_mapping":
+				return ec.fieldContext_LogPipelineStage_severity_mapping(ctx, field)
+			case "project_id":
+				return ec.fieldContext_LogPipelineStage_project_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LogPipelineStage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AllProjectSettings_redaction_rules(ctx context.Context, field graphql.CollectedField, obj *model.AllProjectSettings) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AllProjectSettings_redaction_rules(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RedactionRules, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RedactionRule)
+	fc.Result = res
+	return ec.marshalNRedactionRule2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AllProjectSettings_redaction_rules(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AllProjectSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_RedactionRule_type(ctx, field)
+			case "pattern":
+				return ec.fieldContext_RedactionRule_pattern(ctx, field)
+			case "mode":
+				return ec.fieldContext_RedactionRule_mode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RedactionRule", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _AllWorkspaceSettings_workspace_id(ctx context.Context, field graphql.CollectedField, obj *model1.AllWorkspaceSettings) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AllWorkspaceSettings_workspace_id(ctx, field)
 	if err != nil {
@@ -40449,8 +41281,8 @@ func (ec *executionContext) fieldContext_HistogramBucket_count(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrationProjectMapping_project_id(ctx context.Context, field graphql.CollectedField, obj *model1.IntegrationProjectMapping) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrationProjectMapping_project_id(ctx, field)
+func (ec *executionContext) _IngestDrop_reason(ctx context.Context, field graphql.CollectedField, obj *model.IngestDrop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestDrop_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40463,7 +41295,7 @@ func (ec *executionContext) _IntegrationProjectMapping_project_id(ctx context.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProjectID, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40475,26 +41307,26 @@ func (ec *executionContext) _IntegrationProjectMapping_project_id(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.IngestReason)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNIngestReason2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrationProjectMapping_project_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestDrop_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrationProjectMapping",
+		Object:     "IngestDrop",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type IngestReason does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrationProjectMapping_external_id(ctx context.Context, field graphql.CollectedField, obj *model1.IntegrationProjectMapping) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrationProjectMapping_external_id(ctx, field)
+func (ec *executionContext) _IngestDrop_count(ctx context.Context, field graphql.CollectedField, obj *model.IngestDrop) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestDrop_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40507,7 +41339,7 @@ func (ec *executionContext) _IntegrationProjectMapping_external_id(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ExternalID, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40519,26 +41351,26 @@ func (ec *executionContext) _IntegrationProjectMapping_external_id(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNUInt642uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrationProjectMapping_external_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestDrop_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrationProjectMapping",
+		Object:     "IngestDrop",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UInt64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrationStatus_integrated(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrationStatus_integrated(ctx, field)
+func (ec *executionContext) _IngestPreviewError_event(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_event(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40551,7 +41383,7 @@ func (ec *executionContext) _IntegrationStatus_integrated(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Integrated, nil
+		return obj.Event, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40563,26 +41395,26 @@ func (ec *executionContext) _IntegrationStatus_integrated(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrationStatus_integrated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_event(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrationStatus",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrationStatus_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrationStatus_resourceType(ctx, field)
+func (ec *executionContext) _IngestPreviewError_type(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40595,7 +41427,7 @@ func (ec *executionContext) _IntegrationStatus_resourceType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ResourceType, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40612,9 +41444,9 @@ func (ec *executionContext) _IntegrationStatus_resourceType(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrationStatus_resourceType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrationStatus",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -40625,8 +41457,8 @@ func (ec *executionContext) fieldContext_IntegrationStatus_resourceType(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _IntegrationStatus_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IntegrationStatus_createdAt(ctx, field)
+func (ec *executionContext) _IngestPreviewError_source(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_source(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40639,35 +41471,38 @@ func (ec *executionContext) _IntegrationStatus_createdAt(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IntegrationStatus_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IntegrationStatus",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Timestamp does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_amountDue(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_amountDue(ctx, field)
+func (ec *executionContext) _IngestPreviewError_stack_trace(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_stack_trace(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40680,35 +41515,38 @@ func (ec *executionContext) _Invoice_amountDue(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AmountDue, nil
+		return obj.StackTrace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_amountDue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_stack_trace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int64 does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_amountPaid(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_amountPaid(ctx, field)
+func (ec *executionContext) _IngestPreviewError_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40721,35 +41559,38 @@ func (ec *executionContext) _Invoice_amountPaid(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AmountPaid, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int64)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_amountPaid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int64 does not have child fields")
+			return nil, errors.New("field of type Timestamp does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_attemptCount(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_attemptCount(ctx, field)
+func (ec *executionContext) _IngestPreviewError_service_name(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_service_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40762,35 +41603,38 @@ func (ec *executionContext) _Invoice_attemptCount(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AttemptCount, nil
+		return obj.ServiceName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_attemptCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_service_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int64 does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_date(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_date(ctx, field)
+func (ec *executionContext) _IngestPreviewError_environment(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_environment(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40803,35 +41647,38 @@ func (ec *executionContext) _Invoice_date(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
+		return obj.Environment, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_environment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Timestamp does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_url(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_url(ctx, field)
+func (ec *executionContext) _IngestPreviewError_trace_id(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_trace_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40844,7 +41691,7 @@ func (ec *executionContext) _Invoice_url(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.URL, nil
+		return obj.TraceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40858,9 +41705,9 @@ func (ec *executionContext) _Invoice_url(ctx context.Context, field graphql.Coll
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_trace_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -40871,8 +41718,8 @@ func (ec *executionContext) fieldContext_Invoice_url(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Invoice_status(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Invoice_status(ctx, field)
+func (ec *executionContext) _IngestPreviewError_span_id(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewError) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewError_span_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40885,7 +41732,7 @@ func (ec *executionContext) _Invoice_status(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.SpanID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40899,9 +41746,9 @@ func (ec *executionContext) _Invoice_status(ctx context.Context, field graphql.C
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Invoice_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewError_span_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Invoice",
+		Object:     "IngestPreviewError",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -40912,8 +41759,8 @@ func (ec *executionContext) fieldContext_Invoice_status(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _IssuesSearchResult_id(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssuesSearchResult_id(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_product(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_product(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40926,7 +41773,7 @@ func (ec *executionContext) _IssuesSearchResult_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Product, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -40938,26 +41785,26 @@ func (ec *executionContext) _IssuesSearchResult_id(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.ProductType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNProductType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐProductType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssuesSearchResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_product(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssuesSearchResult",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ProductType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssuesSearchResult_title(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssuesSearchResult_title(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_log(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_log(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -40970,38 +41817,61 @@ func (ec *executionContext) _IssuesSearchResult_title(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Title, nil
+		return obj.Log, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Log)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOLog2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLog(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssuesSearchResult_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_log(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssuesSearchResult",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "projectID":
+				return ec.fieldContext_Log_projectID(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Log_timestamp(ctx, field)
+			case "level":
+				return ec.fieldContext_Log_level(ctx, field)
+			case "message":
+				return ec.fieldContext_Log_message(ctx, field)
+			case "logAttributes":
+				return ec.fieldContext_Log_logAttributes(ctx, field)
+			case "traceID":
+				return ec.fieldContext_Log_traceID(ctx, field)
+			case "spanID":
+				return ec.fieldContext_Log_spanID(ctx, field)
+			case "secureSessionID":
+				return ec.fieldContext_Log_secureSessionID(ctx, field)
+			case "source":
+				return ec.fieldContext_Log_source(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Log_serviceName(ctx, field)
+			case "serviceVersion":
+				return ec.fieldContext_Log_serviceVersion(ctx, field)
+			case "environment":
+				return ec.fieldContext_Log_environment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Log", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _IssuesSearchResult_issue_url(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_IssuesSearchResult_issue_url(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_trace(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_trace(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41014,38 +41884,77 @@ func (ec *executionContext) _IssuesSearchResult_issue_url(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IssueURL, nil
+		return obj.Trace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Trace)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTrace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐTrace(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_IssuesSearchResult_issue_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_trace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "IssuesSearchResult",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_Trace_timestamp(ctx, field)
+			case "traceID":
+				return ec.fieldContext_Trace_traceID(ctx, field)
+			case "spanID":
+				return ec.fieldContext_Trace_spanID(ctx, field)
+			case "parentSpanID":
+				return ec.fieldContext_Trace_parentSpanID(ctx, field)
+			case "projectID":
+				return ec.fieldContext_Trace_projectID(ctx, field)
+			case "secureSessionID":
+				return ec.fieldContext_Trace_secureSessionID(ctx, field)
+			case "traceState":
+				return ec.fieldContext_Trace_traceState(ctx, field)
+			case "spanName":
+				return ec.fieldContext_Trace_spanName(ctx, field)
+			case "spanKind":
+				return ec.fieldContext_Trace_spanKind(ctx, field)
+			case "duration":
+				return ec.fieldContext_Trace_duration(ctx, field)
+			case "startTime":
+				return ec.fieldContext_Trace_startTime(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Trace_serviceName(ctx, field)
+			case "serviceVersion":
+				return ec.fieldContext_Trace_serviceVersion(ctx, field)
+			case "environment":
+				return ec.fieldContext_Trace_environment(ctx, field)
+			case "hasErrors":
+				return ec.fieldContext_Trace_hasErrors(ctx, field)
+			case "traceAttributes":
+				return ec.fieldContext_Trace_traceAttributes(ctx, field)
+			case "statusCode":
+				return ec.fieldContext_Trace_statusCode(ctx, field)
+			case "statusMessage":
+				return ec.fieldContext_Trace_statusMessage(ctx, field)
+			case "events":
+				return ec.fieldContext_Trace_events(ctx, field)
+			case "links":
+				return ec.fieldContext_Trace_links(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trace", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_self(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_self(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_error(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41058,38 +41967,55 @@ func (ec *executionContext) _JiraIssueType_self(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Self, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.IngestPreviewError)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOIngestPreviewError2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_self(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "event":
+				return ec.fieldContext_IngestPreviewError_event(ctx, field)
+			case "type":
+				return ec.fieldContext_IngestPreviewError_type(ctx, field)
+			case "source":
+				return ec.fieldContext_IngestPreviewError_source(ctx, field)
+			case "stack_trace":
+				return ec.fieldContext_IngestPreviewError_stack_trace(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_IngestPreviewError_timestamp(ctx, field)
+			case "service_name":
+				return ec.fieldContext_IngestPreviewError_service_name(ctx, field)
+			case "environment":
+				return ec.fieldContext_IngestPreviewError_environment(ctx, field)
+			case "trace_id":
+				return ec.fieldContext_IngestPreviewError_trace_id(ctx, field)
+			case "span_id":
+				return ec.fieldContext_IngestPreviewError_span_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IngestPreviewError", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_id(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_ingested(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_ingested(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41102,7 +42028,7 @@ func (ec *executionContext) _JiraIssueType_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Ingested, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41114,26 +42040,26 @@ func (ec *executionContext) _JiraIssueType_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_ingested(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_description(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_description(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_reason(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_reason(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41146,38 +42072,35 @@ func (ec *executionContext) _JiraIssueType_description(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Reason, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.IngestReason)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOIngestReason2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type IngestReason does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_iconUrl(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_iconUrl(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_exclusion_query(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_exclusion_query(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41190,26 +42113,23 @@ func (ec *executionContext) _JiraIssueType_iconUrl(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IconURL, nil
+		return obj.ExclusionQuery, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_iconUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_exclusion_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41220,8 +42140,8 @@ func (ec *executionContext) fieldContext_JiraIssueType_iconUrl(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_name(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_quota_exceeded(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_quota_exceeded(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41234,7 +42154,7 @@ func (ec *executionContext) _JiraIssueType_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.QuotaExceeded, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41246,26 +42166,26 @@ func (ec *executionContext) _JiraIssueType_name(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_quota_exceeded(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_untranslatedName(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_untranslatedName(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_dropped_by_pipeline(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_dropped_by_pipeline(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41278,7 +42198,7 @@ func (ec *executionContext) _JiraIssueType_untranslatedName(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UntranslatedName, nil
+		return obj.DroppedByPipeline, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41290,26 +42210,26 @@ func (ec *executionContext) _JiraIssueType_untranslatedName(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_untranslatedName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_dropped_by_pipeline(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_subtask(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_subtask(ctx, field)
+func (ec *executionContext) _IngestPreviewItem_tail_sampled(ctx context.Context, field graphql.CollectedField, obj *model.IngestPreviewItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IngestPreviewItem_tail_sampled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41322,7 +42242,7 @@ func (ec *executionContext) _JiraIssueType_subtask(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Subtask, nil
+		return obj.TailSampled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41339,9 +42259,9 @@ func (ec *executionContext) _JiraIssueType_subtask(ctx context.Context, field gr
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_subtask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IngestPreviewItem_tail_sampled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IngestPreviewItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41352,8 +42272,8 @@ func (ec *executionContext) fieldContext_JiraIssueType_subtask(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueType_scope(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueType_scope(ctx, field)
+func (ec *executionContext) _IntegrationProjectMapping_project_id(ctx context.Context, field graphql.CollectedField, obj *model1.IntegrationProjectMapping) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrationProjectMapping_project_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41366,41 +42286,38 @@ func (ec *executionContext) _JiraIssueType_scope(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Scope, nil
+		return obj.ProjectID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.JiraIssueTypeScope)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOJiraIssueTypeScope2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueTypeScope(ctx, field.Selections, res)
+	return ec.marshalNID2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueType_scope(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IntegrationProjectMapping_project_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueType",
+		Object:     "IntegrationProjectMapping",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "type":
-				return ec.fieldContext_JiraIssueTypeScope_type(ctx, field)
-			case "project":
-				return ec.fieldContext_JiraIssueTypeScope_project(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraIssueTypeScope", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueTypeScope_type(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueTypeScope) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueTypeScope_type(ctx, field)
+func (ec *executionContext) _IntegrationProjectMapping_external_id(ctx context.Context, field graphql.CollectedField, obj *model1.IntegrationProjectMapping) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrationProjectMapping_external_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41413,7 +42330,7 @@ func (ec *executionContext) _JiraIssueTypeScope_type(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.ExternalID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41430,9 +42347,9 @@ func (ec *executionContext) _JiraIssueTypeScope_type(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueTypeScope_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IntegrationProjectMapping_external_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueTypeScope",
+		Object:     "IntegrationProjectMapping",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41443,8 +42360,8 @@ func (ec *executionContext) fieldContext_JiraIssueTypeScope_type(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraIssueTypeScope_project(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueTypeScope) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraIssueTypeScope_project(ctx, field)
+func (ec *executionContext) _IntegrationStatus_integrated(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrationStatus_integrated(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41457,39 +42374,38 @@ func (ec *executionContext) _JiraIssueTypeScope_project(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Project, nil
+		return obj.Integrated, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res := resTmp.(*model.JiraProjectIdentifier)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOJiraProjectIdentifier2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectIdentifier(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraIssueTypeScope_project(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IntegrationStatus_integrated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraIssueTypeScope",
+		Object:     "IntegrationStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_JiraProjectIdentifier_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraProjectIdentifier", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProject_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProject_name(ctx, field)
+func (ec *executionContext) _IntegrationStatus_resourceType(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrationStatus_resourceType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41502,7 +42418,7 @@ func (ec *executionContext) _JiraProject_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ResourceType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41519,9 +42435,9 @@ func (ec *executionContext) _JiraProject_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProject_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IntegrationStatus_resourceType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProject",
+		Object:     "IntegrationStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41532,8 +42448,8 @@ func (ec *executionContext) fieldContext_JiraProject_name(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProject_key(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProject_key(ctx, field)
+func (ec *executionContext) _IntegrationStatus_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.IntegrationStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IntegrationStatus_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41546,38 +42462,35 @@ func (ec *executionContext) _JiraProject_key(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProject_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IntegrationStatus_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProject",
+		Object:     "IntegrationStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Timestamp does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProject_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProject_id(ctx, field)
+func (ec *executionContext) _Invoice_amountDue(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_amountDue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41590,38 +42503,35 @@ func (ec *executionContext) _JiraProject_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.AmountDue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProject_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_amountDue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProject",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProject_self(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProject_self(ctx, field)
+func (ec *executionContext) _Invoice_amountPaid(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_amountPaid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41634,38 +42544,35 @@ func (ec *executionContext) _JiraProject_self(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Self, nil
+		return obj.AmountPaid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProject_self(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_amountPaid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProject",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProject_issueTypes(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProject_issueTypes(ctx, field)
+func (ec *executionContext) _Invoice_attemptCount(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_attemptCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41678,7 +42585,7 @@ func (ec *executionContext) _JiraProject_issueTypes(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IssueTypes, nil
+		return obj.AttemptCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41687,44 +42594,26 @@ func (ec *executionContext) _JiraProject_issueTypes(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.JiraIssueType)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProject_issueTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_attemptCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProject",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "self":
-				return ec.fieldContext_JiraIssueType_self(ctx, field)
-			case "id":
-				return ec.fieldContext_JiraIssueType_id(ctx, field)
-			case "description":
-				return ec.fieldContext_JiraIssueType_description(ctx, field)
-			case "iconUrl":
-				return ec.fieldContext_JiraIssueType_iconUrl(ctx, field)
-			case "name":
-				return ec.fieldContext_JiraIssueType_name(ctx, field)
-			case "untranslatedName":
-				return ec.fieldContext_JiraIssueType_untranslatedName(ctx, field)
-			case "subtask":
-				return ec.fieldContext_JiraIssueType_subtask(ctx, field)
-			case "scope":
-				return ec.fieldContext_JiraIssueType_scope(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type JiraIssueType", field.Name)
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraProjectIdentifier_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraProjectIdentifier) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraProjectIdentifier_id(ctx, field)
+func (ec *executionContext) _Invoice_date(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_date(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41737,38 +42626,35 @@ func (ec *executionContext) _JiraProjectIdentifier_id(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Date, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTimestamp2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraProjectIdentifier_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_date(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraProjectIdentifier",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Timestamp does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraTeam_team_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraTeam_team_id(ctx, field)
+func (ec *executionContext) _Invoice_url(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41781,26 +42667,23 @@ func (ec *executionContext) _JiraTeam_team_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TeamID, nil
+		return obj.URL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraTeam_team_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraTeam",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41811,8 +42694,8 @@ func (ec *executionContext) fieldContext_JiraTeam_team_id(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraTeam_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraTeam_name(ctx, field)
+func (ec *executionContext) _Invoice_status(ctx context.Context, field graphql.CollectedField, obj *model.Invoice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Invoice_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41825,26 +42708,23 @@ func (ec *executionContext) _JiraTeam_name(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraTeam_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Invoice_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraTeam",
+		Object:     "Invoice",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41855,8 +42735,8 @@ func (ec *executionContext) fieldContext_JiraTeam_name(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _JiraTeam_key(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_JiraTeam_key(ctx, field)
+func (ec *executionContext) _IssuesSearchResult_id(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssuesSearchResult_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41869,7 +42749,7 @@ func (ec *executionContext) _JiraTeam_key(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -41886,9 +42766,9 @@ func (ec *executionContext) _JiraTeam_key(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_JiraTeam_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssuesSearchResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "JiraTeam",
+		Object:     "IssuesSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -41899,8 +42779,8 @@ func (ec *executionContext) fieldContext_JiraTeam_key(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _LengthRange_min(ctx context.Context, field graphql.CollectedField, obj *model1.LengthRange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LengthRange_min(ctx, field)
+func (ec *executionContext) _IssuesSearchResult_title(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssuesSearchResult_title(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41913,35 +42793,38 @@ func (ec *executionContext) _LengthRange_min(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Min, nil
+		return obj.Title, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LengthRange_min(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssuesSearchResult_title(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LengthRange",
+		Object:     "IssuesSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LengthRange_max(ctx context.Context, field graphql.CollectedField, obj *model1.LengthRange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LengthRange_max(ctx, field)
+func (ec *executionContext) _IssuesSearchResult_issue_url(ctx context.Context, field graphql.CollectedField, obj *model.IssuesSearchResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IssuesSearchResult_issue_url(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41954,35 +42837,38 @@ func (ec *executionContext) _LengthRange_max(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Max, nil
+		return obj.IssueURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LengthRange_max(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IssuesSearchResult_issue_url(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LengthRange",
+		Object:     "IssuesSearchResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LinearTeam_team_id(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LinearTeam_team_id(ctx, field)
+func (ec *executionContext) _JiraIssueType_self(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_self(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -41995,7 +42881,7 @@ func (ec *executionContext) _LinearTeam_team_id(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TeamID, nil
+		return obj.Self, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42012,9 +42898,9 @@ func (ec *executionContext) _LinearTeam_team_id(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LinearTeam_team_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_self(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LinearTeam",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42025,8 +42911,8 @@ func (ec *executionContext) fieldContext_LinearTeam_team_id(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _LinearTeam_name(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LinearTeam_name(ctx, field)
+func (ec *executionContext) _JiraIssueType_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42039,7 +42925,7 @@ func (ec *executionContext) _LinearTeam_name(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42056,9 +42942,9 @@ func (ec *executionContext) _LinearTeam_name(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LinearTeam_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LinearTeam",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42069,8 +42955,8 @@ func (ec *executionContext) fieldContext_LinearTeam_name(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _LinearTeam_key(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LinearTeam_key(ctx, field)
+func (ec *executionContext) _JiraIssueType_description(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42083,7 +42969,7 @@ func (ec *executionContext) _LinearTeam_key(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42100,9 +42986,9 @@ func (ec *executionContext) _LinearTeam_key(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LinearTeam_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LinearTeam",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42113,8 +42999,8 @@ func (ec *executionContext) fieldContext_LinearTeam_key(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_projectID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_projectID(ctx, field)
+func (ec *executionContext) _JiraIssueType_iconUrl(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_iconUrl(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42127,7 +43013,7 @@ func (ec *executionContext) _Log_projectID(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProjectID, nil
+		return obj.IconURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42139,26 +43025,26 @@ func (ec *executionContext) _Log_projectID(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_projectID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_iconUrl(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_timestamp(ctx, field)
+func (ec *executionContext) _JiraIssueType_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42171,7 +43057,7 @@ func (ec *executionContext) _Log_timestamp(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42183,26 +43069,26 @@ func (ec *executionContext) _Log_timestamp(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Timestamp does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_level(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_level(ctx, field)
+func (ec *executionContext) _JiraIssueType_untranslatedName(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_untranslatedName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42215,7 +43101,7 @@ func (ec *executionContext) _Log_level(ctx context.Context, field graphql.Collec
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Level, nil
+		return obj.UntranslatedName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42227,26 +43113,26 @@ func (ec *executionContext) _Log_level(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.LogLevel)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLogLevel2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_untranslatedName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type LogLevel does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_message(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_message(ctx, field)
+func (ec *executionContext) _JiraIssueType_subtask(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_subtask(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42259,7 +43145,7 @@ func (ec *executionContext) _Log_message(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Subtask, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42271,26 +43157,26 @@ func (ec *executionContext) _Log_message(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_subtask(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_logAttributes(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_logAttributes(ctx, field)
+func (ec *executionContext) _JiraIssueType_scope(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueType_scope(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42303,38 +43189,41 @@ func (ec *executionContext) _Log_logAttributes(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LogAttributes, nil
+		return obj.Scope, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(*model.JiraIssueTypeScope)
 	fc.Result = res
-	return ec.marshalNMap2map(ctx, field.Selections, res)
+	return ec.marshalOJiraIssueTypeScope2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueTypeScope(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_logAttributes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueType_scope(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_JiraIssueTypeScope_type(ctx, field)
+			case "project":
+				return ec.fieldContext_JiraIssueTypeScope_project(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraIssueTypeScope", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_traceID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_traceID(ctx, field)
+func (ec *executionContext) _JiraIssueTypeScope_type(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueTypeScope) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueTypeScope_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42347,23 +43236,26 @@ func (ec *executionContext) _Log_traceID(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TraceID, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_traceID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueTypeScope_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueTypeScope",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42374,8 +43266,8 @@ func (ec *executionContext) fieldContext_Log_traceID(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_spanID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_spanID(ctx, field)
+func (ec *executionContext) _JiraIssueTypeScope_project(ctx context.Context, field graphql.CollectedField, obj *model.JiraIssueTypeScope) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraIssueTypeScope_project(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42388,7 +43280,7 @@ func (ec *executionContext) _Log_spanID(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpanID, nil
+		return obj.Project, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42397,26 +43289,30 @@ func (ec *executionContext) _Log_spanID(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.JiraProjectIdentifier)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOJiraProjectIdentifier2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectIdentifier(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_spanID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraIssueTypeScope_project(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraIssueTypeScope",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_JiraProjectIdentifier_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraProjectIdentifier", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_secureSessionID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_secureSessionID(ctx, field)
+func (ec *executionContext) _JiraProject_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProject_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42429,23 +43325,26 @@ func (ec *executionContext) _Log_secureSessionID(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecureSessionID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_secureSessionID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraProject_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42456,8 +43355,8 @@ func (ec *executionContext) fieldContext_Log_secureSessionID(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_source(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_source(ctx, field)
+func (ec *executionContext) _JiraProject_key(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProject_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42470,23 +43369,26 @@ func (ec *executionContext) _Log_source(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Source, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraProject_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42497,8 +43399,8 @@ func (ec *executionContext) fieldContext_Log_source(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_serviceName(ctx, field)
+func (ec *executionContext) _JiraProject_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProject_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42511,23 +43413,26 @@ func (ec *executionContext) _Log_serviceName(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceName, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_serviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraProject_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42538,8 +43443,8 @@ func (ec *executionContext) fieldContext_Log_serviceName(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_serviceVersion(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_serviceVersion(ctx, field)
+func (ec *executionContext) _JiraProject_self(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProject_self(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42552,23 +43457,26 @@ func (ec *executionContext) _Log_serviceVersion(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceVersion, nil
+		return obj.Self, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_serviceVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraProject_self(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -42579,8 +43487,8 @@ func (ec *executionContext) fieldContext_Log_serviceVersion(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Log_environment(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Log_environment(ctx, field)
+func (ec *executionContext) _JiraProject_issueTypes(ctx context.Context, field graphql.CollectedField, obj *model.JiraProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProject_issueTypes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42593,7 +43501,7 @@ func (ec *executionContext) _Log_environment(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Environment, nil
+		return obj.IssueTypes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42602,26 +43510,44 @@ func (ec *executionContext) _Log_environment(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.JiraIssueType)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Log_environment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_JiraProject_issueTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Log",
+		Object:     "JiraProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "self":
+				return ec.fieldContext_JiraIssueType_self(ctx, field)
+			case "id":
+				return ec.fieldContext_JiraIssueType_id(ctx, field)
+			case "description":
+				return ec.fieldContext_JiraIssueType_description(ctx, field)
+			case "iconUrl":
+				return ec.fieldContext_JiraIssueType_iconUrl(ctx, field)
+			case "name":
+				return ec.fieldContext_JiraIssueType_name(ctx, field)
+			case "untranslatedName":
+				return ec.fieldContext_JiraIssueType_untranslatedName(ctx, field)
+			case "subtask":
+				return ec.fieldContext_JiraIssueType_subtask(ctx, field)
+			case "scope":
+				return ec.fieldContext_JiraIssueType_scope(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type JiraIssueType", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_id(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_id(ctx, field)
+func (ec *executionContext) _JiraProjectIdentifier_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraProjectIdentifier) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraProjectIdentifier_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42646,26 +43572,1299 @@ func (ec *executionContext) _LogAlert_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JiraProjectIdentifier_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JiraProjectIdentifier",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JiraTeam_team_id(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraTeam_team_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JiraTeam_team_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JiraTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JiraTeam_name(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraTeam_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JiraTeam_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JiraTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _JiraTeam_key(ctx context.Context, field graphql.CollectedField, obj *model.JiraTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_JiraTeam_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_JiraTeam_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "JiraTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LengthRange_min(ctx context.Context, field graphql.CollectedField, obj *model1.LengthRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LengthRange_min(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Min, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LengthRange_min(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LengthRange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LengthRange_max(ctx context.Context, field graphql.CollectedField, obj *model1.LengthRange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LengthRange_max(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Max, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LengthRange_max(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LengthRange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LinearTeam_team_id(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LinearTeam_team_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LinearTeam_team_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LinearTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LinearTeam_name(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LinearTeam_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LinearTeam_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LinearTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LinearTeam_key(ctx context.Context, field graphql.CollectedField, obj *model.LinearTeam) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LinearTeam_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LinearTeam_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LinearTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_projectID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_projectID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ProjectID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_projectID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Timestamp does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_level(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_level(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Level, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.LogLevel)
+	fc.Result = res
+	return ec.marshalNLogLevel2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type LogLevel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_message(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_logAttributes(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_logAttributes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogAttributes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(map[string]interface{})
+	fc.Result = res
+	return ec.marshalNMap2map(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_logAttributes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Map does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_traceID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_traceID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_traceID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_spanID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_spanID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpanID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_spanID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_secureSessionID(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_secureSessionID(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SecureSessionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_secureSessionID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_source(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Source, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_serviceName(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_serviceName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServiceName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_serviceName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_serviceVersion(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_serviceVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServiceVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_serviceVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_environment(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_environment(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Environment, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_environment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_id(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_updated_at(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_updated_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Timestamp does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_Name(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_Name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_Name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_ChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_ChannelsToNotify(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogAlert().ChannelsToNotify(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SanitizedSlackChannel)
+	fc.Result = res
+	return ec.marshalNSanitizedSlackChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐSanitizedSlackChannelᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_ChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "webhook_channel":
+				return ec.fieldContext_SanitizedSlackChannel_webhook_channel(ctx, field)
+			case "webhook_channel_id":
+				return ec.fieldContext_SanitizedSlackChannel_webhook_channel_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SanitizedSlackChannel", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_DiscordChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_DiscordChannelsToNotify(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogAlert().DiscordChannelsToNotify(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.DiscordChannel)
+	fc.Result = res
+	return ec.marshalNDiscordChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐDiscordChannelᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_DiscordChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DiscordChannel_id(ctx, field)
+			case "name":
+				return ec.fieldContext_DiscordChannel_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DiscordChannel", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_MicrosoftTeamsChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_MicrosoftTeamsChannelsToNotify(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogAlert().MicrosoftTeamsChannelsToNotify(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.MicrosoftTeamsChannel)
+	fc.Result = res
+	return ec.marshalNMicrosoftTeamsChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐMicrosoftTeamsChannelᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_MicrosoftTeamsChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MicrosoftTeamsChannel_id(ctx, field)
+			case "name":
+				return ec.fieldContext_MicrosoftTeamsChannel_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MicrosoftTeamsChannel", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_WebhookDestinations(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_WebhookDestinations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogAlert().WebhookDestinations(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.WebhookDestination)
+	fc.Result = res
+	return ec.marshalNWebhookDestination2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐWebhookDestinationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_WebhookDestinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "url":
+				return ec.fieldContext_WebhookDestination_url(ctx, field)
+			case "authorization":
+				return ec.fieldContext_WebhookDestination_authorization(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WebhookDestination", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_EmailsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_EmailsToNotify(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.LogAlert().EmailsToNotify(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_EmailsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_CountThreshold(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_CountThreshold(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CountThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
 	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNID2int(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_CountThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_updated_at(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_updated_at(ctx, field)
+func (ec *executionContext) _LogAlert_ThresholdWindow(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_ThresholdWindow(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42678,7 +44877,7 @@ func (ec *executionContext) _LogAlert_updated_at(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.ThresholdWindow, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42690,26 +44889,26 @@ func (ec *executionContext) _LogAlert_updated_at(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_ThresholdWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Timestamp does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_Name(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_Name(ctx, field)
+func (ec *executionContext) _LogAlert_LastAdminToEditID(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_LastAdminToEditID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42722,7 +44921,48 @@ func (ec *executionContext) _LogAlert_Name(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.LastAdminToEditID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalOID2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_LastAdminToEditID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_Type(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_Type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42734,12 +44974,12 @@ func (ec *executionContext) _LogAlert_Name(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_Name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_Type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
@@ -42752,8 +44992,8 @@ func (ec *executionContext) fieldContext_LogAlert_Name(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_ChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_ChannelsToNotify(ctx, field)
+func (ec *executionContext) _LogAlert_DailyFrequency(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_DailyFrequency(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42766,7 +45006,7 @@ func (ec *executionContext) _LogAlert_ChannelsToNotify(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().ChannelsToNotify(rctx, obj)
+		return ec.resolvers.LogAlert().DailyFrequency(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42778,32 +45018,26 @@ func (ec *executionContext) _LogAlert_ChannelsToNotify(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SanitizedSlackChannel)
+	res := resTmp.([]*int64)
 	fc.Result = res
-	return ec.marshalNSanitizedSlackChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐSanitizedSlackChannelᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt642ᚕᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_ChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_DailyFrequency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "webhook_channel":
-				return ec.fieldContext_SanitizedSlackChannel_webhook_channel(ctx, field)
-			case "webhook_channel_id":
-				return ec.fieldContext_SanitizedSlackChannel_webhook_channel_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SanitizedSlackChannel", field.Name)
+			return nil, errors.New("field of type Int64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_DiscordChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_DiscordChannelsToNotify(ctx, field)
+func (ec *executionContext) _LogAlert_disabled(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_disabled(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42816,7 +45050,7 @@ func (ec *executionContext) _LogAlert_DiscordChannelsToNotify(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().DiscordChannelsToNotify(rctx, obj)
+		return obj.Disabled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42828,32 +45062,26 @@ func (ec *executionContext) _LogAlert_DiscordChannelsToNotify(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model1.DiscordChannel)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNDiscordChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐDiscordChannelᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_DiscordChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_disabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_DiscordChannel_id(ctx, field)
-			case "name":
-				return ec.fieldContext_DiscordChannel_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DiscordChannel", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_MicrosoftTeamsChannelsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_MicrosoftTeamsChannelsToNotify(ctx, field)
+func (ec *executionContext) _LogAlert_query(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_query(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42866,7 +45094,7 @@ func (ec *executionContext) _LogAlert_MicrosoftTeamsChannelsToNotify(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().MicrosoftTeamsChannelsToNotify(rctx, obj)
+		return obj.Query, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42878,32 +45106,26 @@ func (ec *executionContext) _LogAlert_MicrosoftTeamsChannelsToNotify(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model1.MicrosoftTeamsChannel)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMicrosoftTeamsChannel2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐMicrosoftTeamsChannelᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_MicrosoftTeamsChannelsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_MicrosoftTeamsChannel_id(ctx, field)
-			case "name":
-				return ec.fieldContext_MicrosoftTeamsChannel_name(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MicrosoftTeamsChannel", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_WebhookDestinations(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_WebhookDestinations(ctx, field)
+func (ec *executionContext) _LogAlert_BelowThreshold(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_BelowThreshold(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42916,7 +45138,7 @@ func (ec *executionContext) _LogAlert_WebhookDestinations(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().WebhookDestinations(rctx, obj)
+		return obj.BelowThreshold, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42928,32 +45150,120 @@ func (ec *executionContext) _LogAlert_WebhookDestinations(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model1.WebhookDestination)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNWebhookDestination2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐWebhookDestinationᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_WebhookDestinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogAlert_BelowThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "LogAlert",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogAlert_default(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogAlert_default(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Default, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogAlert_default(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogAlert",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.LogConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.LogEdge)
+	fc.Result = res
+	return ec.marshalNLogEdge2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "url":
-				return ec.fieldContext_WebhookDestination_url(ctx, field)
-			case "authorization":
-				return ec.fieldContext_WebhookDestination_authorization(ctx, field)
+			case "cursor":
+				return ec.fieldContext_LogEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_LogEdge_node(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type WebhookDestination", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LogEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_EmailsToNotify(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_EmailsToNotify(ctx, field)
+func (ec *executionContext) _LogConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.LogConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -42966,7 +45276,7 @@ func (ec *executionContext) _LogAlert_EmailsToNotify(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().EmailsToNotify(rctx, obj)
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -42978,26 +45288,36 @@ func (ec *executionContext) _LogAlert_EmailsToNotify(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_EmailsToNotify(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_CountThreshold(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_CountThreshold(ctx, field)
+func (ec *executionContext) _LogEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.LogEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43010,7 +45330,7 @@ func (ec *executionContext) _LogAlert_CountThreshold(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CountThreshold, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43022,26 +45342,26 @@ func (ec *executionContext) _LogAlert_CountThreshold(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_CountThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_ThresholdWindow(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_ThresholdWindow(ctx, field)
+func (ec *executionContext) _LogEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.LogEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43054,7 +45374,7 @@ func (ec *executionContext) _LogAlert_ThresholdWindow(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ThresholdWindow, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43066,26 +45386,52 @@ func (ec *executionContext) _LogAlert_ThresholdWindow(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.Log)
 	fc.Result = res
-	return ec.marshalNInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNLog2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLog(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_ThresholdWindow(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "projectID":
+				return ec.fieldContext_Log_projectID(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Log_timestamp(ctx, field)
+			case "level":
+				return ec.fieldContext_Log_level(ctx, field)
+			case "message":
+				return ec.fieldContext_Log_message(ctx, field)
+			case "logAttributes":
+				return ec.fieldContext_Log_logAttributes(ctx, field)
+			case "traceID":
+				return ec.fieldContext_Log_traceID(ctx, field)
+			case "spanID":
+				return ec.fieldContext_Log_spanID(ctx, field)
+			case "secureSessionID":
+				return ec.fieldContext_Log_secureSessionID(ctx, field)
+			case "source":
+				return ec.fieldContext_Log_source(ctx, field)
+			case "serviceName":
+				return ec.fieldContext_Log_serviceName(ctx, field)
+			case "serviceVersion":
+				return ec.fieldContext_Log_serviceVersion(ctx, field)
+			case "environment":
+				return ec.fieldContext_Log_environment(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Log", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_LastAdminToEditID(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_LastAdminToEditID(ctx, field)
+func (ec *executionContext) _LogLine_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogLine_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43098,35 +45444,38 @@ func (ec *executionContext) _LogAlert_LastAdminToEditID(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastAdminToEditID, nil
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOID2int(ctx, field.Selections, res)
+	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_LastAdminToEditID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogLine_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogLine",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Timestamp does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_Type(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_Type(ctx, field)
+func (ec *executionContext) _LogLine_body(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogLine_body(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43139,7 +45488,7 @@ func (ec *executionContext) _LogAlert_Type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Body, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43151,14 +45500,14 @@ func (ec *executionContext) _LogAlert_Type(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_Type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogLine_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogLine",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -43169,8 +45518,8 @@ func (ec *executionContext) fieldContext_LogAlert_Type(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_DailyFrequency(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_DailyFrequency(ctx, field)
+func (ec *executionContext) _LogLine_severity(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogLine_severity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43183,7 +45532,48 @@ func (ec *executionContext) _LogAlert_DailyFrequency(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.LogAlert().DailyFrequency(rctx, obj)
+		return obj.Severity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.LogLevel)
+	fc.Result = res
+	return ec.marshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_LogLine_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LogLine",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type LogLevel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LogLine_labels(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogLine_labels(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Labels, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43195,26 +45585,26 @@ func (ec *executionContext) _LogAlert_DailyFrequency(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*int64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt642ᚕᚖint64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_DailyFrequency(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogLine_labels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogLine",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int64 does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_disabled(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_disabled(ctx, field)
+func (ec *executionContext) _LogPipelineStage_type(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43227,7 +45617,7 @@ func (ec *executionContext) _LogAlert_disabled(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Disabled, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43239,26 +45629,26 @@ func (ec *executionContext) _LogAlert_disabled(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(model.LogPipelineStageType)
 	fc.Result = res
-	return ec.marshalNBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNLogPipelineStageType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_disabled(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type LogPipelineStageType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_query(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_query(ctx, field)
+func (ec *executionContext) _LogPipelineStage_query(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_query(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43288,9 +45678,9 @@ func (ec *executionContext) _LogAlert_query(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -43301,8 +45691,8 @@ func (ec *executionContext) fieldContext_LogAlert_query(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_BelowThreshold(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_BelowThreshold(ctx, field)
+func (ec *executionContext) _LogPipelineStage_pattern(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_pattern(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43315,7 +45705,7 @@ func (ec *executionContext) _LogAlert_BelowThreshold(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BelowThreshold, nil
+		return obj.Pattern, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43327,26 +45717,26 @@ func (ec *executionContext) _LogAlert_BelowThreshold(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogAlert_BelowThreshold(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_pattern(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogAlert_default(ctx context.Context, field graphql.CollectedField, obj *model1.LogAlert) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogAlert_default(ctx, field)
+func (ec *executionContext) _LogPipelineStage_source(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_source(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43359,51 +45749,7 @@ func (ec *executionContext) _LogAlert_default(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Default, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LogAlert_default(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LogAlert",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.LogConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogConnection_edges(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.Source, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43415,32 +45761,26 @@ func (ec *executionContext) _LogConnection_edges(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.LogEdge)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLogEdge2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogConnection",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "cursor":
-				return ec.fieldContext_LogEdge_cursor(ctx, field)
-			case "node":
-				return ec.fieldContext_LogEdge_node(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type LogEdge", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.LogConnection) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogConnection_pageInfo(ctx, field)
+func (ec *executionContext) _LogPipelineStage_target(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_target(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43453,7 +45793,7 @@ func (ec *executionContext) _LogConnection_pageInfo(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Target, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43465,36 +45805,26 @@ func (ec *executionContext) _LogConnection_pageInfo(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_target(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogConnection",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.LogEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogEdge_cursor(ctx, field)
+func (ec *executionContext) _LogPipelineStage_level(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_level(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43507,38 +45837,35 @@ func (ec *executionContext) _LogEdge_cursor(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor, nil
+		return obj.Level, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.LogLevel)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogEdge",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type LogLevel does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.LogEdge) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogEdge_node(ctx, field)
+func (ec *executionContext) _LogPipelineStage_severity_mapping(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_severity_mapping(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43551,64 +45878,41 @@ func (ec *executionContext) _LogEdge_node(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.SeverityMapping, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Log)
+	res := resTmp.([]*model.LogSeverityMapping)
 	fc.Result = res
-	return ec.marshalNLog2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLog(ctx, field.Selections, res)
+	return ec.marshalOLogSeverityMapping2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_severity_mapping(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogEdge",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "projectID":
-				return ec.fieldContext_Log_projectID(ctx, field)
-			case "timestamp":
-				return ec.fieldContext_Log_timestamp(ctx, field)
+			case "from":
+				return ec.fieldContext_LogSeverityMapping_from(ctx, field)
 			case "level":
-				return ec.fieldContext_Log_level(ctx, field)
-			case "message":
-				return ec.fieldContext_Log_message(ctx, field)
-			case "logAttributes":
-				return ec.fieldContext_Log_logAttributes(ctx, field)
-			case "traceID":
-				return ec.fieldContext_Log_traceID(ctx, field)
-			case "spanID":
-				return ec.fieldContext_Log_spanID(ctx, field)
-			case "secureSessionID":
-				return ec.fieldContext_Log_secureSessionID(ctx, field)
-			case "source":
-				return ec.fieldContext_Log_source(ctx, field)
-			case "serviceName":
-				return ec.fieldContext_Log_serviceName(ctx, field)
-			case "serviceVersion":
-				return ec.fieldContext_Log_serviceVersion(ctx, field)
-			case "environment":
-				return ec.fieldContext_Log_environment(ctx, field)
+				return ec.fieldContext_LogSeverityMapping_level(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Log", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type LogSeverityMapping", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogLine_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogLine_timestamp(ctx, field)
+func (ec *executionContext) _LogPipelineStage_project_id(ctx context.Context, field graphql.CollectedField, obj *model.LogPipelineStage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogPipelineStage_project_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43621,38 +45925,35 @@ func (ec *executionContext) _LogLine_timestamp(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Timestamp, nil
+		return obj.ProjectID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNTimestamp2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogLine_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogPipelineStage_project_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogLine",
+		Object:     "LogPipelineStage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Timestamp does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LogLine_body(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogLine_body(ctx, field)
+func (ec *executionContext) _LogSeverityMapping_from(ctx context.Context, field graphql.CollectedField, obj *model.LogSeverityMapping) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSeverityMapping_from(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43665,7 +45966,7 @@ func (ec *executionContext) _LogLine_body(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Body, nil
+		return obj.From, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43682,9 +45983,9 @@ func (ec *executionContext) _LogLine_body(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogLine_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSeverityMapping_from(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogLine",
+		Object:     "LogSeverityMapping",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -43695,8 +45996,8 @@ func (ec *executionContext) fieldContext_LogLine_body(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _LogLine_severity(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogLine_severity(ctx, field)
+func (ec *executionContext) _LogSeverityMapping_level(ctx context.Context, field graphql.CollectedField, obj *model.LogSeverityMapping) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LogSeverityMapping_level(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -43709,48 +46010,7 @@ func (ec *executionContext) _LogLine_severity(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Severity, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.LogLevel)
-	fc.Result = res
-	return ec.marshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_LogLine_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "LogLine",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type LogLevel does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _LogLine_labels(ctx context.Context, field graphql.CollectedField, obj *model.LogLine) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_LogLine_labels(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Labels, nil
+		return obj.Level, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -43762,19 +46022,19 @@ func (ec *executionContext) _LogLine_labels(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.LogLevel)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLogLevel2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_LogLine_labels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LogSeverityMapping_level(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LogLine",
+		Object:     "LogSeverityMapping",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type LogLevel does not have child fields")
 		},
 	}
 	return fc, nil
@@ -46553,7 +48813,7 @@ func (ec *executionContext) _Mutation_editProjectSettings(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().EditProjectSettings(rctx, fc.Args["projectId"].(int), fc.Args["name"].(*string), fc.Args["billing_email"].(*string), fc.Args["excluded_users"].(pq.StringArray), fc.Args["error_filters"].(pq.StringArray), fc.Args["error_json_paths"].(pq.StringArray), fc.Args["rage_click_window_seconds"].(*int), fc.Args["rage_click_radius_pixels"].(*int), fc.Args["rage_click_count"].(*int), fc.Args["filter_chrome_extension"].(*bool), fc.Args["filterSessionsWithoutError"].(*bool), fc.Args["autoResolveStaleErrorsDayInterval"].(*int), fc.Args["sampling"].(*model.SamplingInput))
+		return ec.resolvers.Mutation().EditProjectSettings(rctx, fc.Args["projectId"].(int), fc.Args["name"].(*string), fc.Args["billing_email"].(*string), fc.Args["excluded_users"].(pq.StringArray), fc.Args["error_filters"].(pq.StringArray), fc.Args["error_json_paths"].(pq.StringArray), fc.Args["rage_click_window_seconds"].(*int), fc.Args["rage_click_radius_pixels"].(*int), fc.Args["rage_click_count"].(*int), fc.Args["filter_chrome_extension"].(*bool), fc.Args["filterSessionsWithoutError"].(*bool), fc.Args["autoResolveStaleErrorsDayInterval"].(*int), fc.Args["sampling"].(*model.SamplingInput), fc.Args["log_pipeline"].([]*model.LogPipelineStageInput), fc.Args["redaction_rules"].([]*model.RedactionRuleInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -46605,6 +48865,10 @@ func (ec *executionContext) fieldContext_Mutation_editProjectSettings(ctx contex
 				return ec.fieldContext_AllProjectSettings_autoResolveStaleErrorsDayInterval(ctx, field)
 			case "sampling":
 				return ec.fieldContext_AllProjectSettings_sampling(ctx, field)
+			case "log_pipeline":
+				return ec.fieldContext_AllProjectSettings_log_pipeline(ctx, field)
+			case "redaction_rules":
+				return ec.fieldContext_AllProjectSettings_redaction_rules(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AllProjectSettings", field.Name)
 		},
@@ -58336,6 +60600,67 @@ func (ec *executionContext) fieldContext_Query_usageHistory(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_ingestDrops(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_ingestDrops(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().IngestDrops(rctx, fc.Args["workspace_id"].(int), fc.Args["product_type"].(model.ProductType), fc.Args["date_range"].(model.DateRangeRequiredInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.IngestDrop)
+	fc.Result = res
+	return ec.marshalNIngestDrop2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestDropᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_ingestDrops(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "reason":
+				return ec.fieldContext_IngestDrop_reason(ctx, field)
+			case "count":
+				return ec.fieldContext_IngestDrop_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IngestDrop", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_ingestDrops_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_field_suggestion(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_field_suggestion(ctx, field)
 	if err != nil {
@@ -61544,6 +63869,10 @@ func (ec *executionContext) fieldContext_Query_projectSettings(ctx context.Conte
 				return ec.fieldContext_AllProjectSettings_autoResolveStaleErrorsDayInterval(ctx, field)
 			case "sampling":
 				return ec.fieldContext_AllProjectSettings_sampling(ctx, field)
+			case "log_pipeline":
+				return ec.fieldContext_AllProjectSettings_log_pipeline(ctx, field)
+			case "redaction_rules":
+				return ec.fieldContext_AllProjectSettings_redaction_rules(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type AllProjectSettings", field.Name)
 		},
@@ -61562,6 +63891,83 @@ func (ec *executionContext) fieldContext_Query_projectSettings(ctx context.Conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_ingest_preview(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_ingest_preview(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().IngestPreview(rctx, fc.Args["project_id"].(int), fc.Args["payload"].(string), fc.Args["sampling"].(*model.SamplingInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.IngestPreviewItem)
+	fc.Result = res
+	return ec.marshalNIngestPreviewItem2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewItemᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_ingest_preview(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "product":
+				return ec.fieldContext_IngestPreviewItem_product(ctx, field)
+			case "log":
+				return ec.fieldContext_IngestPreviewItem_log(ctx, field)
+			case "trace":
+				return ec.fieldContext_IngestPreviewItem_trace(ctx, field)
+			case "error":
+				return ec.fieldContext_IngestPreviewItem_error(ctx, field)
+			case "ingested":
+				return ec.fieldContext_IngestPreviewItem_ingested(ctx, field)
+			case "reason":
+				return ec.fieldContext_IngestPreviewItem_reason(ctx, field)
+			case "exclusion_query":
+				return ec.fieldContext_IngestPreviewItem_exclusion_query(ctx, field)
+			case "quota_exceeded":
+				return ec.fieldContext_IngestPreviewItem_quota_exceeded(ctx, field)
+			case "dropped_by_pipeline":
+				return ec.fieldContext_IngestPreviewItem_dropped_by_pipeline(ctx, field)
+			case "tail_sampled":
+				return ec.fieldContext_IngestPreviewItem_tail_sampled(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IngestPreviewItem", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_ingest_preview_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_workspace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_workspace(ctx, field)
 	if err != nil {
@@ -66156,9 +68562,141 @@ func (ec *executionContext) _RageClickEvent_total_clicks(ctx context.Context, fi
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RageClickEvent_total_clicks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RageClickEvent_total_clicks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RageClickEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RageClickEventForProject_identifier(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RageClickEventForProject_identifier(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Identifier, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RageClickEventForProject_identifier(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RageClickEventForProject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RageClickEventForProject_session_secure_id(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RageClickEventForProject_session_secure_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SessionSecureID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RageClickEventForProject_session_secure_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RageClickEventForProject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RageClickEventForProject_total_clicks(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RageClickEventForProject_total_clicks(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalClicks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RageClickEventForProject_total_clicks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RageClickEvent",
+		Object:     "RageClickEventForProject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -66169,8 +68707,8 @@ func (ec *executionContext) fieldContext_RageClickEvent_total_clicks(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _RageClickEventForProject_identifier(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RageClickEventForProject_identifier(ctx, field)
+func (ec *executionContext) _RageClickEventForProject_user_properties(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RageClickEventForProject_user_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -66183,7 +68721,7 @@ func (ec *executionContext) _RageClickEventForProject_identifier(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Identifier, nil
+		return obj.UserProperties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -66200,7 +68738,7 @@ func (ec *executionContext) _RageClickEventForProject_identifier(ctx context.Con
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RageClickEventForProject_identifier(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RageClickEventForProject_user_properties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "RageClickEventForProject",
 		Field:      field,
@@ -66213,8 +68751,8 @@ func (ec *executionContext) fieldContext_RageClickEventForProject_identifier(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _RageClickEventForProject_session_secure_id(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RageClickEventForProject_session_secure_id(ctx, field)
+func (ec *executionContext) _RedactionRule_type(ctx context.Context, field graphql.CollectedField, obj *model.RedactionRule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactionRule_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -66227,7 +68765,7 @@ func (ec *executionContext) _RageClickEventForProject_session_secure_id(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SessionSecureID, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -66239,26 +68777,26 @@ func (ec *executionContext) _RageClickEventForProject_session_secure_id(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.RedactionRuleType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRedactionRuleType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RageClickEventForProject_session_secure_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RedactionRule_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RageClickEventForProject",
+		Object:     "RedactionRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type RedactionRuleType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RageClickEventForProject_total_clicks(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RageClickEventForProject_total_clicks(ctx, field)
+func (ec *executionContext) _RedactionRule_pattern(ctx context.Context, field graphql.CollectedField, obj *model.RedactionRule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactionRule_pattern(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -66271,7 +68809,7 @@ func (ec *executionContext) _RageClickEventForProject_total_clicks(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalClicks, nil
+		return obj.Pattern, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -66283,26 +68821,26 @@ func (ec *executionContext) _RageClickEventForProject_total_clicks(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RageClickEventForProject_total_clicks(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RedactionRule_pattern(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RageClickEventForProject",
+		Object:     "RedactionRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RageClickEventForProject_user_properties(ctx context.Context, field graphql.CollectedField, obj *model.RageClickEventForProject) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RageClickEventForProject_user_properties(ctx, field)
+func (ec *executionContext) _RedactionRule_mode(ctx context.Context, field graphql.CollectedField, obj *model.RedactionRule) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RedactionRule_mode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -66315,7 +68853,7 @@ func (ec *executionContext) _RageClickEventForProject_user_properties(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserProperties, nil
+		return obj.Mode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -66327,19 +68865,19 @@ func (ec *executionContext) _RageClickEventForProject_user_properties(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.RedactionMode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRedactionMode2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionMode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RageClickEventForProject_user_properties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RedactionRule_mode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RageClickEventForProject",
+		Object:     "RedactionRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type RedactionMode does not have child fields")
 		},
 	}
 	return fc, nil
@@ -66858,6 +69396,334 @@ func (ec *executionContext) fieldContext_Sampling_trace_minute_rate_limit(ctx co
 	return fc, nil
 }
 
+func (ec *executionContext) _Sampling_session_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_session_minute_rate_limit_burst(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SessionMinuteRateLimitBurst, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_session_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_error_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_error_minute_rate_limit_burst(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ErrorMinuteRateLimitBurst, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_error_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_log_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_log_minute_rate_limit_burst(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogMinuteRateLimitBurst, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_log_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_minute_rate_limit_burst(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceMinuteRateLimitBurst, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_minute_rate_limit_burst(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_session_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_session_minute_rate_limit_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SessionMinuteRateLimitKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_session_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_error_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_error_minute_rate_limit_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ErrorMinuteRateLimitKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_error_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_log_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_log_minute_rate_limit_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogMinuteRateLimitKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_log_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_minute_rate_limit_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceMinuteRateLimitKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_minute_rate_limit_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Sampling_session_exclusion_query(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Sampling_session_exclusion_query(ctx, field)
 	if err != nil {
@@ -67022,6 +69888,296 @@ func (ec *executionContext) fieldContext_Sampling_trace_exclusion_query(ctx cont
 	return fc, nil
 }
 
+func (ec *executionContext) _Sampling_trace_tail_sampling(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_tail_sampling(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceTailSampling, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_tail_sampling(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_tail_sampling_min_duration_ms(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_tail_sampling_min_duration_ms(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceTailSamplingMinDurationMs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_tail_sampling_min_duration_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_tail_sampling_query(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_tail_sampling_query(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceTailSamplingQuery, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_tail_sampling_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_log_adaptive_sampling_target(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_log_adaptive_sampling_target(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogAdaptiveSamplingTarget, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_log_adaptive_sampling_target(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_log_adaptive_sampling_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_log_adaptive_sampling_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LogAdaptiveSamplingKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_log_adaptive_sampling_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_adaptive_sampling_target(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_adaptive_sampling_target(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceAdaptiveSamplingTarget, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int64)
+	fc.Result = res
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_adaptive_sampling_target(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Sampling_trace_adaptive_sampling_key(ctx context.Context, field graphql.CollectedField, obj *model.Sampling) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Sampling_trace_adaptive_sampling_key(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TraceAdaptiveSamplingKey, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Sampling_trace_adaptive_sampling_key(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Sampling",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SanitizedAdmin_id(ctx context.Context, field graphql.CollectedField, obj *model.SanitizedAdmin) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_SanitizedAdmin_id(ctx, field)
 	if err != nil {
@@ -84800,6 +87956,116 @@ func (ec *executionContext) unmarshalInputLogAlertInput(ctx context.Context, obj
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputLogPipelineStageInput(ctx context.Context, obj interface{}) (model.LogPipelineStageInput, error) {
+	var it model.LogPipelineStageInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "query", "pattern", "source", "target", "level", "severity_mapping", "project_id"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNLogPipelineStageType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "query":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Query = data
+		case "pattern":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pattern"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pattern = data
+		case "source":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("source"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Source = data
+		case "target":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Target = data
+		case "level":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("level"))
+			data, err := ec.unmarshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Level = data
+		case "severity_mapping":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity_mapping"))
+			data, err := ec.unmarshalOLogSeverityMappingInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SeverityMapping = data
+		case "project_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("project_id"))
+			data, err := ec.unmarshalOID2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProjectID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLogSeverityMappingInput(ctx context.Context, obj interface{}) (model.LogSeverityMappingInput, error) {
+	var it model.LogSeverityMappingInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"from", "level"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "from":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.From = data
+		case "level":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("level"))
+			data, err := ec.unmarshalNLogLevel2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Level = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputMetricTagFilterInput(ctx context.Context, obj interface{}) (model.MetricTagFilterInput, error) {
 	var it model.MetricTagFilterInput
 	asMap := map[string]interface{}{}
@@ -84950,6 +88216,47 @@ func (ec *executionContext) unmarshalInputQueryInput(ctx context.Context, obj in
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRedactionRuleInput(ctx context.Context, obj interface{}) (model.RedactionRuleInput, error) {
+	var it model.RedactionRuleInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "pattern", "mode"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNRedactionRuleType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "pattern":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pattern"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pattern = data
+		case "mode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			data, err := ec.unmarshalNRedactionMode2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Mode = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputSamplingInput(ctx context.Context, obj interface{}) (model.SamplingInput, error) {
 	var it model.SamplingInput
 	asMap := map[string]interface{}{}
@@ -84957,7 +88264,7 @@ func (ec *executionContext) unmarshalInputSamplingInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"session_sampling_rate", "error_sampling_rate", "log_sampling_rate", "trace_sampling_rate", "session_minute_rate_limit", "error_minute_rate_limit", "log_minute_rate_limit", "trace_minute_rate_limit", "session_exclusion_query", "error_exclusion_query", "log_exclusion_query", "trace_exclusion_query"}
+	fieldsInOrder := [...]string{"session_sampling_rate", "error_sampling_rate", "log_sampling_rate", "trace_sampling_rate", "session_minute_rate_limit", "error_minute_rate_limit", "log_minute_rate_limit", "trace_minute_rate_limit", "session_minute_rate_limit_burst", "error_minute_rate_limit_burst", "log_minute_rate_limit_burst", "trace_minute_rate_limit_burst", "session_minute_rate_limit_key", "error_minute_rate_limit_key", "log_minute_rate_limit_key", "trace_minute_rate_limit_key", "session_exclusion_query", "error_exclusion_query", "log_exclusion_query", "trace_exclusion_query", "trace_tail_sampling", "trace_tail_sampling_min_duration_ms", "trace_tail_sampling_query", "log_adaptive_sampling_target", "log_adaptive_sampling_key", "trace_adaptive_sampling_target", "trace_adaptive_sampling_key"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -85020,6 +88327,62 @@ func (ec *executionContext) unmarshalInputSamplingInput(ctx context.Context, obj
 				return it, err
 			}
 			it.TraceMinuteRateLimit = data
+		case "session_minute_rate_limit_burst":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("session_minute_rate_limit_burst"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionMinuteRateLimitBurst = data
+		case "error_minute_rate_limit_burst":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("error_minute_rate_limit_burst"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ErrorMinuteRateLimitBurst = data
+		case "log_minute_rate_limit_burst":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("log_minute_rate_limit_burst"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogMinuteRateLimitBurst = data
+		case "trace_minute_rate_limit_burst":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_minute_rate_limit_burst"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceMinuteRateLimitBurst = data
+		case "session_minute_rate_limit_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("session_minute_rate_limit_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SessionMinuteRateLimitKey = data
+		case "error_minute_rate_limit_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("error_minute_rate_limit_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ErrorMinuteRateLimitKey = data
+		case "log_minute_rate_limit_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("log_minute_rate_limit_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogMinuteRateLimitKey = data
+		case "trace_minute_rate_limit_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_minute_rate_limit_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceMinuteRateLimitKey = data
 		case "session_exclusion_query":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("session_exclusion_query"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -85048,6 +88411,55 @@ func (ec *executionContext) unmarshalInputSamplingInput(ctx context.Context, obj
 				return it, err
 			}
 			it.TraceExclusionQuery = data
+		case "trace_tail_sampling":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_tail_sampling"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceTailSampling = data
+		case "trace_tail_sampling_min_duration_ms":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_tail_sampling_min_duration_ms"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceTailSamplingMinDurationMs = data
+		case "trace_tail_sampling_query":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_tail_sampling_query"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceTailSamplingQuery = data
+		case "log_adaptive_sampling_target":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("log_adaptive_sampling_target"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogAdaptiveSamplingTarget = data
+		case "log_adaptive_sampling_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("log_adaptive_sampling_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LogAdaptiveSamplingKey = data
+		case "trace_adaptive_sampling_target":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_adaptive_sampling_target"))
+			data, err := ec.unmarshalOInt642ᚖint64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceAdaptiveSamplingTarget = data
+		case "trace_adaptive_sampling_key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trace_adaptive_sampling_key"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TraceAdaptiveSamplingKey = data
 		}
 	}
 
@@ -86389,6 +89801,16 @@ func (ec *executionContext) _AllProjectSettings(ctx context.Context, sel ast.Sel
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "log_pipeline":
+			out.Values[i] = ec._AllProjectSettings_log_pipeline(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "redaction_rules":
+			out.Values[i] = ec._AllProjectSettings_redaction_rules(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -89639,103 +93061,250 @@ func (ec *executionContext) _GitlabProject(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var graphImplementors = []string{"Graph"}
+var graphImplementors = []string{"Graph"}
+
+func (ec *executionContext) _Graph(ctx context.Context, sel ast.SelectionSet, obj *model1.Graph) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, graphImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Graph")
+		case "id":
+			out.Values[i] = ec._Graph_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "type":
+			out.Values[i] = ec._Graph_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "title":
+			out.Values[i] = ec._Graph_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "productType":
+			out.Values[i] = ec._Graph_productType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "query":
+			out.Values[i] = ec._Graph_query(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "metric":
+			out.Values[i] = ec._Graph_metric(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "functionType":
+			out.Values[i] = ec._Graph_functionType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "groupByKeys":
+			out.Values[i] = ec._Graph_groupByKeys(ctx, field, obj)
+		case "bucketByKey":
+			out.Values[i] = ec._Graph_bucketByKey(ctx, field, obj)
+		case "bucketCount":
+			out.Values[i] = ec._Graph_bucketCount(ctx, field, obj)
+		case "bucketInterval":
+			out.Values[i] = ec._Graph_bucketInterval(ctx, field, obj)
+		case "limit":
+			out.Values[i] = ec._Graph_limit(ctx, field, obj)
+		case "limitFunctionType":
+			out.Values[i] = ec._Graph_limitFunctionType(ctx, field, obj)
+		case "limitMetric":
+			out.Values[i] = ec._Graph_limitMetric(ctx, field, obj)
+		case "funnelSteps":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Graph_funnelSteps(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "display":
+			out.Values[i] = ec._Graph_display(ctx, field, obj)
+		case "nullHandling":
+			out.Values[i] = ec._Graph_nullHandling(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var heightListImplementors = []string{"HeightList"}
+
+func (ec *executionContext) _HeightList(ctx context.Context, sel ast.SelectionSet, obj *model.HeightList) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, heightListImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HeightList")
+		case "id":
+			out.Values[i] = ec._HeightList_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._HeightList_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._HeightList_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var heightTaskImplementors = []string{"HeightTask"}
+
+func (ec *executionContext) _HeightTask(ctx context.Context, sel ast.SelectionSet, obj *model.HeightTask) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, heightTaskImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HeightTask")
+		case "id":
+			out.Values[i] = ec._HeightTask_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._HeightTask_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var heightWorkspaceImplementors = []string{"HeightWorkspace"}
 
-func (ec *executionContext) _Graph(ctx context.Context, sel ast.SelectionSet, obj *model1.Graph) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, graphImplementors)
+func (ec *executionContext) _HeightWorkspace(ctx context.Context, sel ast.SelectionSet, obj *model.HeightWorkspace) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, heightWorkspaceImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Graph")
+			out.Values[i] = graphql.MarshalString("HeightWorkspace")
 		case "id":
-			out.Values[i] = ec._Graph_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "type":
-			out.Values[i] = ec._Graph_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "title":
-			out.Values[i] = ec._Graph_title(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "productType":
-			out.Values[i] = ec._Graph_productType(ctx, field, obj)
+			out.Values[i] = ec._HeightWorkspace_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "query":
-			out.Values[i] = ec._Graph_query(ctx, field, obj)
+		case "model":
+			out.Values[i] = ec._HeightWorkspace_model(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "metric":
-			out.Values[i] = ec._Graph_metric(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._HeightWorkspace_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "functionType":
-			out.Values[i] = ec._Graph_functionType(ctx, field, obj)
+		case "url":
+			out.Values[i] = ec._HeightWorkspace_url(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "groupByKeys":
-			out.Values[i] = ec._Graph_groupByKeys(ctx, field, obj)
-		case "bucketByKey":
-			out.Values[i] = ec._Graph_bucketByKey(ctx, field, obj)
-		case "bucketCount":
-			out.Values[i] = ec._Graph_bucketCount(ctx, field, obj)
-		case "bucketInterval":
-			out.Values[i] = ec._Graph_bucketInterval(ctx, field, obj)
-		case "limit":
-			out.Values[i] = ec._Graph_limit(ctx, field, obj)
-		case "limitFunctionType":
-			out.Values[i] = ec._Graph_limitFunctionType(ctx, field, obj)
-		case "limitMetric":
-			out.Values[i] = ec._Graph_limitMetric(ctx, field, obj)
-		case "funnelSteps":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Graph_funnelSteps(ctx, field, obj)
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "display":
-			out.Values[i] = ec._Graph_display(ctx, field, obj)
-		case "nullHandling":
-			out.Values[i] = ec._Graph_nullHandling(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -89759,29 +93328,34 @@ func (ec *executionContext) _Graph(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var heightListImplementors = []string{"HeightList"}
+var histogramBucketImplementors = []string{"HistogramBucket"}
 
-func (ec *executionContext) _HeightList(ctx context.Context, sel ast.SelectionSet, obj *model.HeightList) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, heightListImplementors)
+func (ec *executionContext) _HistogramBucket(ctx context.Context, sel ast.SelectionSet, obj *model.HistogramBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, histogramBucketImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HeightList")
-		case "id":
-			out.Values[i] = ec._HeightList_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("HistogramBucket")
+		case "bucket":
+			out.Values[i] = ec._HistogramBucket_bucket(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._HeightList_name(ctx, field, obj)
+		case "range_start":
+			out.Values[i] = ec._HistogramBucket_range_start(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "type":
-			out.Values[i] = ec._HeightList_type(ctx, field, obj)
+		case "range_end":
+			out.Values[i] = ec._HistogramBucket_range_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._HistogramBucket_count(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -89808,24 +93382,24 @@ func (ec *executionContext) _HeightList(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var heightTaskImplementors = []string{"HeightTask"}
+var ingestDropImplementors = []string{"IngestDrop"}
 
-func (ec *executionContext) _HeightTask(ctx context.Context, sel ast.SelectionSet, obj *model.HeightTask) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, heightTaskImplementors)
+func (ec *executionContext) _IngestDrop(ctx context.Context, sel ast.SelectionSet, obj *model.IngestDrop) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ingestDropImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HeightTask")
-		case "id":
-			out.Values[i] = ec._HeightTask_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("IngestDrop")
+		case "reason":
+			out.Values[i] = ec._IngestDrop_reason(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._HeightTask_name(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._IngestDrop_count(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -89852,37 +93426,56 @@ func (ec *executionContext) _HeightTask(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var heightWorkspaceImplementors = []string{"HeightWorkspace"}
+var ingestPreviewErrorImplementors = []string{"IngestPreviewError"}
 
-func (ec *executionContext) _HeightWorkspace(ctx context.Context, sel ast.SelectionSet, obj *model.HeightWorkspace) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, heightWorkspaceImplementors)
+func (ec *executionContext) _IngestPreviewError(ctx context.Context, sel ast.SelectionSet, obj *model.IngestPreviewError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ingestPreviewErrorImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HeightWorkspace")
-		case "id":
-			out.Values[i] = ec._HeightWorkspace_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("IngestPreviewError")
+		case "event":
+			out.Values[i] = ec._IngestPreviewError_event(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "model":
-			out.Values[i] = ec._HeightWorkspace_model(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec._IngestPreviewError_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._HeightWorkspace_name(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._IngestPreviewError_source(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "url":
-			out.Values[i] = ec._HeightWorkspace_url(ctx, field, obj)
+		case "stack_trace":
+			out.Values[i] = ec._IngestPreviewError_stack_trace(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "timestamp":
+			out.Values[i] = ec._IngestPreviewError_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "service_name":
+			out.Values[i] = ec._IngestPreviewError_service_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "environment":
+			out.Values[i] = ec._IngestPreviewError_environment(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trace_id":
+			out.Values[i] = ec._IngestPreviewError_trace_id(ctx, field, obj)
+		case "span_id":
+			out.Values[i] = ec._IngestPreviewError_span_id(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -89906,34 +93499,49 @@ func (ec *executionContext) _HeightWorkspace(ctx context.Context, sel ast.Select
 	return out
 }
 
-var histogramBucketImplementors = []string{"HistogramBucket"}
+var ingestPreviewItemImplementors = []string{"IngestPreviewItem"}
 
-func (ec *executionContext) _HistogramBucket(ctx context.Context, sel ast.SelectionSet, obj *model.HistogramBucket) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, histogramBucketImplementors)
+func (ec *executionContext) _IngestPreviewItem(ctx context.Context, sel ast.SelectionSet, obj *model.IngestPreviewItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ingestPreviewItemImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("HistogramBucket")
-		case "bucket":
-			out.Values[i] = ec._HistogramBucket_bucket(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("IngestPreviewItem")
+		case "product":
+			out.Values[i] = ec._IngestPreviewItem_product(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "range_start":
-			out.Values[i] = ec._HistogramBucket_range_start(ctx, field, obj)
+		case "log":
+			out.Values[i] = ec._IngestPreviewItem_log(ctx, field, obj)
+		case "trace":
+			out.Values[i] = ec._IngestPreviewItem_trace(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._IngestPreviewItem_error(ctx, field, obj)
+		case "ingested":
+			out.Values[i] = ec._IngestPreviewItem_ingested(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "range_end":
-			out.Values[i] = ec._HistogramBucket_range_end(ctx, field, obj)
+		case "reason":
+			out.Values[i] = ec._IngestPreviewItem_reason(ctx, field, obj)
+		case "exclusion_query":
+			out.Values[i] = ec._IngestPreviewItem_exclusion_query(ctx, field, obj)
+		case "quota_exceeded":
+			out.Values[i] = ec._IngestPreviewItem_quota_exceeded(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "count":
-			out.Values[i] = ec._HistogramBucket_count(ctx, field, obj)
+		case "dropped_by_pipeline":
+			out.Values[i] = ec._IngestPreviewItem_dropped_by_pipeline(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tail_sampled":
+			out.Values[i] = ec._IngestPreviewItem_tail_sampled(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -91002,6 +94610,115 @@ func (ec *executionContext) _LogLine(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var logPipelineStageImplementors = []string{"LogPipelineStage"}
+
+func (ec *executionContext) _LogPipelineStage(ctx context.Context, sel ast.SelectionSet, obj *model.LogPipelineStage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logPipelineStageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogPipelineStage")
+		case "type":
+			out.Values[i] = ec._LogPipelineStage_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "query":
+			out.Values[i] = ec._LogPipelineStage_query(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pattern":
+			out.Values[i] = ec._LogPipelineStage_pattern(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._LogPipelineStage_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "target":
+			out.Values[i] = ec._LogPipelineStage_target(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "level":
+			out.Values[i] = ec._LogPipelineStage_level(ctx, field, obj)
+		case "severity_mapping":
+			out.Values[i] = ec._LogPipelineStage_severity_mapping(ctx, field, obj)
+		case "project_id":
+			out.Values[i] = ec._LogPipelineStage_project_id(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var logSeverityMappingImplementors = []string{"LogSeverityMapping"}
+
+func (ec *executionContext) _LogSeverityMapping(ctx context.Context, sel ast.SelectionSet, obj *model.LogSeverityMapping) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logSeverityMappingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LogSeverityMapping")
+		case "from":
+			out.Values[i] = ec._LogSeverityMapping_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "level":
+			out.Values[i] = ec._LogSeverityMapping_level(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var logsHistogramImplementors = []string{"LogsHistogram"}
 
 func (ec *executionContext) _LogsHistogram(ctx context.Context, sel ast.SelectionSet, obj *model.LogsHistogram) graphql.Marshaler {
@@ -93944,6 +97661,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "ingestDrops":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ingestDrops(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "field_suggestion":
 			field := field
@@ -94914,6 +98653,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "ingest_preview":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ingest_preview(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "workspace":
 			field := field
@@ -96490,6 +100251,55 @@ func (ec *executionContext) _RageClickEventForProject(ctx context.Context, sel a
 	return out
 }
 
+var redactionRuleImplementors = []string{"RedactionRule"}
+
+func (ec *executionContext) _RedactionRule(ctx context.Context, sel ast.SelectionSet, obj *model.RedactionRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, redactionRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RedactionRule")
+		case "type":
+			out.Values[i] = ec._RedactionRule_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pattern":
+			out.Values[i] = ec._RedactionRule_pattern(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mode":
+			out.Values[i] = ec._RedactionRule_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var referrerTablePayloadImplementors = []string{"ReferrerTablePayload"}
 
 func (ec *executionContext) _ReferrerTablePayload(ctx context.Context, sel ast.SelectionSet, obj *model.ReferrerTablePayload) graphql.Marshaler {
@@ -96614,6 +100424,22 @@ func (ec *executionContext) _Sampling(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Sampling_log_minute_rate_limit(ctx, field, obj)
 		case "trace_minute_rate_limit":
 			out.Values[i] = ec._Sampling_trace_minute_rate_limit(ctx, field, obj)
+		case "session_minute_rate_limit_burst":
+			out.Values[i] = ec._Sampling_session_minute_rate_limit_burst(ctx, field, obj)
+		case "error_minute_rate_limit_burst":
+			out.Values[i] = ec._Sampling_error_minute_rate_limit_burst(ctx, field, obj)
+		case "log_minute_rate_limit_burst":
+			out.Values[i] = ec._Sampling_log_minute_rate_limit_burst(ctx, field, obj)
+		case "trace_minute_rate_limit_burst":
+			out.Values[i] = ec._Sampling_trace_minute_rate_limit_burst(ctx, field, obj)
+		case "session_minute_rate_limit_key":
+			out.Values[i] = ec._Sampling_session_minute_rate_limit_key(ctx, field, obj)
+		case "error_minute_rate_limit_key":
+			out.Values[i] = ec._Sampling_error_minute_rate_limit_key(ctx, field, obj)
+		case "log_minute_rate_limit_key":
+			out.Values[i] = ec._Sampling_log_minute_rate_limit_key(ctx, field, obj)
+		case "trace_minute_rate_limit_key":
+			out.Values[i] = ec._Sampling_trace_minute_rate_limit_key(ctx, field, obj)
 		case "session_exclusion_query":
 			out.Values[i] = ec._Sampling_session_exclusion_query(ctx, field, obj)
 		case "error_exclusion_query":
@@ -96622,6 +100448,23 @@ func (ec *executionContext) _Sampling(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Sampling_log_exclusion_query(ctx, field, obj)
 		case "trace_exclusion_query":
 			out.Values[i] = ec._Sampling_trace_exclusion_query(ctx, field, obj)
+		case "trace_tail_sampling":
+			out.Values[i] = ec._Sampling_trace_tail_sampling(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "trace_tail_sampling_min_duration_ms":
+			out.Values[i] = ec._Sampling_trace_tail_sampling_min_duration_ms(ctx, field, obj)
+		case "trace_tail_sampling_query":
+			out.Values[i] = ec._Sampling_trace_tail_sampling_query(ctx, field, obj)
+		case "log_adaptive_sampling_target":
+			out.Values[i] = ec._Sampling_log_adaptive_sampling_target(ctx, field, obj)
+		case "log_adaptive_sampling_key":
+			out.Values[i] = ec._Sampling_log_adaptive_sampling_key(ctx, field, obj)
+		case "trace_adaptive_sampling_target":
+			out.Values[i] = ec._Sampling_trace_adaptive_sampling_target(ctx, field, obj)
+		case "trace_adaptive_sampling_key":
+			out.Values[i] = ec._Sampling_trace_adaptive_sampling_key(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -102959,7 +106802,183 @@ func (ec *executionContext) marshalNHeightList2ᚕᚖgithubᚗcomᚋhighlightᚑ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNHeightList2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightList(ctx, sel, v[i])
+			ret[i] = ec.marshalNHeightList2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightList(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNHeightList2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightList(ctx context.Context, sel ast.SelectionSet, v *model.HeightList) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._HeightList(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNHeightWorkspace2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HeightWorkspace) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNHeightWorkspace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspace(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNHeightWorkspace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspace(ctx context.Context, sel ast.SelectionSet, v *model.HeightWorkspace) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._HeightWorkspace(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalIntID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalIntID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2int(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNID2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	res, err := graphql.UnmarshalIntID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalIntID(*v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNIngestDrop2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestDropᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.IngestDrop) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNIngestDrop2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestDrop(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -102979,17 +106998,17 @@ func (ec *executionContext) marshalNHeightList2ᚕᚖgithubᚗcomᚋhighlightᚑ
 	return ret
 }
 
-func (ec *executionContext) marshalNHeightList2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightList(ctx context.Context, sel ast.SelectionSet, v *model.HeightList) graphql.Marshaler {
+func (ec *executionContext) marshalNIngestDrop2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestDrop(ctx context.Context, sel ast.SelectionSet, v *model.IngestDrop) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._HeightList(ctx, sel, v)
+	return ec._IngestDrop(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNHeightWorkspace2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.HeightWorkspace) graphql.Marshaler {
+func (ec *executionContext) marshalNIngestPreviewItem2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.IngestPreviewItem) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -103013,7 +107032,7 @@ func (ec *executionContext) marshalNHeightWorkspace2ᚕᚖgithubᚗcomᚋhighlig
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNHeightWorkspace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspace(ctx, sel, v[i])
+			ret[i] = ec.marshalNIngestPreviewItem2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewItem(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -103033,82 +107052,24 @@ func (ec *executionContext) marshalNHeightWorkspace2ᚕᚖgithubᚗcomᚋhighlig
 	return ret
 }
 
-func (ec *executionContext) marshalNHeightWorkspace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐHeightWorkspace(ctx context.Context, sel ast.SelectionSet, v *model.HeightWorkspace) graphql.Marshaler {
+func (ec *executionContext) marshalNIngestPreviewItem2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewItem(ctx context.Context, sel ast.SelectionSet, v *model.IngestPreviewItem) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._HeightWorkspace(ctx, sel, v)
+	return ec._IngestPreviewItem(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalIntID(v)
+func (ec *executionContext) unmarshalNIngestReason2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx context.Context, v interface{}) (model.IngestReason, error) {
+	var res model.IngestReason
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalIntID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNID2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]int, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNID2int(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) marshalNID2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNID2int(ctx, sel, v[i])
-	}
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
-	return ret
-}
-
-func (ec *executionContext) unmarshalNID2ᚖint(ctx context.Context, v interface{}) (*int, error) {
-	res, err := graphql.UnmarshalIntID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNID2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	res := graphql.MarshalIntID(*v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNIngestReason2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx context.Context, sel ast.SelectionSet, v model.IngestReason) graphql.Marshaler {
+	return v
 }
 
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
@@ -103658,6 +107619,90 @@ func (ec *executionContext) marshalNLogLine2ᚖgithubᚗcomᚋhighlightᚑrunᚋ
 	return ec._LogLine(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNLogPipelineStage2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LogPipelineStage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLogPipelineStage2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLogPipelineStage2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStage(ctx context.Context, sel ast.SelectionSet, v *model.LogPipelineStage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LogPipelineStage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNLogPipelineStageInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageInput(ctx context.Context, v interface{}) (*model.LogPipelineStageInput, error) {
+	res, err := ec.unmarshalInputLogPipelineStageInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNLogPipelineStageType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageType(ctx context.Context, v interface{}) (model.LogPipelineStageType, error) {
+	var res model.LogPipelineStageType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLogPipelineStageType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageType(ctx context.Context, sel ast.SelectionSet, v model.LogPipelineStageType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNLogSeverityMapping2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMapping(ctx context.Context, sel ast.SelectionSet, v *model.LogSeverityMapping) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LogSeverityMapping(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNLogSeverityMappingInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingInput(ctx context.Context, v interface{}) (*model.LogSeverityMappingInput, error) {
+	res, err := ec.unmarshalInputLogSeverityMappingInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNLogsHistogram2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogsHistogram(ctx context.Context, sel ast.SelectionSet, v model.LogsHistogram) graphql.Marshaler {
 	return ec._LogsHistogram(ctx, sel, &v)
 }
@@ -104419,7 +108464,125 @@ func (ec *executionContext) marshalNRageClickEvent2ᚕᚖgithubᚗcomᚋhighligh
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNRageClickEvent2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐRageClickEvent(ctx, sel, v[i])
+			ret[i] = ec.marshalNRageClickEvent2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐRageClickEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRageClickEvent2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐRageClickEvent(ctx context.Context, sel ast.SelectionSet, v *model1.RageClickEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RageClickEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRageClickEventForProject2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProjectᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RageClickEventForProject) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRageClickEventForProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProject(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRageClickEventForProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProject(ctx context.Context, sel ast.SelectionSet, v *model.RageClickEventForProject) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RageClickEventForProject(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRedactionMode2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionMode(ctx context.Context, v interface{}) (model.RedactionMode, error) {
+	var res model.RedactionMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRedactionMode2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionMode(ctx context.Context, sel ast.SelectionSet, v model.RedactionMode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRedactionRule2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RedactionRule) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRedactionRule2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRule(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -104439,68 +108602,29 @@ func (ec *executionContext) marshalNRageClickEvent2ᚕᚖgithubᚗcomᚋhighligh
 	return ret
 }
 
-func (ec *executionContext) marshalNRageClickEvent2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐRageClickEvent(ctx context.Context, sel ast.SelectionSet, v *model1.RageClickEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNRedactionRule2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRule(ctx context.Context, sel ast.SelectionSet, v *model.RedactionRule) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RageClickEvent(ctx, sel, v)
+	return ec._RedactionRule(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRageClickEventForProject2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProjectᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RageClickEventForProject) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
-	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalNRageClickEventForProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProject(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
-	}
-	wg.Wait()
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
+func (ec *executionContext) unmarshalNRedactionRuleInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleInput(ctx context.Context, v interface{}) (*model.RedactionRuleInput, error) {
+	res, err := ec.unmarshalInputRedactionRuleInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return ret
+func (ec *executionContext) unmarshalNRedactionRuleType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleType(ctx context.Context, v interface{}) (model.RedactionRuleType, error) {
+	var res model.RedactionRuleType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRageClickEventForProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRageClickEventForProject(ctx context.Context, sel ast.SelectionSet, v *model.RageClickEventForProject) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._RageClickEventForProject(ctx, sel, v)
+func (ec *executionContext) marshalNRedactionRuleType2githubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleType(ctx context.Context, sel ast.SelectionSet, v model.RedactionRuleType) graphql.Marshaler {
+	return v
 }
 
 func (ec *executionContext) marshalNReferrerTablePayload2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐReferrerTablePayload(ctx context.Context, sel ast.SelectionSet, v []*model.ReferrerTablePayload) graphql.Marshaler {
@@ -107523,7 +111647,216 @@ func (ec *executionContext) marshalOGitlabProject2ᚕᚖgithubᚗcomᚋhighlight
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNGitlabProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐGitlabProject(ctx, sel, v[i])
+			ret[i] = ec.marshalNGitlabProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐGitlabProject(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOID2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalIntID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalIntID(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOID2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOID2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2int(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOID2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalIntID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalIntID(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOIngestPreviewError2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestPreviewError(ctx context.Context, sel ast.SelectionSet, v *model.IngestPreviewError) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._IngestPreviewError(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOIngestReason2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx context.Context, v interface{}) (*model.IngestReason, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.IngestReason)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOIngestReason2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIngestReason(ctx context.Context, sel ast.SelectionSet, v *model.IngestReason) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := graphql.MarshalInt64(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt642ᚖint64(ctx context.Context, v interface{}) (*int64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt64(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt642ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt64(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOIntegrationType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIntegrationType(ctx context.Context, v interface{}) (*model.IntegrationType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.IntegrationType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOIntegrationType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIntegrationType(ctx context.Context, sel ast.SelectionSet, v *model.IntegrationType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOInvoice2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐInvoice(ctx context.Context, sel ast.SelectionSet, v *model.Invoice) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Invoice(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx context.Context, sel ast.SelectionSet, v []*model.JiraIssueType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOJiraIssueType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -107534,155 +111867,24 @@ func (ec *executionContext) marshalOGitlabProject2ᚕᚖgithubᚗcomᚋhighlight
 	}
 	wg.Wait()
 
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
 	return ret
 }
 
-func (ec *executionContext) unmarshalOID2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalIntID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOID2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalIntID(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOID2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
-	if v == nil {
-		return nil, nil
-	}
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]int, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNID2int(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) marshalOID2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	ret := make(graphql.Array, len(v))
-	for i := range v {
-		ret[i] = ec.marshalNID2int(ctx, sel, v[i])
-	}
-
-	for _, e := range ret {
-		if e == graphql.Null {
-			return graphql.Null
-		}
-	}
-
-	return ret
-}
-
-func (ec *executionContext) unmarshalOID2ᚖint(ctx context.Context, v interface{}) (*int, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalIntID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOID2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	res := graphql.MarshalIntID(*v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
-	res, err := graphql.UnmarshalInt64(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
-	res := graphql.MarshalInt64(v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalInt(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	res := graphql.MarshalInt(*v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOInt642ᚖint64(ctx context.Context, v interface{}) (*int64, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalInt64(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt642ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	res := graphql.MarshalInt64(*v)
-	return res
-}
-
-func (ec *executionContext) unmarshalOIntegrationType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIntegrationType(ctx context.Context, v interface{}) (*model.IntegrationType, error) {
-	if v == nil {
-		return nil, nil
-	}
-	var res = new(model.IntegrationType)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOIntegrationType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐIntegrationType(ctx context.Context, sel ast.SelectionSet, v *model.IntegrationType) graphql.Marshaler {
+func (ec *executionContext) marshalOJiraIssueType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx context.Context, sel ast.SelectionSet, v *model.JiraIssueType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return v
+	return ec._JiraIssueType(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOInvoice2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐInvoice(ctx context.Context, sel ast.SelectionSet, v *model.Invoice) graphql.Marshaler {
+func (ec *executionContext) marshalOJiraIssueTypeScope2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueTypeScope(ctx context.Context, sel ast.SelectionSet, v *model.JiraIssueTypeScope) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Invoice(ctx, sel, v)
+	return ec._JiraIssueTypeScope(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx context.Context, sel ast.SelectionSet, v []*model.JiraIssueType) graphql.Marshaler {
+func (ec *executionContext) marshalOJiraProject2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.JiraProject) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -107709,7 +111911,7 @@ func (ec *executionContext) marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlight
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOJiraIssueType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx, sel, v[i])
+			ret[i] = ec.marshalNJiraProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProject(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -107720,24 +111922,39 @@ func (ec *executionContext) marshalOJiraIssueType2ᚕᚖgithubᚗcomᚋhighlight
 	}
 	wg.Wait()
 
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
 	return ret
 }
 
-func (ec *executionContext) marshalOJiraIssueType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueType(ctx context.Context, sel ast.SelectionSet, v *model.JiraIssueType) graphql.Marshaler {
+func (ec *executionContext) marshalOJiraProjectIdentifier2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectIdentifier(ctx context.Context, sel ast.SelectionSet, v *model.JiraProjectIdentifier) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._JiraIssueType(ctx, sel, v)
+	return ec._JiraProjectIdentifier(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOJiraIssueTypeScope2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraIssueTypeScope(ctx context.Context, sel ast.SelectionSet, v *model.JiraIssueTypeScope) graphql.Marshaler {
+func (ec *executionContext) unmarshalOKeyType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐKeyType(ctx context.Context, v interface{}) (*model.KeyType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.KeyType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOKeyType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐKeyType(ctx context.Context, sel ast.SelectionSet, v *model.KeyType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._JiraIssueTypeScope(ctx, sel, v)
+	return v
 }
 
-func (ec *executionContext) marshalOJiraProject2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.JiraProject) graphql.Marshaler {
+func (ec *executionContext) marshalOLinearTeam2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLinearTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LinearTeam) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -107764,7 +111981,7 @@ func (ec *executionContext) marshalOJiraProject2ᚕᚖgithubᚗcomᚋhighlight
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNJiraProject2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProject(ctx, sel, v[i])
+			ret[i] = ec.marshalNLinearTeam2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLinearTeam(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -107784,30 +112001,57 @@ func (ec *executionContext) marshalOJiraProject2ᚕᚖgithubᚗcomᚋhighlight
 	return ret
 }
 
-func (ec *executionContext) marshalOJiraProjectIdentifier2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐJiraProjectIdentifier(ctx context.Context, sel ast.SelectionSet, v *model.JiraProjectIdentifier) graphql.Marshaler {
+func (ec *executionContext) marshalOLog2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLog(ctx context.Context, sel ast.SelectionSet, v *model.Log) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._JiraProjectIdentifier(ctx, sel, v)
+	return ec._Log(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOKeyType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐKeyType(ctx context.Context, v interface{}) (*model.KeyType, error) {
+func (ec *executionContext) marshalOLogAlert2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐLogAlert(ctx context.Context, sel ast.SelectionSet, v *model1.LogAlert) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LogAlert(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx context.Context, v interface{}) (*model.LogLevel, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(model.KeyType)
+	var res = new(model.LogLevel)
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOKeyType2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐKeyType(ctx context.Context, sel ast.SelectionSet, v *model.KeyType) graphql.Marshaler {
+func (ec *executionContext) marshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx context.Context, sel ast.SelectionSet, v *model.LogLevel) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return v
 }
 
-func (ec *executionContext) marshalOLinearTeam2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLinearTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LinearTeam) graphql.Marshaler {
+func (ec *executionContext) unmarshalOLogPipelineStageInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageInputᚄ(ctx context.Context, v interface{}) ([]*model.LogPipelineStageInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.LogPipelineStageInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLogPipelineStageInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogPipelineStageInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOLogSeverityMapping2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.LogSeverityMapping) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -107834,7 +112078,7 @@ func (ec *executionContext) marshalOLinearTeam2ᚕᚖgithubᚗcomᚋhighlightᚑ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNLinearTeam2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLinearTeam(ctx, sel, v[i])
+			ret[i] = ec.marshalNLogSeverityMapping2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMapping(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -107854,27 +112098,24 @@ func (ec *executionContext) marshalOLinearTeam2ᚕᚖgithubᚗcomᚋhighlightᚑ
 	return ret
 }
 
-func (ec *executionContext) marshalOLogAlert2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋmodelᚐLogAlert(ctx context.Context, sel ast.SelectionSet, v *model1.LogAlert) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._LogAlert(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx context.Context, v interface{}) (*model.LogLevel, error) {
+func (ec *executionContext) unmarshalOLogSeverityMappingInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingInputᚄ(ctx context.Context, v interface{}) ([]*model.LogSeverityMappingInput, error) {
 	if v == nil {
 		return nil, nil
 	}
-	var res = new(model.LogLevel)
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOLogLevel2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogLevel(ctx context.Context, sel ast.SelectionSet, v *model.LogLevel) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
 	}
-	return v
+	var err error
+	res := make([]*model.LogSeverityMappingInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLogSeverityMappingInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐLogSeverityMappingInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
 func (ec *executionContext) marshalOMatchedErrorTag2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐMatchedErrorTag(ctx context.Context, sel ast.SelectionSet, v []*model.MatchedErrorTag) graphql.Marshaler {
@@ -108185,6 +112426,26 @@ func (ec *executionContext) unmarshalOQueryInput2ᚖgithubᚗcomᚋhighlightᚑr
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalORedactionRuleInput2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleInputᚄ(ctx context.Context, v interface{}) ([]*model.RedactionRuleInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.RedactionRuleInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRedactionRuleInput2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐRedactionRuleInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOReferrerTablePayload2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐReferrerTablePayload(ctx context.Context, sel ast.SelectionSet, v *model.ReferrerTablePayload) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -108701,6 +112962,13 @@ func (ec *executionContext) marshalOTopUsersPayload2ᚖgithubᚗcomᚋhighlight
 	return ec._TopUsersPayload(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTrace2ᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐTrace(ctx context.Context, sel ast.SelectionSet, v *model.Trace) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Trace(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTraceEvent2ᚕᚖgithubᚗcomᚋhighlightᚑrunᚋhighlightᚋbackendᚋprivateᚑgraphᚋgraphᚋmodelᚐTraceEvent(ctx context.Context, sel ast.SelectionSet, v []*model.TraceEvent) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -117,21 +117,23 @@ type AlertStateChangeResults struct {
 }
 
 type AllProjectSettings struct {
-	ID                                int            `json:"id"`
-	VerboseID                         string         `json:"verbose_id"`
-	Name                              string         `json:"name"`
-	BillingEmail                      *string        `json:"billing_email,omitempty"`
-	WorkspaceID                       int            `json:"workspace_id"`
-	ExcludedUsers                     pq.StringArray `json:"excluded_users,omitempty"`
-	ErrorFilters                      pq.StringArray `json:"error_filters,omitempty"`
-	ErrorJSONPaths                    pq.StringArray `json:"error_json_paths,omitempty"`
-	RageClickWindowSeconds            *int           `json:"rage_click_window_seconds,omitempty"`
-	RageClickRadiusPixels             *int           `json:"rage_click_radius_pixels,omitempty"`
-	RageClickCount                    *int           `json:"rage_click_count,omitempty"`
-	FilterChromeExtension             *bool          `json:"filter_chrome_extension,omitempty"`
-	FilterSessionsWithoutError        bool           `json:"filterSessionsWithoutError"`
-	AutoResolveStaleErrorsDayInterval int            `json:"autoResolveStaleErrorsDayInterval"`
-	Sampling                          *Sampling      `json:"sampling"`
+	ID                                int                 `json:"id"`
+	VerboseID                         string              `json:"verbose_id"`
+	Name                              string              `json:"name"`
+	BillingEmail                      *string             `json:"billing_email,omitempty"`
+	WorkspaceID                       int                 `json:"workspace_id"`
+	ExcludedUsers                     pq.StringArray      `json:"excluded_users,omitempty"`
+	ErrorFilters                      pq.StringArray      `json:"error_filters,omitempty"`
+	ErrorJSONPaths                    pq.StringArray      `json:"error_json_paths,omitempty"`
+	RageClickWindowSeconds            *int                `json:"rage_click_window_seconds,omitempty"`
+	RageClickRadiusPixels             *int                `json:"rage_click_radius_pixels,omitempty"`
+	RageClickCount                    *int                `json:"rage_click_count,omitempty"`
+	FilterChromeExtension             *bool               `json:"filter_chrome_extension,omitempty"`
+	FilterSessionsWithoutError        bool                `json:"filterSessionsWithoutError"`
+	AutoResolveStaleErrorsDayInterval int                 `json:"autoResolveStaleErrorsDayInterval"`
+	Sampling                          *Sampling           `json:"sampling"`
+	LogPipeline                       []*LogPipelineStage `json:"log_pipeline"`
+	RedactionRules                    []*RedactionRule    `json:"redaction_rules"`
 }
 
 type AverageSessionLength struct {
@@ -459,6 +461,36 @@ type HistogramBucket struct {
 	Count      int     `json:"count"`
 }
 
+type IngestDrop struct {
+	Reason IngestReason `json:"reason"`
+	Count  uint64       `json:"count"`
+}
+
+type IngestPreviewError struct {
+	Event       string    `json:"event"`
+	Type        string    `json:"type"`
+	Source      string    `json:"source"`
+	StackTrace  string    `json:"stack_trace"`
+	Timestamp   time.Time `json:"timestamp"`
+	ServiceName string    `json:"service_name"`
+	Environment string    `json:"environment"`
+	TraceID     *string   `json:"trace_id,omitempty"`
+	SpanID      *string   `json:"span_id,omitempty"`
+}
+
+type IngestPreviewItem struct {
+	Product           ProductType         `json:"product"`
+	Log               *Log                `json:"log,omitempty"`
+	Trace             *Trace              `json:"trace,omitempty"`
+	Error             *IngestPreviewError `json:"error,omitempty"`
+	Ingested          bool                `json:"ingested"`
+	Reason            *IngestReason       `json:"reason,omitempty"`
+	ExclusionQuery    *string             `json:"exclusion_query,omitempty"`
+	QuotaExceeded     bool                `json:"quota_exceeded"`
+	DroppedByPipeline bool                `json:"dropped_by_pipeline"`
+	TailSampled       bool                `json:"tail_sampled"`
+}
+
 type IntegrationProjectMappingInput struct {
 	ProjectID  int    `json:"project_id"`
 	ExternalID string `json:"external_id"`
@@ -584,6 +616,38 @@ type LogLine struct {
 	Labels    string    `json:"labels"`
 }
 
+type LogPipelineStage struct {
+	Type            LogPipelineStageType  `json:"type"`
+	Query           string                `json:"query"`
+	Pattern         string                `json:"pattern"`
+	Source          string                `json:"source"`
+	Target          string                `json:"target"`
+	Level           *LogLevel             `json:"level,omitempty"`
+	SeverityMapping []*LogSeverityMapping `json:"severity_mapping,omitempty"`
+	ProjectID       *int                  `json:"project_id,omitempty"`
+}
+
+type LogPipelineStageInput struct {
+	Type            LogPipelineStageType       `json:"type"`
+	Query           *string                    `json:"query,omitempty"`
+	Pattern         *string                    `json:"pattern,omitempty"`
+	Source          *string                    `json:"source,omitempty"`
+	Target          *string                    `json:"target,omitempty"`
+	Level           *LogLevel                  `json:"level,omitempty"`
+	SeverityMapping []*LogSeverityMappingInput `json:"severity_mapping,omitempty"`
+	ProjectID       *int                       `json:"project_id,omitempty"`
+}
+
+type LogSeverityMapping struct {
+	From  string   `json:"from"`
+	Level LogLevel `json:"level"`
+}
+
+type LogSeverityMappingInput struct {
+	From  string   `json:"from"`
+	Level LogLevel `json:"level"`
+}
+
 type LogsHistogram struct {
 	Buckets      []*LogsHistogramBucket `json:"buckets"`
 	TotalCount   uint64                 `json:"totalCount"`
@@ -715,6 +779,18 @@ type RageClickEventForProject struct {
 	UserProperties  string `json:"user_properties"`
 }
 
+type RedactionRule struct {
+	Type    RedactionRuleType `json:"type"`
+	Pattern string            `json:"pattern"`
+	Mode    RedactionMode     `json:"mode"`
+}
+
+type RedactionRuleInput struct {
+	Type    RedactionRuleType `json:"type"`
+	Pattern *string           `json:"pattern,omitempty"`
+	Mode    RedactionMode     `json:"mode"`
+}
+
 type ReferrerTablePayload struct {
 	Host    string  `json:"host"`
 	Count   int     `json:"count"`
@@ -726,33 +802,63 @@ type S3File struct {
 }
 
 type Sampling struct {
-	SessionSamplingRate    float64 `json:"session_sampling_rate"`
-	ErrorSamplingRate      float64 `json:"error_sampling_rate"`
-	LogSamplingRate        float64 `json:"log_sampling_rate"`
-	TraceSamplingRate      float64 `json:"trace_sampling_rate"`
-	SessionMinuteRateLimit *int64  `json:"session_minute_rate_limit,omitempty"`
-	ErrorMinuteRateLimit   *int64  `json:"error_minute_rate_limit,omitempty"`
-	LogMinuteRateLimit     *int64  `json:"log_minute_rate_limit,omitempty"`
-	TraceMinuteRateLimit   *int64  `json:"trace_minute_rate_limit,omitempty"`
-	SessionExclusionQuery  *string `json:"session_exclusion_query,omitempty"`
-	ErrorExclusionQuery    *string `json:"error_exclusion_query,omitempty"`
-	LogExclusionQuery      *string `json:"log_exclusion_query,omitempty"`
-	TraceExclusionQuery    *string `json:"trace_exclusion_query,omitempty"`
+	SessionSamplingRate            float64 `json:"session_sampling_rate"`
+	ErrorSamplingRate              float64 `json:"error_sampling_rate"`
+	LogSamplingRate                float64 `json:"log_sampling_rate"`
+	TraceSamplingRate              float64 `json:"trace_sampling_rate"`
+	SessionMinuteRateLimit         *int64  `json:"session_minute_rate_limit,omitempty"`
+	ErrorMinuteRateLimit           *int64  `json:"error_minute_rate_limit,omitempty"`
+	LogMinuteRateLimit             *int64  `json:"log_minute_rate_limit,omitempty"`
+	TraceMinuteRateLimit           *int64  `json:"trace_minute_rate_limit,omitempty"`
+	SessionMinuteRateLimitBurst    *int64  `json:"session_minute_rate_limit_burst,omitempty"`
+	ErrorMinuteRateLimitBurst      *int64  `json:"error_minute_rate_limit_burst,omitempty"`
+	LogMinuteRateLimitBurst        *int64  `json:"log_minute_rate_limit_burst,omitempty"`
+	TraceMinuteRateLimitBurst      *int64  `json:"trace_minute_rate_limit_burst,omitempty"`
+	SessionMinuteRateLimitKey      *string `json:"session_minute_rate_limit_key,omitempty"`
+	ErrorMinuteRateLimitKey        *string `json:"error_minute_rate_limit_key,omitempty"`
+	LogMinuteRateLimitKey          *string `json:"log_minute_rate_limit_key,omitempty"`
+	TraceMinuteRateLimitKey        *string `json:"trace_minute_rate_limit_key,omitempty"`
+	SessionExclusionQuery          *string `json:"session_exclusion_query,omitempty"`
+	ErrorExclusionQuery            *string `json:"error_exclusion_query,omitempty"`
+	LogExclusionQuery              *string `json:"log_exclusion_query,omitempty"`
+	TraceExclusionQuery            *string `json:"trace_exclusion_query,omitempty"`
+	TraceTailSampling              bool    `json:"trace_tail_sampling"`
+	TraceTailSamplingMinDurationMs *int64  `json:"trace_tail_sampling_min_duration_ms,omitempty"`
+	TraceTailSamplingQuery         *string `json:"trace_tail_sampling_query,omitempty"`
+	LogAdaptiveSamplingTarget      *int64  `json:"log_adaptive_sampling_target,omitempty"`
+	LogAdaptiveSamplingKey         *string `json:"log_adaptive_sampling_key,omitempty"`
+	TraceAdaptiveSamplingTarget    *int64  `json:"trace_adaptive_sampling_target,omitempty"`
+	TraceAdaptiveSamplingKey       *string `json:"trace_adaptive_sampling_key,omitempty"`
 }
 
 type SamplingInput struct {
-	SessionSamplingRate    *float64 `json:"session_sampling_rate,omitempty"`
-	ErrorSamplingRate      *float64 `json:"error_sampling_rate,omitempty"`
-	LogSamplingRate        *float64 `json:"log_sampling_rate,omitempty"`
-	TraceSamplingRate      *float64 `json:"trace_sampling_rate,omitempty"`
-	SessionMinuteRateLimit *int64   `json:"session_minute_rate_limit,omitempty"`
-	ErrorMinuteRateLimit   *int64   `json:"error_minute_rate_limit,omitempty"`
-	LogMinuteRateLimit     *int64   `json:"log_minute_rate_limit,omitempty"`
-	TraceMinuteRateLimit   *int64   `json:"trace_minute_rate_limit,omitempty"`
-	SessionExclusionQuery  *string  `json:"session_exclusion_query,omitempty"`
-	ErrorExclusionQuery    *string  `json:"error_exclusion_query,omitempty"`
-	LogExclusionQuery      *string  `json:"log_exclusion_query,omitempty"`
-	TraceExclusionQuery    *string  `json:"trace_exclusion_query,omitempty"`
+	SessionSamplingRate            *float64 `json:"session_sampling_rate,omitempty"`
+	ErrorSamplingRate              *float64 `json:"error_sampling_rate,omitempty"`
+	LogSamplingRate                *float64 `json:"log_sampling_rate,omitempty"`
+	TraceSamplingRate              *float64 `json:"trace_sampling_rate,omitempty"`
+	SessionMinuteRateLimit         *int64   `json:"session_minute_rate_limit,omitempty"`
+	ErrorMinuteRateLimit           *int64   `json:"error_minute_rate_limit,omitempty"`
+	LogMinuteRateLimit             *int64   `json:"log_minute_rate_limit,omitempty"`
+	TraceMinuteRateLimit           *int64   `json:"trace_minute_rate_limit,omitempty"`
+	SessionMinuteRateLimitBurst    *int64   `json:"session_minute_rate_limit_burst,omitempty"`
+	ErrorMinuteRateLimitBurst      *int64   `json:"error_minute_rate_limit_burst,omitempty"`
+	LogMinuteRateLimitBurst        *int64   `json:"log_minute_rate_limit_burst,omitempty"`
+	TraceMinuteRateLimitBurst      *int64   `json:"trace_minute_rate_limit_burst,omitempty"`
+	SessionMinuteRateLimitKey      *string  `json:"session_minute_rate_limit_key,omitempty"`
+	ErrorMinuteRateLimitKey        *string  `json:"error_minute_rate_limit_key,omitempty"`
+	LogMinuteRateLimitKey          *string  `json:"log_minute_rate_limit_key,omitempty"`
+	TraceMinuteRateLimitKey        *string  `json:"trace_minute_rate_limit_key,omitempty"`
+	SessionExclusionQuery          *string  `json:"session_exclusion_query,omitempty"`
+	ErrorExclusionQuery            *string  `json:"error_exclusion_query,omitempty"`
+	LogExclusionQuery              *string  `json:"log_exclusion_query,omitempty"`
+	TraceExclusionQuery            *string  `json:"trace_exclusion_query,omitempty"`
+	TraceTailSampling              *bool    `json:"trace_tail_sampling,omitempty"`
+	TraceTailSamplingMinDurationMs *int64   `json:"trace_tail_sampling_min_duration_ms,omitempty"`
+	TraceTailSamplingQuery         *string  `json:"trace_tail_sampling_query,omitempty"`
+	LogAdaptiveSamplingTarget      *int64   `json:"log_adaptive_sampling_target,omitempty"`
+	LogAdaptiveSamplingKey         *string  `json:"log_adaptive_sampling_key,omitempty"`
+	TraceAdaptiveSamplingTarget    *int64   `json:"trace_adaptive_sampling_target,omitempty"`
+	TraceAdaptiveSamplingKey       *string  `json:"trace_adaptive_sampling_key,omitempty"`
 }
 
 type SanitizedAdmin struct {
@@ -1362,17 +1468,19 @@ const (
 	IngestReasonSample IngestReason = "Sample"
 	IngestReasonRate   IngestReason = "Rate"
 	IngestReasonFilter IngestReason = "Filter"
+	IngestReasonQuota  IngestReason = "Quota"
 )
 
 var AllIngestReason = []IngestReason{
 	IngestReasonSample,
 	IngestReasonRate,
 	IngestReasonFilter,
+	IngestReasonQuota,
 }
 
 func (e IngestReason) IsValid() bool {
 	switch e {
-	case IngestReasonSample, IngestReasonRate, IngestReasonFilter:
+	case IngestReasonSample, IngestReasonRate, IngestReasonFilter, IngestReasonQuota:
 		return true
 	}
 	return false
@@ -1560,6 +1668,59 @@ func (e LogLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LogPipelineStageType string
+
+const (
+	LogPipelineStageTypeParse     LogPipelineStageType = "Parse"
+	LogPipelineStageTypeParseJSON LogPipelineStageType = "ParseJSON"
+	LogPipelineStageTypeRename    LogPipelineStageType = "Rename"
+	LogPipelineStageTypeCopy      LogPipelineStageType = "Copy"
+	LogPipelineStageTypeDrop      LogPipelineStageType = "Drop"
+	LogPipelineStageTypeDropLog   LogPipelineStageType = "DropLog"
+	LogPipelineStageTypeSeverity  LogPipelineStageType = "Severity"
+	LogPipelineStageTypeRoute     LogPipelineStageType = "Route"
+)
+
+var AllLogPipelineStageType = []LogPipelineStageType{
+	LogPipelineStageTypeParse,
+	LogPipelineStageTypeParseJSON,
+	LogPipelineStageTypeRename,
+	LogPipelineStageTypeCopy,
+	LogPipelineStageTypeDrop,
+	LogPipelineStageTypeDropLog,
+	LogPipelineStageTypeSeverity,
+	LogPipelineStageTypeRoute,
+}
+
+func (e LogPipelineStageType) IsValid() bool {
+	switch e {
+	case LogPipelineStageTypeParse, LogPipelineStageTypeParseJSON, LogPipelineStageTypeRename, LogPipelineStageTypeCopy, LogPipelineStageTypeDrop, LogPipelineStageTypeDropLog, LogPipelineStageTypeSeverity, LogPipelineStageTypeRoute:
+		return true
+	}
+	return false
+}
+
+func (e LogPipelineStageType) String() string {
+	return string(e)
+}
+
+func (e *LogPipelineStageType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogPipelineStageType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogPipelineStageType", str)
+	}
+	return nil
+}
+
+func (e LogPipelineStageType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type LogSource string
 
 const (
@@ -1615,6 +1776,8 @@ const (
 	MetricAggregatorP99              MetricAggregator = "P99"
 	MetricAggregatorMax              MetricAggregator = "Max"
 	MetricAggregatorSum              MetricAggregator = "Sum"
+	MetricAggregatorRate             MetricAggregator = "Rate"
+	MetricAggregatorIncrease         MetricAggregator = "Increase"
 	MetricAggregatorNone             MetricAggregator = "None"
 )
 
@@ -1630,12 +1793,14 @@ var AllMetricAggregator = []MetricAggregator{
 	MetricAggregatorP99,
 	MetricAggregatorMax,
 	MetricAggregatorSum,
+	MetricAggregatorRate,
+	MetricAggregatorIncrease,
 	MetricAggregatorNone,
 }
 
 func (e MetricAggregator) IsValid() bool {
 	switch e {
-	case MetricAggregatorCount, MetricAggregatorCountDistinct, MetricAggregatorCountDistinctKey, MetricAggregatorMin, MetricAggregatorAvg, MetricAggregatorP50, MetricAggregatorP90, MetricAggregatorP95, MetricAggregatorP99, MetricAggregatorMax, MetricAggregatorSum, MetricAggregatorNone:
+	case MetricAggregatorCount, MetricAggregatorCountDistinct, MetricAggregatorCountDistinctKey, MetricAggregatorMin, MetricAggregatorAvg, MetricAggregatorP50, MetricAggregatorP90, MetricAggregatorP95, MetricAggregatorP99, MetricAggregatorMax, MetricAggregatorSum, MetricAggregatorRate, MetricAggregatorIncrease, MetricAggregatorNone:
 		return true
 	}
 	return false
@@ -2004,12 +2169,13 @@ func (e PlanType) MarshalGQL(w io.Writer) {
 type ProductType string
 
 const (
-	ProductTypeSessions ProductType = "Sessions"
-	ProductTypeErrors   ProductType = "Errors"
-	ProductTypeLogs     ProductType = "Logs"
-	ProductTypeTraces   ProductType = "Traces"
-	ProductTypeMetrics  ProductType = "Metrics"
-	ProductTypeEvents   ProductType = "Events"
+	ProductTypeSessions    ProductType = "Sessions"
+	ProductTypeErrors      ProductType = "Errors"
+	ProductTypeLogs        ProductType = "Logs"
+	ProductTypeTraces      ProductType = "Traces"
+	ProductTypeMetrics     ProductType = "Metrics"
+	ProductTypeEvents      ProductType = "Events"
+	ProductTypeIngestDrops ProductType = "IngestDrops"
 )
 
 var AllProductType = []ProductType{
@@ -2019,11 +2185,12 @@ var AllProductType = []ProductType{
 	ProductTypeTraces,
 	ProductTypeMetrics,
 	ProductTypeEvents,
+	ProductTypeIngestDrops,
 }
 
 func (e ProductType) IsValid() bool {
 	switch e {
-	case ProductTypeSessions, ProductTypeErrors, ProductTypeLogs, ProductTypeTraces, ProductTypeMetrics, ProductTypeEvents:
+	case ProductTypeSessions, ProductTypeErrors, ProductTypeLogs, ProductTypeTraces, ProductTypeMetrics, ProductTypeEvents, ProductTypeIngestDrops:
 		return true
 	}
 	return false
@@ -2050,6 +2217,96 @@ func (e ProductType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RedactionMode string
+
+const (
+	RedactionModeMask RedactionMode = "Mask"
+	RedactionModeHash RedactionMode = "Hash"
+)
+
+var AllRedactionMode = []RedactionMode{
+	RedactionModeMask,
+	RedactionModeHash,
+}
+
+func (e RedactionMode) IsValid() bool {
+	switch e {
+	case RedactionModeMask, RedactionModeHash:
+		return true
+	}
+	return false
+}
+
+func (e RedactionMode) String() string {
+	return string(e)
+}
+
+func (e *RedactionMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RedactionMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RedactionMode", str)
+	}
+	return nil
+}
+
+func (e RedactionMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RedactionRuleType string
+
+const (
+	RedactionRuleTypeEmail        RedactionRuleType = "Email"
+	RedactionRuleTypeCreditCard   RedactionRuleType = "CreditCard"
+	RedactionRuleTypeJwt          RedactionRuleType = "JWT"
+	RedactionRuleTypeAWSKey       RedactionRuleType = "AWSKey"
+	RedactionRuleTypeRegex        RedactionRuleType = "Regex"
+	RedactionRuleTypeAttributeKey RedactionRuleType = "AttributeKey"
+)
+
+var AllRedactionRuleType = []RedactionRuleType{
+	RedactionRuleTypeEmail,
+	RedactionRuleTypeCreditCard,
+	RedactionRuleTypeJwt,
+	RedactionRuleTypeAWSKey,
+	RedactionRuleTypeRegex,
+	RedactionRuleTypeAttributeKey,
+}
+
+func (e RedactionRuleType) IsValid() bool {
+	switch e {
+	case RedactionRuleTypeEmail, RedactionRuleTypeCreditCard, RedactionRuleTypeJwt, RedactionRuleTypeAWSKey, RedactionRuleTypeRegex, RedactionRuleTypeAttributeKey:
+		return true
+	}
+	return false
+}
+
+func (e RedactionRuleType) String() string {
+	return string(e)
+}
+
+func (e *RedactionRuleType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RedactionRuleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RedactionRuleType", str)
+	}
+	return nil
+}
+
+func (e RedactionRuleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ReservedErrorGroupKey string
 
 const (
@@ -49,6 +49,7 @@ import (
 	kafka_queue "github.com/highlight-run/highlight/backend/kafka-queue"
 	"github.com/highlight-run/highlight/backend/lambda"
 	"github.com/highlight-run/highlight/backend/oauth"
+	"github.com/highlight-run/highlight/backend/pipeline"
 	"github.com/highlight-run/highlight/backend/redis"
 	"github.com/highlight-run/highlight/backend/stepfunctions"
 	"github.com/highlight-run/highlight/backend/store"
@@ -77,6 +78,7 @@ import (
 	"github.com/highlight-run/highlight/backend/model"
 	"github.com/highlight-run/highlight/backend/pricing"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/promql"
 	"github.com/highlight-run/highlight/backend/storage"
 	"github.com/highlight-run/highlight/backend/util"
 	"github.com/highlight/highlight/sdk/highlight-go"
@@ -159,6 +161,13 @@ type Resolver struct {
 	TracesQueue            kafka_queue.MessageQueue
 	EmbeddingsClient       embeddings.Client
 	OpenAiClient           openai_client.OpenAiInterface
+	IngestPreviewer        IngestPreviewer
+}
+
+// IngestPreviewer previews how a project would ingest an OTLP payload without ingesting it.
+// Settings, when set, are previewed in place of the saved filter settings of the project.
+type IngestPreviewer interface {
+	PreviewIngest(ctx context.Context, projectID int, payload []byte, settings *model.ProjectFilterSettings) ([]*modelInputs.IngestPreviewItem, error)
 }
 
 func (r *mutationResolver) Transaction(body func(txnR *mutationResolver) error) error {
@@ -590,6 +599,27 @@ func (r *Resolver) isUserInWorkspace(ctx context.Context, workspaceID int) (*mod
 	return &workspace, nil
 }
 
+// validateLogPipeline compiles the stages of a log pipeline, and checks that logs are only routed
+// to projects of the same workspace that the admin has access to.
+func (r *Resolver) validateLogPipeline(ctx context.Context, project *model.Project, logPipeline model.LogPipeline) error {
+	if _, err := pipeline.New(logPipeline); err != nil {
+		return err
+	}
+	for _, stage := range logPipeline {
+		if stage.Type != modelInputs.LogPipelineStageTypeRoute || stage.ProjectID == nil {
+			continue
+		}
+		destination, err := r.isUserInProject(ctx, *stage.ProjectID)
+		if err != nil {
+			return err
+		}
+		if destination.WorkspaceID != project.WorkspaceID {
+			return e.New("logs can only be routed to projects in the same workspace")
+		}
+	}
+	return nil
+}
+
 // isUserInProject should be used for actions that you only want admins in all projects to have access to.
 // Use this on actions that you don't want laymen in the demo project to have access to.
 func (r *Resolver) isUserInProject(ctx context.Context, project_id int) (*model.Project, error) {
@@ -1720,6 +1750,8 @@ const (
 	expClaimName       = "exp"
 	projectIdUrlParam  = "project_id"
 	hashValUrlParam    = "hash_val"
+
+	promQLProjectQueryParam = "project"
 )
 
 func getProjectCookieName(projectId int) string {
@@ -1837,6 +1869,45 @@ func (r *Resolver) AssetHandler(w http.ResponseWriter, req *http.Request) {
 	http.Redirect(w, req, url, http.StatusFound)
 }
 
+// getPromQLProject authorizes the project of a promql api request, provided by the
+// project header or query string parameter as configured on the grafana data source.
+func (r *Resolver) getPromQLProject(w http.ResponseWriter, req *http.Request) (int, bool) {
+	ctx := req.Context()
+	projectVerboseID := req.Header.Get(highlight.ProjectIDHeader)
+	if projectVerboseID == "" {
+		projectVerboseID = req.URL.Query().Get(promQLProjectQueryParam)
+	}
+	projectID, err := model.FromVerboseID(projectVerboseID)
+	if err != nil {
+		promql.WriteError(w, http.StatusBadRequest, promql.ErrorTypeBadData, e.New("invalid project id"))
+		return 0, false
+	}
+
+	project, err := r.isUserInProjectOrDemoProject(ctx, projectID)
+	if err != nil {
+		log.WithContext(ctx).Error(err)
+		promql.WriteError(w, http.StatusForbidden, promql.ErrorTypeBadData, e.New("not authorized to access project"))
+		return 0, false
+	}
+	return project.ID, true
+}
+
+func (r *Resolver) PromQLQueryHandler(w http.ResponseWriter, req *http.Request) {
+	projectID, ok := r.getPromQLProject(w, req)
+	if !ok {
+		return
+	}
+	promql.HandleQuery(w, req, projectID, r.ClickhouseClient.ReadMetrics)
+}
+
+func (r *Resolver) PromQLQueryRangeHandler(w http.ResponseWriter, req *http.Request) {
+	projectID, ok := r.getPromQLProject(w, req)
+	if !ok {
+		return
+	}
+	promql.HandleQueryRange(w, req, projectID, r.ClickhouseClient.ReadMetrics)
+}
+
 func (r *Resolver) StripeWebhook(ctx context.Context, endpointSecret string) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
 		const MaxBodyBytes = int64(65536)
@@ -53,6 +53,7 @@ import (
 	"github.com/highlight-run/highlight/backend/private-graph/graph/generated"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/prompts"
+	"github.com/highlight-run/highlight/backend/redact"
 	"github.com/highlight-run/highlight/backend/redis"
 	"github.com/highlight-run/highlight/backend/storage"
 	"github.com/highlight-run/highlight/backend/store"
@@ -616,8 +617,26 @@ func (r *mutationResolver) EditProject(ctx context.Context, id int, name *string
 }
 
 // EditProjectSettings is the resolver for the editProjectSettings field.
-func (r *mutationResolver) EditProjectSettings(ctx context.Context, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *modelInputs.SamplingInput) (*modelInputs.AllProjectSettings, error) {
-	project, err := r.EditProject(ctx, projectID, name, billingEmail, excludedUsers, errorFilters, errorJSONPaths, rageClickWindowSeconds, rageClickRadiusPixels, rageClickCount, filterChromeExtension)
+func (r *mutationResolver) EditProjectSettings(ctx context.Context, projectID int, name *string, billingEmail *string, excludedUsers pq.StringArray, errorFilters pq.StringArray, errorJSONPaths pq.StringArray, rageClickWindowSeconds *int, rageClickRadiusPixels *int, rageClickCount *int, filterChromeExtension *bool, filterSessionsWithoutError *bool, autoResolveStaleErrorsDayInterval *int, sampling *modelInputs.SamplingInput, logPipeline []*modelInputs.LogPipelineStageInput, redactionRules []*modelInputs.RedactionRuleInput) (*modelInputs.AllProjectSettings, error) {
+	// the filter settings are validated before any settings are saved
+	project, err := r.isUserInProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if logPipeline != nil {
+		if err := r.validateLogPipeline(ctx, project, store.GetLogPipeline(logPipeline)); err != nil {
+			return nil, err
+		}
+	}
+
+	if redactionRules != nil {
+		if _, err := redact.New(project.ID, store.GetRedactionRules(redactionRules)); err != nil {
+			return nil, err
+		}
+	}
+
+	project, err = r.EditProject(ctx, projectID, name, billingEmail, excludedUsers, errorFilters, errorJSONPaths, rageClickWindowSeconds, rageClickRadiusPixels, rageClickCount, filterChromeExtension)
 	if err != nil {
 		return nil, err
 	}
@@ -639,6 +658,8 @@ func (r *mutationResolver) EditProjectSettings(ctx context.Context, projectID in
 		FilterSessionsWithoutError:        filterSessionsWithoutError,
 		AutoResolveStaleErrorsDayInterval: autoResolveStaleErrorsDayInterval,
 		Sampling:                          sampling,
+		LogPipeline:                       logPipeline,
+		RedactionRules:                    redactionRules,
 	})
 	if err != nil {
 		return nil, err
@@ -646,19 +667,36 @@ func (r *mutationResolver) EditProjectSettings(ctx context.Context, projectID in
 	allProjectSettings.FilterSessionsWithoutError = projectFilterSettings.FilterSessionsWithoutError
 	allProjectSettings.AutoResolveStaleErrorsDayInterval = projectFilterSettings.AutoResolveStaleErrorsDayInterval
 	allProjectSettings.Sampling = &modelInputs.Sampling{
-		SessionSamplingRate:    projectFilterSettings.SessionSamplingRate,
-		ErrorSamplingRate:      projectFilterSettings.SessionSamplingRate,
-		LogSamplingRate:        projectFilterSettings.SessionSamplingRate,
-		TraceSamplingRate:      projectFilterSettings.SessionSamplingRate,
-		SessionMinuteRateLimit: projectFilterSettings.SessionMinuteRateLimit,
-		ErrorMinuteRateLimit:   projectFilterSettings.ErrorMinuteRateLimit,
-		LogMinuteRateLimit:     projectFilterSettings.LogMinuteRateLimit,
-		TraceMinuteRateLimit:   projectFilterSettings.TraceMinuteRateLimit,
-		SessionExclusionQuery:  projectFilterSettings.SessionExclusionQuery,
-		ErrorExclusionQuery:    projectFilterSettings.ErrorExclusionQuery,
-		LogExclusionQuery:      projectFilterSettings.LogExclusionQuery,
-		TraceExclusionQuery:    projectFilterSettings.TraceExclusionQuery,
-	}
+		SessionSamplingRate:            projectFilterSettings.SessionSamplingRate,
+		ErrorSamplingRate:              projectFilterSettings.SessionSamplingRate,
+		LogSamplingRate:                projectFilterSettings.SessionSamplingRate,
+		TraceSamplingRate:              projectFilterSettings.SessionSamplingRate,
+		SessionMinuteRateLimit:         projectFilterSettings.SessionMinuteRateLimit,
+		ErrorMinuteRateLimit:           projectFilterSettings.ErrorMinuteRateLimit,
+		LogMinuteRateLimit:             projectFilterSettings.LogMinuteRateLimit,
+		TraceMinuteRateLimit:           projectFilterSettings.TraceMinuteRateLimit,
+		SessionMinuteRateLimitBurst:    projectFilterSettings.SessionMinuteRateLimitBurst,
+		ErrorMinuteRateLimitBurst:      projectFilterSettings.ErrorMinuteRateLimitBurst,
+		LogMinuteRateLimitBurst:        projectFilterSettings.LogMinuteRateLimitBurst,
+		TraceMinuteRateLimitBurst:      projectFilterSettings.TraceMinuteRateLimitBurst,
+		SessionMinuteRateLimitKey:      projectFilterSettings.SessionMinuteRateLimitKey,
+		ErrorMinuteRateLimitKey:        projectFilterSettings.ErrorMinuteRateLimitKey,
+		LogMinuteRateLimitKey:          projectFilterSettings.LogMinuteRateLimitKey,
+		TraceMinuteRateLimitKey:        projectFilterSettings.TraceMinuteRateLimitKey,
+		SessionExclusionQuery:          projectFilterSettings.SessionExclusionQuery,
+		ErrorExclusionQuery:            projectFilterSettings.ErrorExclusionQuery,
+		LogExclusionQuery:              projectFilterSettings.LogExclusionQuery,
+		TraceExclusionQuery:            projectFilterSettings.TraceExclusionQuery,
+		TraceTailSampling:              projectFilterSettings.TraceTailSampling,
+		TraceTailSamplingMinDurationMs: projectFilterSettings.TraceTailSamplingMinDurationMs,
+		TraceTailSamplingQuery:         projectFilterSettings.TraceTailSamplingQuery,
+		LogAdaptiveSamplingTarget:      projectFilterSettings.LogAdaptiveSamplingTarget,
+		LogAdaptiveSamplingKey:         projectFilterSettings.LogAdaptiveSamplingKey,
+		TraceAdaptiveSamplingTarget:    projectFilterSettings.TraceAdaptiveSamplingTarget,
+		TraceAdaptiveSamplingKey:       projectFilterSettings.TraceAdaptiveSamplingKey,
+	}
+	allProjectSettings.LogPipeline = append([]*modelInputs.LogPipelineStage{}, projectFilterSettings.LogPipeline...)
+	allProjectSettings.RedactionRules = append([]*modelInputs.RedactionRule{}, projectFilterSettings.RedactionRules...)
 
 	return &allProjectSettings, nil
 }
@@ -6978,6 +7016,25 @@ func (r *queryResolver) UsageHistory(ctx context.Context, workspaceID int, produ
 	}, nil
 }
 
+// IngestDrops is the resolver for the ingestDrops field.
+func (r *queryResolver) IngestDrops(ctx context.Context, workspaceID int, productType modelInputs.ProductType, dateRange modelInputs.DateRangeRequiredInput) ([]*modelInputs.IngestDrop, error) {
+	_, err := r.isUserInWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, nil
+	}
+
+	workspace, err := r.Query().Workspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIds := lo.Map(workspace.Projects, func(p model.Project, _ int) int {
+		return p.ID
+	})
+
+	return r.ClickhouseClient.ReadWorkspaceIngestDrops(ctx, projectIds, productType, dateRange.StartDate, dateRange.EndDate)
+}
+
 // FieldSuggestion is the resolver for the field_suggestion field.
 func (r *queryResolver) FieldSuggestion(ctx context.Context, projectID int, name string, query string) ([]*model.Field, error) {
 	fields := []*model.Field{}
@@ -8065,24 +8122,65 @@ func (r *queryResolver) ProjectSettings(ctx context.Context, projectID int) (*mo
 		FilterSessionsWithoutError:        projectFilterSettings.FilterSessionsWithoutError,
 		AutoResolveStaleErrorsDayInterval: projectFilterSettings.AutoResolveStaleErrorsDayInterval,
 		Sampling: &modelInputs.Sampling{
-			SessionSamplingRate:    projectFilterSettings.SessionSamplingRate,
-			ErrorSamplingRate:      projectFilterSettings.ErrorSamplingRate,
-			LogSamplingRate:        projectFilterSettings.LogSamplingRate,
-			TraceSamplingRate:      projectFilterSettings.TraceSamplingRate,
-			SessionMinuteRateLimit: projectFilterSettings.SessionMinuteRateLimit,
-			ErrorMinuteRateLimit:   projectFilterSettings.ErrorMinuteRateLimit,
-			LogMinuteRateLimit:     projectFilterSettings.LogMinuteRateLimit,
-			TraceMinuteRateLimit:   projectFilterSettings.TraceMinuteRateLimit,
-			SessionExclusionQuery:  projectFilterSettings.SessionExclusionQuery,
-			ErrorExclusionQuery:    projectFilterSettings.ErrorExclusionQuery,
-			LogExclusionQuery:      projectFilterSettings.LogExclusionQuery,
-			TraceExclusionQuery:    projectFilterSettings.TraceExclusionQuery,
+			SessionSamplingRate:            projectFilterSettings.SessionSamplingRate,
+			ErrorSamplingRate:              projectFilterSettings.ErrorSamplingRate,
+			LogSamplingRate:                projectFilterSettings.LogSamplingRate,
+			TraceSamplingRate:              projectFilterSettings.TraceSamplingRate,
+			SessionMinuteRateLimit:         projectFilterSettings.SessionMinuteRateLimit,
+			ErrorMinuteRateLimit:           projectFilterSettings.ErrorMinuteRateLimit,
+			LogMinuteRateLimit:             projectFilterSettings.LogMinuteRateLimit,
+			TraceMinuteRateLimit:           projectFilterSettings.TraceMinuteRateLimit,
+			SessionMinuteRateLimitBurst:    projectFilterSettings.SessionMinuteRateLimitBurst,
+			ErrorMinuteRateLimitBurst:      projectFilterSettings.ErrorMinuteRateLimitBurst,
+			LogMinuteRateLimitBurst:        projectFilterSettings.LogMinuteRateLimitBurst,
+			TraceMinuteRateLimitBurst:      projectFilterSettings.TraceMinuteRateLimitBurst,
+			SessionMinuteRateLimitKey:      projectFilterSettings.SessionMinuteRateLimitKey,
+			ErrorMinuteRateLimitKey:        projectFilterSettings.ErrorMinuteRateLimitKey,
+			LogMinuteRateLimitKey:          projectFilterSettings.LogMinuteRateLimitKey,
+			TraceMinuteRateLimitKey:        projectFilterSettings.TraceMinuteRateLimitKey,
+			SessionExclusionQuery:          projectFilterSettings.SessionExclusionQuery,
+			ErrorExclusionQuery:            projectFilterSettings.ErrorExclusionQuery,
+			LogExclusionQuery:              projectFilterSettings.LogExclusionQuery,
+			TraceExclusionQuery:            projectFilterSettings.TraceExclusionQuery,
+			TraceTailSampling:              projectFilterSettings.TraceTailSampling,
+			TraceTailSamplingMinDurationMs: projectFilterSettings.TraceTailSamplingMinDurationMs,
+			TraceTailSamplingQuery:         projectFilterSettings.TraceTailSamplingQuery,
+			LogAdaptiveSamplingTarget:      projectFilterSettings.LogAdaptiveSamplingTarget,
+			LogAdaptiveSamplingKey:         projectFilterSettings.LogAdaptiveSamplingKey,
+			TraceAdaptiveSamplingTarget:    projectFilterSettings.TraceAdaptiveSamplingTarget,
+			TraceAdaptiveSamplingKey:       projectFilterSettings.TraceAdaptiveSamplingKey,
 		},
+		LogPipeline:    append([]*modelInputs.LogPipelineStage{}, projectFilterSettings.LogPipeline...),
+		RedactionRules: append([]*modelInputs.RedactionRule{}, projectFilterSettings.RedactionRules...),
 	}
 
 	return &allProjectSettings, nil
 }
 
+// IngestPreview is the resolver for the ingest_preview field.
+func (r *queryResolver) IngestPreview(ctx context.Context, projectID int, payload string, sampling *modelInputs.SamplingInput) ([]*modelInputs.IngestPreviewItem, error) {
+	project, err := r.isUserInProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// unsaved sampling settings are previewed in place of the saved settings
+	var settings *model.ProjectFilterSettings
+	if sampling != nil {
+		settings, err = r.Store.PreviewProjectFilterSettings(ctx, project.ID, store.UpdateProjectFilterSettingsParams{
+			Sampling: sampling,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.IngestPreviewer == nil {
+		return nil, e.New("ingest preview is not available")
+	}
+	return r.IngestPreviewer.PreviewIngest(ctx, project.ID, []byte(payload), settings)
+}
+
 // Workspace is the resolver for the workspace field.
 func (r *queryResolver) Workspace(ctx context.Context, id int) (*model.Workspace, error) {
 	workspace, err := r.isUserInWorkspaceReadOnly(ctx, id)
@@ -9533,6 +9631,12 @@ func (r *queryResolver) Metrics(ctx context.Context, productType modelInputs.Pro
 		return r.ErrorsMetrics(ctx, projectID, params, column, metricTypes, groupBy, bucketBy, bucketCount, bucketWindow, limit, limitAggregator, limitColumn)
 	case modelInputs.ProductTypeEvents:
 		return r.EventsMetrics(ctx, projectID, params, column, metricTypes, groupBy, bucketBy, bucketCount, bucketWindow, limit, limitAggregator, limitColumn)
+	case modelInputs.ProductTypeIngestDrops:
+		project, err := r.isUserInProjectOrDemoProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		return r.ClickhouseClient.ReadIngestDropsMetrics(ctx, project.ID, params, column, metricTypes, groupBy, bucketCount, bucketBy, bucketWindow, limit, limitAggregator, limitColumn)
 	default:
 		return nil, e.Errorf("invalid product type %s", productType)
 	}
@@ -9562,6 +9666,8 @@ func (r *queryResolver) Keys(ctx context.Context, productType *modelInputs.Produ
 		return r.ErrorsKeys(ctx, projectID, dateRange, query, typeArg)
 	case modelInputs.ProductTypeEvents:
 		return r.EventsKeys(ctx, projectID, dateRange, query, typeArg, event)
+	case modelInputs.ProductTypeIngestDrops:
+		return r.ClickhouseClient.IngestDropsKeys(ctx, project.ID, dateRange.StartDate, dateRange.EndDate, query, typeArg)
 	default:
 		return nil, e.Errorf("invalid product type %s", productType)
 	}
@@ -9591,6 +9697,8 @@ func (r *queryResolver) KeyValues(ctx context.Context, productType *modelInputs.
 		return r.ErrorsKeyValues(ctx, projectID, keyName, dateRange, query, count)
 	case modelInputs.ProductTypeEvents:
 		return r.EventsKeyValues(ctx, projectID, keyName, dateRange, query, count, event)
+	case modelInputs.ProductTypeIngestDrops:
+		return r.ClickhouseClient.IngestDropsKeyValues(ctx, project.ID, keyName, dateRange.StartDate, dateRange.EndDate, query, count)
 	default:
 		return nil, e.Errorf("invalid product type %s", productType)
 	}
@@ -9697,6 +9805,8 @@ func (r *queryResolver) LogLines(ctx context.Context, productType modelInputs.Pr
 		return r.ClickhouseClient.ErrorsLogLines(ctx, project.ID, params)
 	case modelInputs.ProductTypeEvents:
 		return r.ClickhouseClient.EventsLogLines(ctx, project.ID, params)
+	case modelInputs.ProductTypeIngestDrops:
+		return r.ClickhouseClient.IngestDropsLogLines(ctx, project.ID, params)
 	default:
 		return nil, e.Errorf("invalid product type %s", productType)
 	}
@@ -0,0 +1,198 @@
+package promql
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	e "github.com/pkg/errors"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ResultTypeMatrix = "matrix"
+	ResultTypeVector = "vector"
+	ResultTypeScalar = "scalar"
+
+	ErrorTypeBadData   = "bad_data"
+	ErrorTypeExecution = "execution"
+)
+
+// maxPoints is the maximum number of steps of a range query, matching prometheus.
+const maxPoints = 11000
+
+type MetricsReader func(ctx context.Context, input clickhouse.ReadMetricsInput) (*modelInputs.MetricsBuckets, error)
+
+type responseData struct {
+	ResultType string      `json:"resultType"`
+	Result     interface{} `json:"result"`
+}
+
+type response struct {
+	Status    string        `json:"status"`
+	Data      *responseData `json:"data,omitempty"`
+	ErrorType string        `json:"errorType,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("failed to write promql response")
+	}
+}
+
+func writeResult(w http.ResponseWriter, resultType string, result interface{}) {
+	writeJSON(w, http.StatusOK, response{
+		Status: "success",
+		Data:   &responseData{ResultType: resultType, Result: result},
+	})
+}
+
+// WriteError responds with the prometheus api error format.
+func WriteError(w http.ResponseWriter, status int, errorType string, err error) {
+	writeJSON(w, status, response{
+		Status:    "error",
+		ErrorType: errorType,
+		Error:     err.Error(),
+	})
+}
+
+// parseTime parses an RFC3339 or unix timestamp query parameter.
+func parseTime(value string, defaultTime time.Time) (time.Time, error) {
+	if value == "" {
+		return defaultTime, nil
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		s, ns := math.Modf(seconds)
+		return time.Unix(int64(s), int64(ns*1e9)), nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, e.Errorf("cannot parse %q to a valid timestamp", value)
+}
+
+// parseStep parses a step given in seconds or as a prometheus duration.
+func parseStep(value string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds <= 0 {
+			return 0, e.New("zero or negative query resolution step widths are not accepted")
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+	return ParseDuration(value)
+}
+
+func parseQuery(r *http.Request) (*Query, error) {
+	expr, err := Parse(r.FormValue("query"))
+	if err != nil {
+		return nil, e.Wrap(err, "invalid parameter \"query\"")
+	}
+	return Compile(expr)
+}
+
+// readQuery reads the metrics of a query. The quantile of a histogram is read from the series of its buckets,
+// falling back to the histogram rows of the histogram when it has no bucket series.
+// Returns the query the metrics were read with.
+func readQuery(ctx context.Context, q *Query, read MetricsReader, input func(q *Query) clickhouse.ReadMetricsInput) (*Query, *modelInputs.MetricsBuckets, error) {
+	metrics, err := read(ctx, input(q))
+	if err != nil || q.histogram == nil {
+		return q, metrics, err
+	}
+	if lo.ContainsBy(metrics.Buckets, func(bucket *modelInputs.MetricBucket) bool {
+		return bucket.MetricValue != nil
+	}) {
+		return q, metrics, nil
+	}
+	metrics, err = read(ctx, input(q.histogram))
+	return q.histogram, metrics, err
+}
+
+// HandleQuery implements the prometheus /api/v1/query instant query endpoint.
+func HandleQuery(w http.ResponseWriter, r *http.Request, projectID int, read MetricsReader) {
+	q, err := parseQuery(r)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, err)
+		return
+	}
+	t, err := parseTime(r.FormValue("time"), time.Now())
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.Wrap(err, "invalid parameter \"time\""))
+		return
+	}
+
+	if q.Scalar != nil {
+		writeResult(w, ResultTypeScalar, newSamplePair(timestamp(t), *q.Scalar))
+		return
+	}
+
+	q, metrics, err := readQuery(r.Context(), q, read, func(q *Query) clickhouse.ReadMetricsInput {
+		return q.InstantInput(projectID, t)
+	})
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("failed to read promql instant query")
+		WriteError(w, http.StatusUnprocessableEntity, ErrorTypeExecution, err)
+		return
+	}
+	writeResult(w, ResultTypeVector, q.Vector(metrics, t))
+}
+
+// HandleQueryRange implements the prometheus /api/v1/query_range endpoint.
+func HandleQueryRange(w http.ResponseWriter, r *http.Request, projectID int, read MetricsReader) {
+	q, err := parseQuery(r)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, err)
+		return
+	}
+	start, err := parseTime(r.FormValue("start"), time.Time{})
+	if err != nil || start.IsZero() {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.New("invalid parameter \"start\""))
+		return
+	}
+	end, err := parseTime(r.FormValue("end"), time.Time{})
+	if err != nil || end.IsZero() {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.New("invalid parameter \"end\""))
+		return
+	}
+	if end.Before(start) {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.New("end timestamp must not be before start time"))
+		return
+	}
+	step, err := parseStep(r.FormValue("step"))
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.Wrap(err, "invalid parameter \"step\""))
+		return
+	}
+
+	if end.Sub(start)/step > maxPoints {
+		WriteError(w, http.StatusBadRequest, ErrorTypeBadData, e.New("exceeded maximum resolution of 11,000 points per timeseries. Try decreasing the query resolution (?step=XX)"))
+		return
+	}
+
+	if q.Scalar != nil {
+		series := &MatrixSeries{Metric: map[string]string{}}
+		for t := start; !t.After(end); t = t.Add(step) {
+			series.Values = append(series.Values, newSamplePair(timestamp(t), *q.Scalar))
+		}
+		writeResult(w, ResultTypeMatrix, []*MatrixSeries{series})
+		return
+	}
+
+	q, metrics, err := readQuery(r.Context(), q, read, func(q *Query) clickhouse.ReadMetricsInput {
+		return q.RangeInput(projectID, start, end, step)
+	})
+	if err != nil {
+		log.WithContext(r.Context()).WithError(err).Error("failed to read promql range query")
+		WriteError(w, http.StatusUnprocessableEntity, ErrorTypeExecution, err)
+		return
+	}
+	writeResult(w, ResultTypeMatrix, q.Matrix(metrics))
+}
@@ -0,0 +1,498 @@
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	e "github.com/pkg/errors"
+)
+
+type MatchType string
+
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
+const MetricNameLabel = "__name__"
+
+type Expr interface {
+	String() string
+}
+
+type NumberLiteral struct {
+	Value float64
+}
+
+type LabelMatcher struct {
+	Name  string
+	Type  MatchType
+	Value string
+}
+
+type VectorSelector struct {
+	Name     string
+	Matchers []*LabelMatcher
+	Range    time.Duration
+}
+
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+type AggregateExpr struct {
+	Op       string
+	Grouping []string
+	Without  bool
+	Expr     Expr
+}
+
+type BinaryExpr struct {
+	Op  string
+	LHS Expr
+	RHS Expr
+}
+
+func (n *NumberLiteral) String() string {
+	return strconv.FormatFloat(n.Value, 'f', -1, 64)
+}
+
+func (m *LabelMatcher) String() string {
+	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
+}
+
+func (v *VectorSelector) String() string {
+	var matchers []string
+	for _, m := range v.Matchers {
+		matchers = append(matchers, m.String())
+	}
+	s := v.Name
+	if len(matchers) > 0 {
+		s += "{" + strings.Join(matchers, ",") + "}"
+	}
+	if v.Range > 0 {
+		s += "[" + v.Range.String() + "]"
+	}
+	return s
+}
+
+func (c *Call) String() string {
+	var args []string
+	for _, arg := range c.Args {
+		args = append(args, arg.String())
+	}
+	return fmt.Sprintf("%s(%s)", c.Func, strings.Join(args, ", "))
+}
+
+func (a *AggregateExpr) String() string {
+	grouping := "by"
+	if a.Without {
+		grouping = "without"
+	}
+	return fmt.Sprintf("%s %s (%s) (%s)", a.Op, grouping, strings.Join(a.Grouping, ", "), a.Expr.String())
+}
+
+func (b *BinaryExpr) String() string {
+	return fmt.Sprintf("%s %s %s", b.LHS.String(), b.Op, b.RHS.String())
+}
+
+var aggregateOps = map[string]bool{
+	"sum":   true,
+	"avg":   true,
+	"min":   true,
+	"max":   true,
+	"count": true,
+}
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenNumber
+	tokenString
+	tokenDuration
+	tokenOperator
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+// lex splits a PromQL expression into tokens. Durations are only recognized inside of range brackets.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	inRange := false
+	for i := 0; i < len(input); {
+		c := rune(input[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case inRange && unicode.IsDigit(c):
+			start := i
+			for i < len(input) && (unicode.IsLetter(rune(input[i])) || unicode.IsDigit(rune(input[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{typ: tokenDuration, value: input[start:i], pos: start})
+		case unicode.IsDigit(c) || (c == '.' && i+1 < len(input) && unicode.IsDigit(rune(input[i+1]))):
+			start := i
+			for i < len(input) && (unicode.IsDigit(rune(input[i])) || strings.ContainsRune(".eE", rune(input[i])) ||
+				((input[i] == '+' || input[i] == '-') && (input[i-1] == 'e' || input[i-1] == 'E'))) {
+				i++
+			}
+			tokens = append(tokens, token{typ: tokenNumber, value: input[start:i], pos: start})
+		case unicode.IsLetter(c) || c == '_' || c == ':':
+			start := i
+			// dots are allowed in addition to the prometheus charset since otel metric names use them
+			for i < len(input) && (unicode.IsLetter(rune(input[i])) || unicode.IsDigit(rune(input[i])) || strings.ContainsRune("_:.", rune(input[i]))) {
+				i++
+			}
+			tokens = append(tokens, token{typ: tokenIdentifier, value: input[start:i], pos: start})
+		case c == '"' || c == '\'' || c == '`':
+			start := i
+			i++
+			var sb strings.Builder
+			for ; i < len(input) && rune(input[i]) != c; i++ {
+				if input[i] == '\\' && c != '`' && i+1 < len(input) {
+					i++
+					switch input[i] {
+					case 'n':
+						sb.WriteByte('\n')
+					case 't':
+						sb.WriteByte('\t')
+					default:
+						sb.WriteByte(input[i])
+					}
+					continue
+				}
+				sb.WriteByte(input[i])
+			}
+			if i >= len(input) {
+				return nil, e.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, token{typ: tokenString, value: sb.String(), pos: start})
+		default:
+			start := i
+			op := string(c)
+			if i+1 < len(input) {
+				if two := input[i : i+2]; two == "!=" || two == "=~" || two == "!~" {
+					op = two
+				}
+			}
+			if !operators[op] {
+				return nil, e.Errorf("unexpected character %q at position %d", c, start)
+			}
+			switch op {
+			case "[":
+				inRange = true
+			case "]":
+				inRange = false
+			}
+			i += len(op)
+			tokens = append(tokens, token{typ: tokenOperator, value: op, pos: start})
+		}
+	}
+	return append(tokens, token{typ: tokenEOF, pos: len(input)}), nil
+}
+
+var operators = map[string]bool{
+	"{": true, "}": true, "(": true, ")": true, "[": true, "]": true, ",": true,
+	"=": true, "!=": true, "=~": true, "!~": true,
+	"+": true, "-": true, "*": true, "/": true,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(value string) bool {
+	t := p.peek()
+	return t.typ == tokenOperator && t.value == value
+}
+
+func (p *parser) expect(value string) error {
+	t := p.next()
+	if t.typ != tokenOperator || t.value != value {
+		return e.Errorf("expected %q at position %d", value, t.pos)
+	}
+	return nil
+}
+
+// Parse parses the supported subset of PromQL: vector selectors, function calls,
+// aggregations with a by / without clause, number literals and arithmetic.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, e.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+	return expr, nil
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	lhs, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+") || p.isOperator("-") {
+		op := p.next().value
+		rhs, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseTerm() (Expr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*") || p.isOperator("/") {
+		op := p.next().value
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.isOperator("-") || p.isOperator("+") {
+		op := p.next().value
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &BinaryExpr{Op: op, LHS: &NumberLiteral{}, RHS: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokenNumber:
+		p.next()
+		value, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, e.Errorf("invalid number %q at position %d", t.value, t.pos)
+		}
+		return &NumberLiteral{Value: value}, nil
+	case tokenOperator:
+		switch t.value {
+		case "(":
+			p.next()
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			return expr, p.expect(")")
+		case "{":
+			return p.parseVectorSelector("")
+		}
+	case tokenIdentifier:
+		p.next()
+		if aggregateOps[t.value] {
+			return p.parseAggregate(t.value)
+		}
+		if p.isOperator("(") {
+			return p.parseCall(t.value)
+		}
+		return p.parseVectorSelector(t.value)
+	}
+	if t.typ == tokenEOF {
+		return nil, e.New("unexpected end of expression")
+	}
+	return nil, e.Errorf("unexpected %q at position %d", t.value, t.pos)
+}
+
+func (p *parser) parseCall(name string) (Expr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	call := &Call{Func: name}
+	for !p.isOperator(")") {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+	return call, p.expect(")")
+}
+
+func (p *parser) parseGrouping(agg *AggregateExpr) error {
+	t := p.peek()
+	if t.typ != tokenIdentifier || (t.value != "by" && t.value != "without") {
+		return nil
+	}
+	p.next()
+	agg.Without = t.value == "without"
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.isOperator(")") {
+		label := p.next()
+		if label.typ != tokenIdentifier {
+			return e.Errorf("expected label name at position %d", label.pos)
+		}
+		agg.Grouping = append(agg.Grouping, label.value)
+		if !p.isOperator(",") {
+			break
+		}
+		p.next()
+	}
+	return p.expect(")")
+}
+
+func (p *parser) parseAggregate(op string) (Expr, error) {
+	agg := &AggregateExpr{Op: op}
+	if err := p.parseGrouping(agg); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	agg.Expr = expr
+	if agg.Grouping == nil {
+		if err := p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+func (p *parser) parseVectorSelector(name string) (Expr, error) {
+	selector := &VectorSelector{Name: name}
+	if p.isOperator("{") {
+		p.next()
+		for !p.isOperator("}") {
+			label := p.next()
+			if label.typ != tokenIdentifier {
+				return nil, e.Errorf("expected label name at position %d", label.pos)
+			}
+			op := p.next()
+			matchType := MatchType(op.value)
+			if op.typ != tokenOperator || (matchType != MatchEqual && matchType != MatchNotEqual && matchType != MatchRegexp && matchType != MatchNotRegexp) {
+				return nil, e.Errorf("expected label matcher at position %d", op.pos)
+			}
+			value := p.next()
+			if value.typ != tokenString {
+				return nil, e.Errorf("expected label value string at position %d", value.pos)
+			}
+			if label.value == MetricNameLabel && matchType == MatchEqual {
+				selector.Name = value.value
+			} else {
+				selector.Matchers = append(selector.Matchers, &LabelMatcher{Name: label.value, Type: matchType, Value: value.value})
+			}
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+	}
+	if selector.Name == "" {
+		return nil, e.New("vector selector must contain a metric name")
+	}
+	if p.isOperator("[") {
+		p.next()
+		duration := p.next()
+		if duration.typ != tokenDuration {
+			return nil, e.Errorf("expected range duration at position %d", duration.pos)
+		}
+		d, err := ParseDuration(duration.value)
+		if err != nil {
+			return nil, err
+		}
+		selector.Range = d
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	return selector, nil
+}
+
+var durationUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	"y":  365 * 24 * time.Hour,
+}
+
+// ParseDuration parses a prometheus duration such as 5m or 1h30m.
+func ParseDuration(s string) (time.Duration, error) {
+	var total time.Duration
+	rest := s
+	for rest != "" {
+		i := 0
+		for i < len(rest) && unicode.IsDigit(rune(rest[i])) {
+			i++
+		}
+		j := i
+		for j < len(rest) && unicode.IsLetter(rune(rest[j])) {
+			j++
+		}
+		n, err := strconv.Atoi(rest[:i])
+		unit, ok := durationUnits[rest[i:j]]
+		if err != nil || !ok {
+			return 0, e.Errorf("invalid duration %q", s)
+		}
+		total += time.Duration(n) * unit
+		rest = rest[j:]
+	}
+	if total <= 0 {
+		return 0, e.Errorf("invalid duration %q", s)
+	}
+	return total, nil
+}
@@ -0,0 +1,250 @@
+package promql
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func compile(t *testing.T, query string) *Query {
+	expr, err := Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := Compile(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func TestCompile(t *testing.T) {
+	for query, expected := range map[string]struct {
+		metricName string
+		aggregator modelInputs.MetricAggregator
+		groupBy    []string
+		filter     string
+	}{
+		`http_requests_total{method="GET"}`: {
+			metricName: "http_requests_total",
+			aggregator: modelInputs.MetricAggregatorAvg,
+			filter:     `metric_name="http_requests_total" method="GET"`,
+		},
+		`sum by (job) (rate(http_requests_total{status=~"5..", path!="/health"}[5m]))`: {
+			metricName: "http_requests_total",
+			aggregator: modelInputs.MetricAggregatorRate,
+			groupBy:    []string{"job"},
+			filter:     `metric_name="http_requests_total" status="/^(?:5..)$/" path!="/health"`,
+		},
+		`sum(increase({__name__="jobs_processed_total"}[1h])) by (queue)`: {
+			metricName: "jobs_processed_total",
+			aggregator: modelInputs.MetricAggregatorIncrease,
+			groupBy:    []string{"queue"},
+			filter:     `metric_name="jobs_processed_total"`,
+		},
+		`max by (instance) (memory_bytes{job!~"test.*"})`: {
+			metricName: "memory_bytes",
+			aggregator: modelInputs.MetricAggregatorMax,
+			groupBy:    []string{"instance"},
+			filter:     `metric_name="memory_bytes" job!="/^(?:test.*)$/"`,
+		},
+		`histogram_quantile(0.95, sum by (le, service_name) (rate(http.server.duration_bucket[5m])))`: {
+			metricName: "http.server.duration_bucket",
+			aggregator: modelInputs.MetricAggregatorRate,
+			groupBy:    []string{"le", "service_name"},
+			filter:     `metric_name="http.server.duration_bucket"`,
+		},
+		`histogram_quantile(0.42, rate(duration_bucket{job="api"}[5m]))`: {
+			metricName: "duration_bucket",
+			aggregator: modelInputs.MetricAggregatorRate,
+			groupBy:    []string{"le"},
+			filter:     `metric_name="duration_bucket" job="api"`,
+		},
+	} {
+		t.Run(query, func(t *testing.T) {
+			q := compile(t, query)
+			assert.Equal(t, expected.metricName, q.MetricName)
+			assert.Equal(t, expected.aggregator, q.Aggregator)
+			assert.Equal(t, expected.groupBy, q.GroupBy)
+			assert.Equal(t, expected.filter, q.filter())
+		})
+	}
+}
+
+func TestCompileScalar(t *testing.T) {
+	q := compile(t, "1 + 2 * 3")
+	assert.Equal(t, 7., *q.Scalar)
+}
+
+func TestCompileUnsupported(t *testing.T) {
+	for _, query := range []string{
+		`http_requests_total[5m]`,
+		`avg(rate(http_requests_total[5m]))`,
+		`sum without (job) (memory_bytes)`,
+		`sum(max by (job) (memory_bytes))`,
+		`histogram_quantile(1.5, rate(duration_bucket[5m]))`,
+		`deriv(memory_bytes[5m])`,
+		`memory_bytes / 1024`,
+	} {
+		t.Run(query, func(t *testing.T) {
+			expr, err := Parse(query)
+			assert.NoError(t, err)
+			_, err = Compile(expr)
+			assert.Error(t, err)
+		})
+	}
+
+	for _, query := range []string{`sum by (job`, `{job="api"}`, `memory_bytes{job="api}`, `rate(x[5q])`} {
+		_, err := Parse(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestRangeInput(t *testing.T) {
+	q := compile(t, `sum by (job) (rate(http_requests_total[5m]))`)
+	end := time.Unix(1700003600, 0)
+	start := end.Add(-time.Hour)
+
+	input := q.RangeInput(1, start, end, time.Minute)
+	assert.Equal(t, []int{1}, input.ProjectIDs)
+	assert.Equal(t, "http_requests_total", input.Column)
+	assert.Equal(t, []modelInputs.MetricAggregator{modelInputs.MetricAggregatorRate}, input.MetricTypes)
+	assert.Equal(t, modelInputs.MetricBucketByTimestamp.String(), input.BucketBy)
+	assert.Equal(t, 60, *input.BucketWindow)
+	assert.Equal(t, start.Add(-time.Minute), input.Params.DateRange.StartDate)
+
+	// the step is widened rather than exceeding the maximum bucket count
+	input = q.RangeInput(1, start, end, time.Second)
+	assert.LessOrEqual(t, int(end.Sub(start).Seconds())/(*input.BucketWindow), clickhouse.MaxBuckets)
+
+	input = q.InstantInput(1, end)
+	assert.Equal(t, modelInputs.MetricBucketByNone.String(), input.BucketBy)
+	assert.Equal(t, end.Add(-5*time.Minute), input.Params.DateRange.StartDate)
+}
+
+func TestHandleQueryRange(t *testing.T) {
+	var input clickhouse.ReadMetricsInput
+	read := func(ctx context.Context, i clickhouse.ReadMetricsInput) (*modelInputs.MetricsBuckets, error) {
+		input = i
+		return &modelInputs.MetricsBuckets{
+			Buckets: []*modelInputs.MetricBucket{
+				{BucketID: 0, BucketMax: 1700000060, Group: []string{"api"}, MetricValue: ptr.Float64(1.5)},
+				{BucketID: 1, BucketMax: 1700000120, Group: []string{"api"}, MetricValue: nil},
+				{BucketID: 0, BucketMax: 1700000060, Group: []string{"worker"}, MetricValue: ptr.Float64(2)},
+				{BucketID: 1, BucketMax: 1700000120, Group: []string{"worker"}, MetricValue: ptr.Float64(0.25)},
+			},
+		}, nil
+	}
+
+	form := url.Values{
+		"query": {`sum by (job) (rate(http_requests_total[5m]))`},
+		"start": {"1700000060"},
+		"end":   {"2023-11-14T22:15:20Z"},
+		"step":  {"60"},
+	}
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/query_range", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	HandleQueryRange(w, r, 1, read)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"job"}, input.GroupBy)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"matrix","result":[
+		{"metric":{"job":"api"},"values":[[1700000060,"1.5"]]},
+		{"metric":{"job":"worker"},"values":[[1700000060,"2"],[1700000120,"0.25"]]}
+	]}}`, w.Body.String())
+}
+
+func TestHandleQuery(t *testing.T) {
+	read := func(ctx context.Context, i clickhouse.ReadMetricsInput) (*modelInputs.MetricsBuckets, error) {
+		return &modelInputs.MetricsBuckets{
+			Buckets: []*modelInputs.MetricBucket{{BucketMax: 1700000000, Group: []string{}, MetricValue: ptr.Float64(42)}},
+		}, nil
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/query?"+url.Values{
+		"query": {`memory_bytes{job="api"}`},
+		"time":  {"1700000000"},
+	}.Encode(), nil)
+	w := httptest.NewRecorder()
+	HandleQuery(w, r, 1, read)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"__name__":"memory_bytes","job":"api"},"value":[1700000000,"42"]}
+	]}}`, w.Body.String())
+
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/query?query=1%2B1&time=1700000000", nil)
+	w = httptest.NewRecorder()
+	HandleQuery(w, r, 1, read)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"2"]}}`, w.Body.String())
+
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/query?query=sum+without+(job)+(memory_bytes)", nil)
+	w = httptest.NewRecorder()
+	HandleQuery(w, r, 1, read)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"errorType":"bad_data"`)
+}
+
+func Test_bucketQuantile(t *testing.T) {
+	buckets := func() []histogramBucket {
+		return []histogramBucket{{math.Inf(1), 40}, {0.1, 10}, {1, 40}, {0.5, 30}}
+	}
+	assert.InDelta(t, 0.3, bucketQuantile(0.5, buckets()), 1e-9)
+	assert.InDelta(t, 0.02, bucketQuantile(0.05, buckets()), 1e-9)
+	// quantiles in the +Inf bucket are the upper bound of the highest finite bucket
+	assert.Equal(t, 1., bucketQuantile(1, append(buckets()[:1], histogramBucket{1, 20})))
+	assert.True(t, math.IsNaN(bucketQuantile(0.5, buckets()[1:])))
+	assert.True(t, math.IsNaN(bucketQuantile(0.5, []histogramBucket{{math.Inf(1), 0}, {1, 0}})))
+}
+
+func TestHandleQueryHistogramQuantile(t *testing.T) {
+	var inputs []clickhouse.ReadMetricsInput
+	read := func(ctx context.Context, i clickhouse.ReadMetricsInput) (*modelInputs.MetricsBuckets, error) {
+		inputs = append(inputs, i)
+		if i.Column != "duration_bucket" {
+			return &modelInputs.MetricsBuckets{
+				Buckets: []*modelInputs.MetricBucket{{Group: []string{"api"}, MetricValue: ptr.Float64(0.75)}},
+			}, nil
+		}
+		var buckets []*modelInputs.MetricBucket
+		for le, value := range map[string]float64{"0.25": 1, "1": 3, "2": 4, "+Inf": 4} {
+			buckets = append(buckets, &modelInputs.MetricBucket{Group: []string{"api", le}, MetricValue: ptr.Float64(value)})
+		}
+		if len(inputs) > 1 {
+			buckets = nil
+		}
+		return &modelInputs.MetricsBuckets{Buckets: buckets}, nil
+	}
+
+	query := url.Values{
+		"query": {`histogram_quantile(0.5, sum by (job, le) (rate(duration_bucket[5m])))`},
+		"time":  {"1700000000"},
+	}.Encode()
+	w := httptest.NewRecorder()
+	HandleQuery(w, httptest.NewRequest(http.MethodGet, "/api/v1/query?"+query, nil), 1, read)
+	assert.Equal(t, []string{"job", "le"}, inputs[0].GroupBy)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"job":"api"},"value":[1700000000,"0.625"]}
+	]}}`, w.Body.String())
+
+	// histograms without bucket series are read from their histogram rows
+	w = httptest.NewRecorder()
+	HandleQuery(w, httptest.NewRequest(http.MethodGet, "/api/v1/query?"+query, nil), 1, read)
+	assert.Len(t, inputs, 3)
+	assert.Equal(t, "duration", inputs[2].Column)
+	assert.Equal(t, []modelInputs.MetricAggregator{modelInputs.MetricAggregatorP50}, inputs[2].MetricTypes)
+	assert.Equal(t, []string{"job"}, inputs[2].GroupBy)
+	assert.JSONEq(t, `{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"job":"api"},"value":[1700000000,"0.75"]}
+	]}}`, w.Body.String())
+}
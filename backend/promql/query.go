@@ -0,0 +1,441 @@
+package promql
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/openlyinc/pointy"
+	e "github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// DefaultLookback is the window of an instant query over a selector without a range, matching prometheus.
+const DefaultLookback = 5 * time.Minute
+
+const HistogramBucketLabel = "le"
+
+var quantileAggregators = map[float64]modelInputs.MetricAggregator{
+	0.5:  modelInputs.MetricAggregatorP50,
+	0.9:  modelInputs.MetricAggregatorP90,
+	0.95: modelInputs.MetricAggregatorP95,
+	0.99: modelInputs.MetricAggregatorP99,
+}
+
+var aggregateOpAggregators = map[string]modelInputs.MetricAggregator{
+	"sum":   modelInputs.MetricAggregatorSum,
+	"avg":   modelInputs.MetricAggregatorAvg,
+	"min":   modelInputs.MetricAggregatorMin,
+	"max":   modelInputs.MetricAggregatorMax,
+	"count": modelInputs.MetricAggregatorCount,
+}
+
+// Query is a PromQL expression translated into a single highlight metrics aggregation.
+// Samples of a series are aggregated over the step of the query rather than their last value,
+// so rate and increase are computed over the step instead of the range of the selector.
+type Query struct {
+	MetricName string
+	Matchers   []*LabelMatcher
+	Aggregator modelInputs.MetricAggregator
+	GroupBy    []string
+	Range      time.Duration
+	// Scalar is set for expressions that only consist of number literals
+	Scalar *float64
+	// Quantile is set by histogram_quantile, computing the quantile from the histogram buckets grouped by le
+	Quantile *float64
+
+	labels     map[string]string
+	aggregated bool
+	// histogram reads the quantile from histogram rows rather than bucket series
+	histogram *Query
+}
+
+// Compile translates a parsed expression into a query, returning an error for unsupported PromQL.
+func Compile(expr Expr) (*Query, error) {
+	switch expr := expr.(type) {
+	case *NumberLiteral:
+		return &Query{Scalar: &expr.Value}, nil
+	case *BinaryExpr:
+		return compileBinary(expr)
+	case *VectorSelector:
+		if expr.Range > 0 {
+			return nil, e.Errorf("range vector %s must be passed to rate or increase", expr.String())
+		}
+		labels := map[string]string{MetricNameLabel: expr.Name}
+		for _, m := range expr.Matchers {
+			if m.Type == MatchEqual {
+				labels[m.Name] = m.Value
+			}
+		}
+		return &Query{
+			MetricName: expr.Name,
+			Matchers:   expr.Matchers,
+			Aggregator: modelInputs.MetricAggregatorAvg,
+			labels:     labels,
+		}, nil
+	case *Call:
+		return compileCall(expr)
+	case *AggregateExpr:
+		return compileAggregate(expr)
+	}
+	return nil, e.Errorf("unsupported expression %s", expr.String())
+}
+
+func compileBinary(expr *BinaryExpr) (*Query, error) {
+	lhs, err := Compile(expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := Compile(expr.RHS)
+	if err != nil {
+		return nil, err
+	}
+	if lhs.Scalar == nil || rhs.Scalar == nil {
+		return nil, e.Errorf("binary operations are only supported between scalars: %s", expr.String())
+	}
+
+	var value float64
+	switch expr.Op {
+	case "+":
+		value = *lhs.Scalar + *rhs.Scalar
+	case "-":
+		value = *lhs.Scalar - *rhs.Scalar
+	case "*":
+		value = *lhs.Scalar * *rhs.Scalar
+	case "/":
+		value = *lhs.Scalar / *rhs.Scalar
+	default:
+		return nil, e.Errorf("unsupported binary operator %s", expr.Op)
+	}
+	return &Query{Scalar: &value}, nil
+}
+
+func compileCall(expr *Call) (*Query, error) {
+	switch expr.Func {
+	case "rate", "increase":
+		if len(expr.Args) != 1 {
+			return nil, e.Errorf("%s expects a single range vector argument", expr.Func)
+		}
+		selector, ok := expr.Args[0].(*VectorSelector)
+		if !ok || selector.Range == 0 {
+			return nil, e.Errorf("%s expects a range vector argument", expr.Func)
+		}
+		aggregator := modelInputs.MetricAggregatorRate
+		if expr.Func == "increase" {
+			aggregator = modelInputs.MetricAggregatorIncrease
+		}
+		return &Query{
+			MetricName: selector.Name,
+			Matchers:   selector.Matchers,
+			Aggregator: aggregator,
+			Range:      selector.Range,
+		}, nil
+	case "histogram_quantile":
+		if len(expr.Args) != 2 {
+			return nil, e.New("histogram_quantile expects a quantile and a vector argument")
+		}
+		phi, ok := expr.Args[0].(*NumberLiteral)
+		if !ok {
+			return nil, e.New("histogram_quantile expects a number literal quantile")
+		}
+		if phi.Value < 0 || phi.Value > 1 {
+			return nil, e.Errorf("histogram_quantile expects a quantile between 0 and 1, got %s", phi.String())
+		}
+		q, err := Compile(expr.Args[1])
+		if err != nil {
+			return nil, err
+		}
+		if q.Scalar != nil || q.labels != nil && !q.aggregated {
+			return nil, e.New("histogram_quantile expects the rate or sum of histogram buckets")
+		}
+		// prometheus histograms are stored as a series per bucket, labeled with the upper bound of the bucket.
+		// otlp histograms are stored as rows with their buckets under the name of the histogram,
+		// which can only be read for the quantiles supported by the metric aggregators.
+		if aggregator, ok := quantileAggregators[phi.Value]; ok {
+			q.histogram = &Query{
+				MetricName: strings.TrimSuffix(q.MetricName, "_bucket"),
+				Matchers:   q.Matchers,
+				Aggregator: aggregator,
+				GroupBy:    withoutBucketLabel(q.GroupBy),
+				Range:      q.Range,
+				aggregated: true,
+			}
+		}
+		if !lo.Contains(q.GroupBy, HistogramBucketLabel) {
+			q.GroupBy = append(q.GroupBy, HistogramBucketLabel)
+		}
+		q.Quantile = &phi.Value
+		q.labels = nil
+		q.aggregated = true
+		return q, nil
+	}
+	return nil, e.Errorf("unsupported function %s", expr.Func)
+}
+
+// withoutBucketLabel removes the histogram bucket label from the grouping of histogram rows.
+func withoutBucketLabel(groupBy []string) []string {
+	var result []string
+	for _, label := range groupBy {
+		if label != HistogramBucketLabel {
+			result = append(result, label)
+		}
+	}
+	return result
+}
+
+func compileAggregate(expr *AggregateExpr) (*Query, error) {
+	if expr.Without {
+		return nil, e.Errorf("%s without is not supported, use %s by", expr.Op, expr.Op)
+	}
+	q, err := Compile(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if q.Scalar != nil {
+		return nil, e.Errorf("%s expects a vector argument", expr.Op)
+	}
+	if q.aggregated {
+		return nil, e.Errorf("nested aggregations are not supported: %s", expr.String())
+	}
+
+	switch q.Aggregator {
+	case modelInputs.MetricAggregatorRate, modelInputs.MetricAggregatorIncrease:
+		if expr.Op != "sum" {
+			return nil, e.Errorf("only sum is supported over %s", strings.ToLower(string(q.Aggregator)))
+		}
+	default:
+		q.Aggregator = aggregateOpAggregators[expr.Op]
+	}
+	q.GroupBy = expr.Grouping
+	q.labels = nil
+	q.aggregated = true
+	return q, nil
+}
+
+// filter returns the highlight search query matching the selector of the query.
+func (q *Query) filter() string {
+	parts := []string{fmt.Sprintf("%s=%s", modelInputs.ReservedTraceKeyMetricName, quote(q.MetricName))}
+	for _, m := range q.Matchers {
+		switch m.Type {
+		case MatchEqual:
+			parts = append(parts, fmt.Sprintf("%s=%s", m.Name, quote(m.Value)))
+		case MatchNotEqual:
+			parts = append(parts, fmt.Sprintf("%s!=%s", m.Name, quote(m.Value)))
+		// prometheus regular expressions are fully anchored
+		case MatchRegexp:
+			parts = append(parts, fmt.Sprintf("%s=%s", m.Name, quote("/^(?:"+m.Value+")$/")))
+		case MatchNotRegexp:
+			parts = append(parts, fmt.Sprintf("%s!=%s", m.Name, quote("/^(?:"+m.Value+")$/")))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func quote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
+
+func (q *Query) readMetricsInput(projectID int, start, end time.Time) clickhouse.ReadMetricsInput {
+	return clickhouse.ReadMetricsInput{
+		SampleableConfig: clickhouse.MetricsSampleableTableConfig,
+		ProjectIDs:       []int{projectID},
+		Params: modelInputs.QueryInput{
+			Query: q.filter(),
+			DateRange: &modelInputs.DateRangeRequiredInput{
+				StartDate: start,
+				EndDate:   end,
+			},
+		},
+		Column:      q.MetricName,
+		MetricTypes: []modelInputs.MetricAggregator{q.Aggregator},
+		GroupBy:     q.GroupBy,
+	}
+}
+
+// InstantInput evaluates the query in a single bucket ending at the evaluation time.
+func (q *Query) InstantInput(projectID int, t time.Time) clickhouse.ReadMetricsInput {
+	lookback := q.Range
+	if lookback == 0 {
+		lookback = DefaultLookback
+	}
+	input := q.readMetricsInput(projectID, t.Add(-lookback), t)
+	input.BucketBy = modelInputs.MetricBucketByNone.String()
+	return input
+}
+
+// RangeInput evaluates the query in buckets of the step, each ending at a step of the range.
+func (q *Query) RangeInput(projectID int, start, end time.Time, step time.Duration) clickhouse.ReadMetricsInput {
+	// use fewer, larger steps rather than letting the range be truncated
+	if n := int64(end.Sub(start)/step) + 1; n > clickhouse.MaxBuckets {
+		step = time.Duration(math.Ceil(float64(end.Sub(start)) / float64(clickhouse.MaxBuckets-1)))
+	}
+	step = step.Round(time.Second)
+	if step < time.Second {
+		step = time.Second
+	}
+	input := q.readMetricsInput(projectID, start.Add(-step), end)
+	input.BucketBy = modelInputs.MetricBucketByTimestamp.String()
+	input.BucketWindow = pointy.Int(int(step.Seconds()))
+	return input
+}
+
+type samplePair [2]interface{}
+
+func newSamplePair(t float64, value float64) samplePair {
+	return samplePair{t, strconv.FormatFloat(value, 'f', -1, 64)}
+}
+
+type MatrixSeries struct {
+	Metric map[string]string `json:"metric"`
+	Values []samplePair      `json:"values"`
+}
+
+type VectorSample struct {
+	Metric map[string]string `json:"metric"`
+	Value  samplePair        `json:"value"`
+}
+
+// histogramBucket is a bucket of a histogram, counting the observations up to its upper bound.
+type histogramBucket struct {
+	upperBound float64
+	count      float64
+}
+
+// bucketQuantile computes a quantile of a histogram as prometheus does, interpolating linearly within the bucket
+// of the quantile. Returns NaN for a histogram without observations or without a +Inf bucket.
+func bucketQuantile(q float64, buckets []histogramBucket) float64 {
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].upperBound < buckets[j].upperBound
+	})
+	if len(buckets) < 2 || !math.IsInf(buckets[len(buckets)-1].upperBound, 1) {
+		return math.NaN()
+	}
+	// the counts of buckets summed from different series may not be monotonic
+	for i := 1; i < len(buckets); i++ {
+		buckets[i].count = math.Max(buckets[i].count, buckets[i-1].count)
+	}
+
+	observations := buckets[len(buckets)-1].count
+	if observations == 0 {
+		return math.NaN()
+	}
+	rank := q * observations
+	b := sort.Search(len(buckets)-1, func(i int) bool {
+		return buckets[i].count >= rank
+	})
+	if b == len(buckets)-1 {
+		return buckets[len(buckets)-2].upperBound
+	}
+	if b == 0 && buckets[0].upperBound <= 0 {
+		return buckets[0].upperBound
+	}
+
+	var bucketStart float64
+	bucketEnd := buckets[b].upperBound
+	count := buckets[b].count
+	if b > 0 {
+		bucketStart = buckets[b-1].upperBound
+		count -= buckets[b-1].count
+		rank -= buckets[b-1].count
+	}
+	return bucketStart + (bucketEnd-bucketStart)*(rank/count)
+}
+
+// getBuckets returns the metric buckets of the query, computing the quantile of each histogram for histogram_quantile.
+// The buckets of a histogram share their step and group, except for their le group which holds their upper bound.
+func (q *Query) getBuckets(metrics *modelInputs.MetricsBuckets) []*modelInputs.MetricBucket {
+	idx := lo.IndexOf(q.GroupBy, HistogramBucketLabel)
+	if q.Quantile == nil || idx < 0 {
+		return metrics.Buckets
+	}
+
+	var result []*modelInputs.MetricBucket
+	var keys []string
+	histograms := map[string][]histogramBucket{}
+	for _, bucket := range metrics.Buckets {
+		if bucket.MetricValue == nil || idx >= len(bucket.Group) {
+			continue
+		}
+		upperBound, err := strconv.ParseFloat(bucket.Group[idx], 64)
+		if err != nil {
+			continue
+		}
+		group := append([]string{}, bucket.Group...)
+		group[idx] = ""
+		key := fmt.Sprintf("%d\x00%s", bucket.BucketID, strings.Join(group, "\x00"))
+		if _, ok := histograms[key]; !ok {
+			keys = append(keys, key)
+			result = append(result, &modelInputs.MetricBucket{
+				BucketID:   bucket.BucketID,
+				BucketMin:  bucket.BucketMin,
+				BucketMax:  bucket.BucketMax,
+				Group:      group,
+				Column:     bucket.Column,
+				MetricType: bucket.MetricType,
+			})
+		}
+		histograms[key] = append(histograms[key], histogramBucket{upperBound: upperBound, count: *bucket.MetricValue})
+	}
+	for i, bucket := range result {
+		bucket.MetricValue = pointy.Float64(bucketQuantile(*q.Quantile, histograms[keys[i]]))
+	}
+	return result
+}
+
+func (q *Query) seriesLabels(group []string) map[string]string {
+	labels := map[string]string{}
+	for k, v := range q.labels {
+		labels[k] = v
+	}
+	for idx, label := range q.GroupBy {
+		if idx < len(group) && group[idx] != "" {
+			labels[label] = group[idx]
+		}
+	}
+	return labels
+}
+
+// Matrix converts the metric buckets into a prometheus range vector, with a series per group.
+// Buckets without a value are omitted, as prometheus does for steps without samples.
+func (q *Query) Matrix(metrics *modelInputs.MetricsBuckets) []*MatrixSeries {
+	result := []*MatrixSeries{}
+	series := map[string]*MatrixSeries{}
+	for _, bucket := range q.getBuckets(metrics) {
+		if bucket.MetricValue == nil {
+			continue
+		}
+		key := strings.Join(bucket.Group, "\x00")
+		s, ok := series[key]
+		if !ok {
+			s = &MatrixSeries{Metric: q.seriesLabels(bucket.Group), Values: []samplePair{}}
+			series[key] = s
+			result = append(result, s)
+		}
+		s.Values = append(s.Values, newSamplePair(bucket.BucketMax, *bucket.MetricValue))
+	}
+	return result
+}
+
+// Vector converts the metric buckets of an instant query into a prometheus instant vector.
+func (q *Query) Vector(metrics *modelInputs.MetricsBuckets, t time.Time) []*VectorSample {
+	result := []*VectorSample{}
+	for _, bucket := range q.getBuckets(metrics) {
+		if bucket.MetricValue == nil {
+			continue
+		}
+		result = append(result, &VectorSample{
+			Metric: q.seriesLabels(bucket.Group),
+			Value:  newSamplePair(timestamp(t), *bucket.MetricValue),
+		})
+	}
+	return result
+}
+
+func timestamp(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1e3
+}
@@ -0,0 +1,223 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/highlight-run/highlight/backend/model"
+	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/redis"
+)
+
+// AdaptiveSamplingWindow is the period over which the items of adaptive sampling groups are counted.
+// The rates of a window are computed from the counts of the previous window.
+const AdaptiveSamplingWindow = time.Minute
+
+// adaptiveSamplingMaxGroups bounds the groups counted per window of a project and product,
+// items of further groups share the rate of the overflow group.
+const adaptiveSamplingMaxGroups = 1000
+
+const adaptiveSamplingOverflowGroup = "__overflow__"
+
+const defaultAdaptiveSamplingKey = "service_name"
+
+// AdaptiveSamplingFlushInterval is how often the items counted by a replica are added to the counts of their window.
+const AdaptiveSamplingFlushInterval = time.Second
+
+// isRowIngestedBySample samples a log or span by the sampling rate of the project, and by the adaptive sampling
+// rate of its group when the project sets a target volume. Returns the sample factor of an ingested row.
+// Both rates sample by the same hash of the key, so a row is ingested when it is within the lower rate.
+func (r *Resolver) isRowIngestedBySample(ctx context.Context, product privateModel.ProductType, projectID int, key string, getValue func(key string) string) (float64, bool) {
+	settings, err := r.getSettings(ctx, projectID, nil)
+	if err != nil {
+		return 1, true
+	}
+
+	rate := math.Min(getSamplingRate(settings, product), r.getAdaptiveSamplingRate(ctx, settings, product, getValue))
+	if !isIngestedBySample(ctx, key, rate) {
+		return 0, false
+	}
+	return math.Max(1/rate, 1), true
+}
+
+// getAdaptiveSamplingRate counts an item in its group and returns the rate of the group for the current window,
+// or the rate of the whole project for spans.
+func (r *Resolver) getAdaptiveSamplingRate(ctx context.Context, settings *model.ProjectFilterSettings, product privateModel.ProductType, getValue func(key string) string) float64 {
+	target, key := func() (*int64, *string) {
+		switch product {
+		case privateModel.ProductTypeLogs:
+			return settings.LogAdaptiveSamplingTarget, settings.LogAdaptiveSamplingKey
+		case privateModel.ProductTypeTraces:
+			return settings.TraceAdaptiveSamplingTarget, settings.TraceAdaptiveSamplingKey
+		}
+		return nil, nil
+	}()
+	if target == nil {
+		return 1.
+	}
+	groupKey := ptr.ToString(key)
+	if groupKey == "" {
+		groupKey = defaultAdaptiveSamplingKey
+	}
+
+	window := time.Now().Unix() / int64(AdaptiveSamplingWindow.Seconds())
+	group := getValue(groupKey)
+	// previewed items are not counted
+	if r.AdaptiveSamplingCounts != nil && !isIngestPreview(ctx) {
+		r.AdaptiveSamplingCounts.Add(settings.ProjectID, product, window, group)
+	}
+
+	cacheKey := fmt.Sprintf("adaptive-sampling-rates-%d-%s-%d-%d", settings.ProjectID, product.String(), *target, window)
+	rates, err := redis.CachedEval(ctx, r.Redis, cacheKey, 250*time.Millisecond, AdaptiveSamplingWindow, func() (*map[string]float64, error) {
+		counts, err := r.Redis.GetAdaptiveSamplingCounts(ctx, redis.AdaptiveSamplingCountsKey(settings.ProjectID, product.String(), window-1))
+		if err != nil {
+			return nil, err
+		}
+		rates := getAdaptiveSamplingRates(counts, *target)
+		// spans are sampled by their trace id, so the spans of a trace must share a rate to be kept together.
+		// groups are still counted, but traces are sampled by a single rate of the whole project.
+		if product == privateModel.ProductTypeTraces {
+			var total int64
+			for _, count := range counts {
+				total += count
+			}
+			rates = getAdaptiveSamplingRates(map[string]int64{"": total}, *target)
+		}
+		return &rates, nil
+	})
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithField("project_id", settings.ProjectID).Error("failed to get adaptive sampling rates")
+		return 1.
+	}
+
+	if product == privateModel.ProductTypeTraces {
+		group = ""
+	} else if _, ok := (*rates)[group]; !ok && len(*rates) >= adaptiveSamplingMaxGroups {
+		group = adaptiveSamplingOverflowGroup
+	}
+	// groups that were not seen in the previous window are kept until they are counted
+	if rate, ok := (*rates)[group]; ok {
+		return rate
+	}
+	return 1.
+}
+
+// getAdaptiveSamplingRates splits a target volume between groups by their counts. Groups below their fair share
+// are kept, and the volume they leave is shared equally by the larger groups, which are sampled down to it.
+func getAdaptiveSamplingRates(counts map[string]int64, target int64) map[string]float64 {
+	groups := make([]string, 0, len(counts))
+	for group := range counts {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return counts[groups[i]] < counts[groups[j]]
+	})
+
+	rates := make(map[string]float64, len(groups))
+	remaining := float64(target)
+	for idx, group := range groups {
+		share := remaining / float64(len(groups)-idx)
+		count := float64(counts[group])
+		if count <= share {
+			rates[group] = 1.
+			remaining -= count
+		} else {
+			rates[group] = share / count
+			remaining -= share
+		}
+	}
+	return rates
+}
+
+type adaptiveSamplingCountsKey struct {
+	projectID int
+	product   privateModel.ProductType
+	window    int64
+}
+
+// AdaptiveSamplingCounts counts the items of adaptive sampling groups in memory and adds them to the counts
+// of their window every AdaptiveSamplingFlushInterval, so that sampling an item does not call redis.
+type AdaptiveSamplingCounts struct {
+	redis *redis.Client
+
+	lock   sync.Mutex
+	counts map[adaptiveSamplingCountsKey]map[string]int64
+}
+
+func NewAdaptiveSamplingCounts(client *redis.Client) *AdaptiveSamplingCounts {
+	return &AdaptiveSamplingCounts{
+		redis:  client,
+		counts: map[adaptiveSamplingCountsKey]map[string]int64{},
+	}
+}
+
+func (c *AdaptiveSamplingCounts) Add(projectID int, product privateModel.ProductType, window int64, group string) {
+	key := adaptiveSamplingCountsKey{projectID: projectID, product: product, window: window}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.add(key, group, 1)
+}
+
+func (c *AdaptiveSamplingCounts) add(key adaptiveSamplingCountsKey, group string, count int64) {
+	groups, ok := c.counts[key]
+	if !ok {
+		groups = map[string]int64{}
+		c.counts[key] = groups
+	}
+	if _, ok := groups[group]; !ok && len(groups) >= adaptiveSamplingMaxGroups {
+		group = adaptiveSamplingOverflowGroup
+	}
+	groups[group] += count
+}
+
+// Flush adds the items counted since the last flush to the counts of their window. Counts that fail to be
+// added are kept for the next flush, unless their window is no longer used to compute rates.
+func (c *AdaptiveSamplingCounts) Flush(ctx context.Context) error {
+	c.lock.Lock()
+	counts := c.counts
+	c.counts = map[adaptiveSamplingCountsKey]map[string]int64{}
+	c.lock.Unlock()
+
+	var errs []error
+	for key, groups := range counts {
+		err := c.redis.IncrementAdaptiveSamplingCounts(ctx, redis.AdaptiveSamplingCountsKey(key.projectID, key.product.String(), key.window), groups, adaptiveSamplingOverflowGroup, adaptiveSamplingMaxGroups, 2*AdaptiveSamplingWindow)
+		if err == nil {
+			continue
+		}
+		errs = append(errs, err)
+		if key.window < time.Now().Unix()/int64(AdaptiveSamplingWindow.Seconds())-1 {
+			continue
+		}
+		c.lock.Lock()
+		for group, count := range groups {
+			c.add(key, group, count)
+		}
+		c.lock.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Start flushes the counted items every AdaptiveSamplingFlushInterval until the context is done.
+func (c *AdaptiveSamplingCounts) Start(ctx context.Context) {
+	ticker := time.NewTicker(AdaptiveSamplingFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				log.WithContext(ctx).WithError(err).Error("failed to flush adaptive sampling counts")
+			}
+		}
+	}
+}
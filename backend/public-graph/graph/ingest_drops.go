@@ -0,0 +1,159 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+)
+
+// IngestDropsFlushInterval is how often the drops counted by a replica are written to clickhouse.
+const IngestDropsFlushInterval = 10 * time.Second
+
+// IngestDropsMaxAge bounds how long the drops that failed to be written are retried.
+const IngestDropsMaxAge = time.Hour
+
+type ingestDropKey struct {
+	projectID   uint32
+	timestamp   time.Time
+	product     privateModel.ProductType
+	reason      privateModel.IngestReason
+	serviceName string
+}
+
+// IngestDrops counts the items dropped at ingest by project, product, reason and service for each minute.
+// Counts are kept in memory and written periodically, so that dropping an item stays cheap.
+type IngestDrops struct {
+	write func(ctx context.Context, rows []*clickhouse.IngestDropRow) error
+
+	lock   sync.Mutex
+	counts map[ingestDropKey]uint64
+}
+
+func NewIngestDrops(client *clickhouse.Client) *IngestDrops {
+	return &IngestDrops{
+		write:  client.BatchWriteIngestDropRows,
+		counts: map[ingestDropKey]uint64{},
+	}
+}
+
+func (d *IngestDrops) Add(projectID int, product privateModel.ProductType, reason privateModel.IngestReason, serviceName string, count int) {
+	if count <= 0 {
+		return
+	}
+	key := ingestDropKey{
+		projectID:   uint32(projectID),
+		timestamp:   time.Now().UTC().Truncate(time.Minute),
+		product:     product,
+		reason:      reason,
+		serviceName: serviceName,
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.counts[key] += uint64(count)
+}
+
+// Flush writes the drops counted since the last flush. Drops that fail to be written are counted again,
+// so that they are retried by the next flush.
+func (d *IngestDrops) Flush(ctx context.Context) error {
+	d.lock.Lock()
+	counts := d.counts
+	d.counts = map[ingestDropKey]uint64{}
+	d.lock.Unlock()
+
+	var rows []*clickhouse.IngestDropRow
+	for key, count := range counts {
+		rows = append(rows, &clickhouse.IngestDropRow{
+			ProjectId:   key.projectID,
+			Timestamp:   key.timestamp,
+			Product:     key.product.String(),
+			Reason:      key.reason.String(),
+			ServiceName: key.serviceName,
+			Count:       count,
+		})
+	}
+	if err := d.write(ctx, rows); err != nil {
+		d.restore(counts)
+		return err
+	}
+	return nil
+}
+
+func (d *IngestDrops) restore(counts map[ingestDropKey]uint64) {
+	oldest := time.Now().UTC().Add(-IngestDropsMaxAge)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for key, count := range counts {
+		if key.timestamp.Before(oldest) {
+			continue
+		}
+		d.counts[key] += count
+	}
+}
+
+// Stop writes the drops counted since the last flush, so that they are not lost on shutdown.
+func (d *IngestDrops) Stop(ctx context.Context) {
+	if err := d.Flush(ctx); err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to flush ingest drops on shutdown")
+	}
+}
+
+// Start flushes the counted drops every IngestDropsFlushInterval until the context is done.
+func (d *IngestDrops) Start(ctx context.Context) {
+	ticker := time.NewTicker(IngestDropsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := d.Flush(context.Background()); err != nil {
+				log.WithContext(ctx).WithError(err).Error("failed to flush ingest drops")
+			}
+			return
+		case <-ticker.C:
+			if err := d.Flush(ctx); err != nil {
+				log.WithContext(ctx).WithError(err).Error("failed to flush ingest drops")
+			}
+		}
+	}
+}
+
+// RecordSessionDrop counts a session excluded by sampling, an exclusion filter or a rate limit, given the session
+// before its exclusion is saved. A session is counted once, the first time it is excluded for one of these reasons.
+// Sessions excluded for other reasons, such as having no user events, are not dropped telemetry.
+func (r *Resolver) RecordSessionDrop(ctx context.Context, session *model.Session, reason privateModel.SessionExcludedReason) {
+	if session.Excluded && session.ExcludedReason != nil {
+		if _, dropped := getSessionIngestReason(*session.ExcludedReason); dropped {
+			return
+		}
+	}
+	if ingestReason, dropped := getSessionIngestReason(reason); dropped {
+		r.RecordIngestDrop(ctx, session.ProjectID, privateModel.ProductTypeSessions, ingestReason, session.ServiceName, 1)
+	}
+}
+
+func getSessionIngestReason(reason privateModel.SessionExcludedReason) (privateModel.IngestReason, bool) {
+	switch reason {
+	case privateModel.SessionExcludedReasonSampled:
+		return privateModel.IngestReasonSample, true
+	case privateModel.SessionExcludedReasonExclusionFilter:
+		return privateModel.IngestReasonFilter, true
+	case privateModel.SessionExcludedReasonRateLimitMinute:
+		return privateModel.IngestReasonRate, true
+	}
+	return "", false
+}
+
+// RecordIngestDrop counts items that are not ingested. Drops are not counted when previewing ingestion.
+func (r *Resolver) RecordIngestDrop(ctx context.Context, projectID int, product privateModel.ProductType, reason privateModel.IngestReason, serviceName string, count int) {
+	if r.IngestDrops == nil || isIngestPreview(ctx) {
+		return
+	}
+	r.IngestDrops.Add(projectID, product, reason, serviceName, count)
+}
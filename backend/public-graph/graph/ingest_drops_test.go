@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RecordIngestDrop(t *testing.T) {
+	r := Resolver{IngestDrops: NewIngestDrops(nil)}
+	ctx := context.TODO()
+
+	r.RecordIngestDrop(ctx, 1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 1)
+	r.RecordIngestDrop(ctx, 1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 2)
+	r.RecordIngestDrop(ctx, 1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonSample, "api", 1)
+	r.RecordIngestDrop(ctx, 1, modelInputs.ProductTypeTraces, modelInputs.IngestReasonFilter, "worker", 4)
+	// previews do not drop items
+	r.RecordIngestDrop(WithIngestPreview(ctx, nil), 1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 1)
+
+	counts := map[modelInputs.IngestReason]uint64{}
+	for key, count := range r.IngestDrops.counts {
+		if key.product == modelInputs.ProductTypeLogs {
+			assert.Equal(t, "api", key.serviceName)
+			counts[key.reason] += count
+		}
+	}
+	assert.Equal(t, map[modelInputs.IngestReason]uint64{
+		modelInputs.IngestReasonFilter: 3,
+		modelInputs.IngestReasonSample: 1,
+	}, counts)
+
+	// a resolver without a counter does not record drops
+	(&Resolver{}).RecordIngestDrop(ctx, 1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 1)
+}
+
+func Test_RecordSessionDrop(t *testing.T) {
+	r := Resolver{IngestDrops: NewIngestDrops(nil)}
+	ctx := context.TODO()
+	noUserEvents := modelInputs.SessionExcludedReasonNoUserEvents
+	sampled := modelInputs.SessionExcludedReasonSampled
+
+	r.RecordSessionDrop(ctx, &model.Session{ProjectID: 1}, modelInputs.SessionExcludedReasonSampled)
+	r.RecordSessionDrop(ctx, &model.Session{ProjectID: 1}, modelInputs.SessionExcludedReasonExclusionFilter)
+	// a session excluded for lacking activity is counted once it is dropped
+	r.RecordSessionDrop(ctx, &model.Session{ProjectID: 1, Excluded: true, ExcludedReason: &noUserEvents}, modelInputs.SessionExcludedReasonRateLimitMinute)
+	// sessions excluded for lacking activity are not dropped telemetry
+	r.RecordSessionDrop(ctx, &model.Session{ProjectID: 1}, modelInputs.SessionExcludedReasonNoUserEvents)
+	// a dropped session is not counted again when its exclusion reason changes
+	r.RecordSessionDrop(ctx, &model.Session{ProjectID: 1, Excluded: true, ExcludedReason: &sampled}, modelInputs.SessionExcludedReasonExclusionFilter)
+
+	counts := map[modelInputs.IngestReason]uint64{}
+	for key, count := range r.IngestDrops.counts {
+		assert.Equal(t, modelInputs.ProductTypeSessions, key.product)
+		counts[key.reason] += count
+	}
+	assert.Equal(t, map[modelInputs.IngestReason]uint64{
+		modelInputs.IngestReasonSample: 1,
+		modelInputs.IngestReasonFilter: 1,
+		modelInputs.IngestReasonRate:   1,
+	}, counts)
+}
+
+func TestIngestDrops_FlushRetries(t *testing.T) {
+	ctx := context.TODO()
+	drops := NewIngestDrops(nil)
+	var written []*clickhouse.IngestDropRow
+	drops.write = func(ctx context.Context, rows []*clickhouse.IngestDropRow) error {
+		return errors.New("clickhouse is unavailable")
+	}
+
+	drops.Add(1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 2)
+	assert.Error(t, drops.Flush(ctx))
+	drops.Add(1, modelInputs.ProductTypeLogs, modelInputs.IngestReasonFilter, "api", 1)
+
+	drops.write = func(ctx context.Context, rows []*clickhouse.IngestDropRow) error {
+		written = append(written, rows...)
+		return nil
+	}
+	assert.NoError(t, drops.Flush(ctx))
+	var count uint64
+	for _, row := range written {
+		count += row.Count
+	}
+	assert.Equal(t, uint64(3), count)
+	assert.Empty(t, drops.counts)
+}
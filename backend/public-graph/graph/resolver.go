@@ -34,7 +34,6 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aws/smithy-go/ptr"
-	"github.com/google/uuid"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/highlight-run/go-resthooks"
 	"github.com/mssola/user_agent"
@@ -93,6 +92,9 @@ type Resolver struct {
 	Store             *store.Store
 	LambdaClient      *lambda.Client
 	SessionCache      *lru.Cache[string, *model.Session]
+	IngestDrops       *IngestDrops
+	// AdaptiveSamplingCounts counts the items of adaptive sampling groups, items are not counted when nil
+	AdaptiveSamplingCounts *AdaptiveSamplingCounts
 }
 
 type Location struct {
@@ -2010,17 +2012,14 @@ func (r *Resolver) PushMetricsImpl(ctx context.Context, projectVerboseID *string
 		}
 	}
 
+	if !r.isWithinMetricQuota(ctx, projectID) {
+		r.RecordIngestDrop(ctx, projectID, privateModel.ProductTypeMetrics, privateModel.IngestReasonQuota, session.ServiceName, len(metrics))
+		return nil
+	}
+
 	curTime := time.Now()
-	var traceRows []*clickhouse.TraceRow
+	var messages []kafka_queue.RetryableMessage
 	for _, m := range metrics {
-		var spanID, parentSpanID, traceID = ptr.ToString(m.SpanID), ptr.ToString(m.ParentSpanID), ptr.ToString(m.TraceID)
-		if spanID == "" {
-			spanID = uuid.New().String()
-		}
-		if traceID == "" {
-			traceID = uuid.New().String()
-		}
-
 		var serviceName, serviceVersion = session.ServiceName, ptr.ToString(session.AppVersion)
 		attributes := map[string]string{}
 		for _, t := range m.Tags {
@@ -2037,38 +2036,52 @@ func (r *Resolver) PushMetricsImpl(ctx context.Context, projectVerboseID *string
 		if m.Group != nil {
 			attributes["group"] = *m.Group
 		}
-
-		timestamp := ClampTime(m.Timestamp, curTime)
-		event := map[string]any{
-			"Name":       "metric",
-			"Timestamp":  timestamp,
-			"Attributes": map[string]any{"metric.name": m.Name, "metric.value": m.Value},
+		if m.ParentSpanID != nil {
+			attributes["parent_span_id"] = *m.ParentSpanID
 		}
-		traceRows = append(traceRows, clickhouse.NewTraceRow(timestamp, projectID).
+
+		metricRow := clickhouse.NewMetricRow(ClampTime(m.Timestamp, curTime), projectID).
+			WithMetricName(m.Name).
+			WithValue(m.Value).
 			WithSecureSessionId(session.SecureID).
-			WithSpanId(spanID).
-			WithParentSpanId(parentSpanID).
-			WithTraceId(traceID).
-			WithSpanName(highlight.MetricSpanName).
+			WithSpanId(ptr.ToString(m.SpanID)).
+			WithTraceId(ptr.ToString(m.TraceID)).
 			WithServiceName(serviceName).
 			WithServiceVersion(serviceVersion).
 			WithEnvironment(session.Environment).
-			WithTraceAttributes(attributes).
-			WithEvents([]map[string]any{event}))
+			WithAttributes(attributes)
+		messages = append(messages, &kafka_queue.MetricRowMessage{
+			Type:      kafka_queue.PushMetricsFlattened,
+			MetricRow: metricRow,
+		})
 	}
 
-	// TODO(vkorolik) write to an actual metrics table
-	var messages []kafka_queue.RetryableMessage
-	for _, traceRow := range traceRows {
-		if !r.IsTraceIngested(ctx, traceRow) {
-			continue
-		}
-		messages = append(messages, &kafka_queue.TraceRowMessage{
-			Type:               kafka_queue.PushTracesFlattened,
-			ClickhouseTraceRow: clickhouse.ConvertTraceRow(traceRow),
-		})
+	return r.BatchedQueue.Submit(ctx, "", messages...)
+}
+
+// isWithinMetricQuota checks the billing quota of metrics, which are billed as traces.
+// The result is cached like the quota checks of the otel handlers.
+func (r *Resolver) isWithinMetricQuota(ctx context.Context, projectID int) bool {
+	if exceeded, err := r.Redis.IsBillingQuotaExceeded(ctx, projectID, model.PricingProductTypeTraces); err == nil && exceeded != nil {
+		return !*exceeded
 	}
-	return r.TracesQueue.Submit(ctx, "", messages...)
+
+	project, err := r.Store.GetProject(ctx, projectID)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("error querying project")
+		return true
+	}
+	workspace, err := r.Store.GetWorkspace(ctx, project.WorkspaceID)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).Error("error querying workspace")
+		return true
+	}
+
+	withinBillingQuota, _ := r.IsWithinQuota(ctx, model.PricingProductTypeTraces, workspace, time.Now())
+	if err := r.Redis.SetBillingQuotaExceeded(ctx, projectID, model.PricingProductTypeTraces, !withinBillingQuota); err != nil {
+		log.WithContext(ctx).Error(err)
+	}
+	return withinBillingQuota
 }
 
 // If curTime is provided and the input is different by more than 2 hours,
@@ -2183,6 +2196,9 @@ func (r *Resolver) ProcessBackendPayloadImpl(ctx context.Context, sessionSecureI
 	}
 
 	if !r.isWithinErrorQuota(ctx, workspace) {
+		for _, errorObject := range errorObjects {
+			r.RecordIngestDrop(ctx, projectID, privateModel.ProductTypeErrors, privateModel.IngestReasonQuota, getErrorServiceName(errorObject), 1)
+		}
 		return
 	}
 
@@ -2190,6 +2206,11 @@ func (r *Resolver) ProcessBackendPayloadImpl(ctx context.Context, sessionSecureI
 		return
 	}
 
+	redactor := r.GetRedactor(ctx, projectID)
+	for _, errorObject := range errorObjects {
+		redactor.BackendError(errorObject)
+	}
+
 	// Count the number of errors for each project
 	errorsBySession := make(map[string]int64)
 	for _, err := range errorObjects {
@@ -2875,6 +2896,7 @@ func (r *Resolver) ProcessPayload(ctx context.Context, sessionSecureID string, e
 		}
 
 		if !r.isWithinErrorQuota(ctx, workspace) {
+			r.RecordIngestDrop(ctx, project.ID, privateModel.ProductTypeErrors, privateModel.IngestReasonQuota, sessionObj.ServiceName, len(errors))
 			return nil
 		}
 
@@ -2886,6 +2908,11 @@ func (r *Resolver) ProcessPayload(ctx context.Context, sessionSecureID string, e
 			return r.IsFrontendErrorIngested(ctx, project.ID, sessionObj, item)
 		})
 
+		redactor := r.GetRedactor(ctx, project.ID)
+		for _, errorObject := range errors {
+			redactor.FrontendError(errorObject)
+		}
+
 		// increment daily error table
 		numErrors := int64(len(errors))
 		if numErrors > 0 {
@@ -3086,6 +3113,7 @@ func (r *Resolver) ProcessPayload(ctx context.Context, sessionSecureID string, e
 			newReasonDeref = *reason
 		}
 		if sessionObj.Excluded != excluded || reasonDeref != newReasonDeref {
+			r.RecordSessionDrop(ctx, sessionObj, newReasonDeref)
 			if err := r.DB.WithContext(ctx).Model(&model.Session{Model: model.Model{ID: sessionID}}).
 				Select("Excluded", "ExcludedReason").Updates(&model.Session{
 				Excluded:       excluded,
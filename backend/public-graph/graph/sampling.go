@@ -12,14 +12,16 @@ import (
 
 	"github.com/aws/smithy-go/ptr"
 	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
 	e "github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/highlight-run/highlight/backend/clickhouse"
 	"github.com/highlight-run/highlight/backend/model"
-	"github.com/highlight-run/highlight/backend/parser"
+	"github.com/highlight-run/highlight/backend/pipeline"
 	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	modelInputs "github.com/highlight-run/highlight/backend/public-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/redact"
 	"github.com/highlight-run/highlight/backend/util"
 	"github.com/highlight/highlight/sdk/highlight-go"
 )
@@ -35,30 +37,46 @@ func (r *Resolver) IsTraceIngested(ctx context.Context, trace *clickhouse.TraceR
 	)
 	defer span.Finish()
 
-	if !r.IsTraceIngestedByFilter(ctx, trace) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonFilter)
-		return false
-	}
-	if !r.IsTraceIngestedBySample(ctx, trace) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonSample)
-		return false
-	}
-	if !r.IsTraceIngestedByRateLimit(ctx, trace) {
+	if reason := r.GetTraceIngestReason(ctx, trace); reason != nil {
 		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonRate)
+		span.SetAttribute("reason", *reason)
+		r.RecordIngestDrop(ctx, int(trace.ProjectId), privateModel.ProductTypeTraces, *reason, trace.ServiceName, 1)
 		return false
 	}
 	return true
 }
 
+// GetTraceIngestReason returns the reason a span is not ingested, or nil when it is ingested.
+func (r *Resolver) GetTraceIngestReason(ctx context.Context, trace *clickhouse.TraceRow) *privateModel.IngestReason {
+	var reason privateModel.IngestReason
+	if !r.IsTraceIngestedByFilter(ctx, trace) {
+		reason = privateModel.IngestReasonFilter
+	} else if !r.IsTraceIngestedBySample(ctx, trace) {
+		reason = privateModel.IngestReasonSample
+	} else if !r.IsTraceIngestedByRateLimit(ctx, trace) {
+		reason = privateModel.IngestReasonRate
+	} else {
+		return nil
+	}
+	return &reason
+}
+
+// IsTraceIngestedBySample samples spans by their trace id, so that the spans of a trace are kept together.
+// Sets the sample factor of an ingested span.
 func (r *Resolver) IsTraceIngestedBySample(ctx context.Context, trace *clickhouse.TraceRow) bool {
-	return r.isItemIngestedBySample(ctx, privateModel.ProductTypeTraces, int(trace.ProjectId), trace.TraceId)
+	sampleFactor, ingested := r.isRowIngestedBySample(ctx, privateModel.ProductTypeTraces, int(trace.ProjectId), trace.TraceId, func(key string) string {
+		return clickhouse.TraceValue(trace, key)
+	})
+	if ingested {
+		trace.SampleFactor = sampleFactor
+	}
+	return ingested
 }
 
 func (r *Resolver) IsTraceIngestedByRateLimit(ctx context.Context, trace *clickhouse.TraceRow) bool {
-	return r.isItemIngestedByRate(ctx, trace.Timestamp, privateModel.ProductTypeTraces, int(trace.ProjectId))
+	return r.isItemIngestedByRate(ctx, privateModel.ProductTypeTraces, int(trace.ProjectId), func(key string) string {
+		return clickhouse.TraceValue(trace, key)
+	})
 }
 
 func (r *Resolver) IsTraceIngestedByFilter(ctx context.Context, trace *clickhouse.TraceRow) bool {
@@ -76,30 +94,45 @@ func (r *Resolver) IsLogIngested(ctx context.Context, logRow *clickhouse.LogRow)
 	)
 	defer span.Finish()
 
-	if !r.IsLogIngestedBySample(ctx, logRow) {
+	if reason := r.GetLogIngestReason(ctx, logRow); reason != nil {
 		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonSample)
-		return false
-	}
-	if !r.IsLogIngestedByFilter(ctx, logRow) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonFilter)
-		return false
-	}
-	if !r.IsLogIngestedByRateLimit(ctx, logRow) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonRate)
+		span.SetAttribute("reason", *reason)
+		r.RecordIngestDrop(ctx, int(logRow.ProjectId), privateModel.ProductTypeLogs, *reason, logRow.ServiceName, 1)
 		return false
 	}
 	return true
 }
 
+// GetLogIngestReason returns the reason a log is not ingested, or nil when it is ingested.
+func (r *Resolver) GetLogIngestReason(ctx context.Context, logRow *clickhouse.LogRow) *privateModel.IngestReason {
+	var reason privateModel.IngestReason
+	if !r.IsLogIngestedBySample(ctx, logRow) {
+		reason = privateModel.IngestReasonSample
+	} else if !r.IsLogIngestedByFilter(ctx, logRow) {
+		reason = privateModel.IngestReasonFilter
+	} else if !r.IsLogIngestedByRateLimit(ctx, logRow) {
+		reason = privateModel.IngestReasonRate
+	} else {
+		return nil
+	}
+	return &reason
+}
+
+// IsLogIngestedBySample sets the sample factor of an ingested log.
 func (r *Resolver) IsLogIngestedBySample(ctx context.Context, logRow *clickhouse.LogRow) bool {
-	return r.isItemIngestedBySample(ctx, privateModel.ProductTypeLogs, int(logRow.ProjectId), logRow.UUID)
+	sampleFactor, ingested := r.isRowIngestedBySample(ctx, privateModel.ProductTypeLogs, int(logRow.ProjectId), logRow.UUID, func(key string) string {
+		return clickhouse.LogValue(logRow, key)
+	})
+	if ingested {
+		logRow.SampleFactor = sampleFactor
+	}
+	return ingested
 }
 
 func (r *Resolver) IsLogIngestedByRateLimit(ctx context.Context, logRow *clickhouse.LogRow) bool {
-	return r.isItemIngestedByRate(ctx, logRow.Timestamp, privateModel.ProductTypeLogs, int(logRow.ProjectId))
+	return r.isItemIngestedByRate(ctx, privateModel.ProductTypeLogs, int(logRow.ProjectId), func(key string) string {
+		return clickhouse.LogValue(logRow, key)
+	})
 }
 
 func (r *Resolver) IsLogIngestedByFilter(ctx context.Context, logRow *clickhouse.LogRow) bool {
@@ -123,13 +156,8 @@ func (r *Resolver) IsFrontendErrorIngested(ctx context.Context, projectID int, s
 		Type:       frontendError.Type,
 		URL:        frontendError.URL,
 	}
-	if !r.IsErrorIngestedBySample(ctx, projectID, errorObject) {
-		return false
-	}
-	if !r.IsErrorIngestedByFilter(ctx, projectID, errorObject) {
-		return false
-	}
-	if !r.IsErrorIngestedByRateLimit(ctx, projectID, errorObject) {
+	if reason := r.GetErrorIngestReason(ctx, projectID, errorObject); reason != nil {
+		r.RecordIngestDrop(ctx, projectID, privateModel.ProductTypeErrors, *reason, session.ServiceName, 1)
 		return false
 	}
 	return true
@@ -146,24 +174,30 @@ func (r *Resolver) IsErrorIngested(ctx context.Context, projectID int, errorObje
 	)
 	defer span.Finish()
 
-	if !r.IsErrorIngestedBySample(ctx, projectID, errorObject) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonFilter)
-		return false
-	}
-	if !r.IsErrorIngestedByFilter(ctx, projectID, errorObject) {
+	if reason := r.GetErrorIngestReason(ctx, projectID, errorObject); reason != nil {
 		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonFilter)
-		return false
-	}
-	if !r.IsErrorIngestedByRateLimit(ctx, projectID, errorObject) {
-		span.SetAttribute("ingested", false)
-		span.SetAttribute("reason", privateModel.IngestReasonFilter)
+		span.SetAttribute("reason", *reason)
+		r.RecordIngestDrop(ctx, projectID, privateModel.ProductTypeErrors, *reason, getErrorServiceName(errorObject), 1)
 		return false
 	}
 	return true
 }
 
+// GetErrorIngestReason returns the reason an error is not ingested, or nil when it is ingested.
+func (r *Resolver) GetErrorIngestReason(ctx context.Context, projectID int, errorObject *modelInputs.BackendErrorObjectInput) *privateModel.IngestReason {
+	var reason privateModel.IngestReason
+	if !r.IsErrorIngestedBySample(ctx, projectID, errorObject) {
+		reason = privateModel.IngestReasonSample
+	} else if !r.IsErrorIngestedByFilter(ctx, projectID, errorObject) {
+		reason = privateModel.IngestReasonFilter
+	} else if !r.IsErrorIngestedByRateLimit(ctx, projectID, errorObject) {
+		reason = privateModel.IngestReasonRate
+	} else {
+		return nil
+	}
+	return &reason
+}
+
 func (r *Resolver) IsErrorIngestedBySample(ctx context.Context, projectID int, errorObject *modelInputs.BackendErrorObjectInput) bool {
 	settings, err := r.getSettings(ctx, projectID, errorObject.SessionSecureID)
 	if err != nil {
@@ -179,7 +213,9 @@ func (r *Resolver) IsErrorIngestedByRateLimit(ctx context.Context, projectID int
 		return true
 	}
 
-	return r.isItemIngestedByRate(ctx, errorObject.Timestamp, privateModel.ProductTypeErrors, settings.ProjectID)
+	return r.isItemIngestedByRate(ctx, privateModel.ProductTypeErrors, settings.ProjectID, func(key string) string {
+		return clickhouse.ErrorValue(errorObject, key)
+	})
 }
 
 func (r *Resolver) IsErrorIngestedByFilter(ctx context.Context, projectID int, errorObject *modelInputs.BackendErrorObjectInput) bool {
@@ -263,7 +299,9 @@ func (r *Resolver) isSessionExcludedBySample(ctx context.Context, session *model
 }
 
 func (r *Resolver) isSessionExcludedByRateLimit(ctx context.Context, session *model.Session) bool {
-	return !r.isItemIngestedByRate(ctx, session.CreatedAt, privateModel.ProductTypeSessions, session.ProjectID)
+	return !r.isItemIngestedByRate(ctx, privateModel.ProductTypeSessions, session.ProjectID, func(key string) string {
+		return clickhouse.SessionValue(session, key)
+	})
 }
 
 func (r *Resolver) IsSessionExcludedByFilter(ctx context.Context, session *model.Session) bool {
@@ -322,46 +360,57 @@ func (r *Resolver) isItemIngestedBySample(ctx context.Context, product privateMo
 		return true
 	}
 
-	rate := func() float64 {
-		switch product {
-		case privateModel.ProductTypeSessions:
-			return settings.SessionSamplingRate
-		case privateModel.ProductTypeErrors:
-			return settings.ErrorSamplingRate
-		case privateModel.ProductTypeLogs:
-			return settings.LogSamplingRate
-		case privateModel.ProductTypeTraces:
-			return settings.TraceSamplingRate
-		}
-		return 1.
-	}()
-	ingested := isIngestedBySample(ctx, key, rate)
+	ingested := isIngestedBySample(ctx, key, getSamplingRate(settings, product))
 	return ingested
 }
 
-func (r *Resolver) isItemIngestedByRate(ctx context.Context, when time.Time, product privateModel.ProductType, projectID int) bool {
+func getSamplingRate(settings *model.ProjectFilterSettings, product privateModel.ProductType) float64 {
+	switch product {
+	case privateModel.ProductTypeSessions:
+		return settings.SessionSamplingRate
+	case privateModel.ProductTypeErrors:
+		return settings.ErrorSamplingRate
+	case privateModel.ProductTypeLogs:
+		return settings.LogSamplingRate
+	case privateModel.ProductTypeTraces:
+		return settings.TraceSamplingRate
+	}
+	return 1.
+}
+
+// isItemIngestedByRate rate limits the items of a product of a project, or of each value of the rate limit key,
+// such as a service or environment, when the project sets one. The burst defaults to the minute rate limit.
+func (r *Resolver) isItemIngestedByRate(ctx context.Context, product privateModel.ProductType, projectID int, getValue func(key string) string) bool {
 	settings, err := r.getSettings(ctx, projectID, nil)
 	if err != nil {
 		return true
 	}
 
-	max := func() *int64 {
+	max, burst, key := func() (*int64, *int64, *string) {
 		switch product {
 		case privateModel.ProductTypeSessions:
-			return settings.SessionMinuteRateLimit
+			return settings.SessionMinuteRateLimit, settings.SessionMinuteRateLimitBurst, settings.SessionMinuteRateLimitKey
 		case privateModel.ProductTypeErrors:
-			return settings.ErrorMinuteRateLimit
+			return settings.ErrorMinuteRateLimit, settings.ErrorMinuteRateLimitBurst, settings.ErrorMinuteRateLimitKey
 		case privateModel.ProductTypeLogs:
-			return settings.LogMinuteRateLimit
+			return settings.LogMinuteRateLimit, settings.LogMinuteRateLimitBurst, settings.LogMinuteRateLimitKey
 		case privateModel.ProductTypeTraces:
-			return settings.TraceMinuteRateLimit
+			return settings.TraceMinuteRateLimit, settings.TraceMinuteRateLimitBurst, settings.TraceMinuteRateLimitKey
 		}
-		return nil
+		return nil, nil, nil
 	}()
 	if max == nil {
 		return true
 	}
-	ingested := r.isIngestedByRateLimit(ctx, fmt.Sprintf("sampling-%d-%s", projectID, product.String()), *max, when.Minute())
+	if burst == nil {
+		burst = max
+	}
+
+	rateLimitKey := fmt.Sprintf("sampling-%d-%s", projectID, product.String())
+	if groupKey := ptr.ToString(key); groupKey != "" {
+		rateLimitKey = fmt.Sprintf("%s-%s", rateLimitKey, getValue(groupKey))
+	}
+	ingested := r.isIngestedByRateLimit(ctx, rateLimitKey, *max, *burst)
 	return ingested
 }
 
@@ -371,41 +420,104 @@ func (r *Resolver) isItemIngestedByFilter(ctx context.Context, product privateMo
 		return true
 	}
 
-	query := func() string {
-		switch product {
-		case privateModel.ProductTypeSessions:
-			return ptr.ToString(settings.SessionExclusionQuery)
-		case privateModel.ProductTypeErrors:
-			return ptr.ToString(settings.ErrorExclusionQuery)
-		case privateModel.ProductTypeLogs:
-			return ptr.ToString(settings.LogExclusionQuery)
-		case privateModel.ProductTypeTraces:
-			return ptr.ToString(settings.TraceExclusionQuery)
-		}
-		return ""
-	}()
+	query := getProductExclusionQuery(settings, product)
 	if query == "" {
 		return true
 	}
 
-	excluded := func() bool {
-		switch product {
-		case privateModel.ProductTypeSessions:
-			filters := parser.Parse(query, clickhouse.SessionsTableConfig)
-			return clickhouse.SessionMatchesQuery(object.(*model.Session), filters)
-		case privateModel.ProductTypeErrors:
-			filters := parser.Parse(query, clickhouse.BackendErrorObjectInputConfig)
-			return clickhouse.ErrorMatchesQuery(object.(*modelInputs.BackendErrorObjectInput), filters)
-		case privateModel.ProductTypeLogs:
-			filters := parser.Parse(query, clickhouse.LogsTableConfig)
-			return clickhouse.LogMatchesQuery(object.(*clickhouse.LogRow), filters)
-		case privateModel.ProductTypeTraces:
-			filters := parser.Parse(query, clickhouse.TracesTableNoDefaultConfig)
-			return clickhouse.TraceMatchesQuery(object.(*clickhouse.TraceRow), filters)
+	// previewed settings are not cached, so that they do not evict the compiled queries of saved settings
+	if isIngestPreview(ctx) {
+		return !compileExclusionQuery(product, query)(object)
+	}
+	excluded := getExclusionQuery(settings, product, query).matches(object)
+	return !excluded
+}
+
+// GetExclusionQuery returns the exclusion query of a product of a project.
+func (r *Resolver) GetExclusionQuery(ctx context.Context, product privateModel.ProductType, projectID int) string {
+	settings, err := r.getSettings(ctx, projectID, nil)
+	if err != nil {
+		return ""
+	}
+	return getProductExclusionQuery(settings, product)
+}
+
+func getProductExclusionQuery(settings *model.ProjectFilterSettings, product privateModel.ProductType) string {
+	switch product {
+	case privateModel.ProductTypeSessions:
+		return ptr.ToString(settings.SessionExclusionQuery)
+	case privateModel.ProductTypeErrors:
+		return ptr.ToString(settings.ErrorExclusionQuery)
+	case privateModel.ProductTypeLogs:
+		return ptr.ToString(settings.LogExclusionQuery)
+	case privateModel.ProductTypeTraces:
+		return ptr.ToString(settings.TraceExclusionQuery)
+	}
+	return ""
+}
+
+// compiledQuery is a query compiled for a version of the filter settings of a project.
+type compiledQuery struct {
+	version time.Time
+	query   string
+	matches func(object interface{}) bool
+}
+
+// compiledQueries caches the compiled exclusion and tail sampling queries by project and product,
+// so that queries are not parsed for each item.
+var compiledQueries, _ = lru.New[string, *compiledQuery](10_000)
+
+// getExclusionQuery returns the compiled exclusion query of a product, compiling it again
+// when the filter settings of the project changed since it was cached.
+func getExclusionQuery(settings *model.ProjectFilterSettings, product privateModel.ProductType, query string) *compiledQuery {
+	return getCompiledQuery(fmt.Sprintf("%d-%s", settings.ProjectID, product.String()), settings, product, query)
+}
+
+// getTailSamplingQuery returns the compiled tail sampling query of a project, cached like the exclusion queries.
+func getTailSamplingQuery(settings *model.ProjectFilterSettings, query string) *compiledQuery {
+	return getCompiledQuery(fmt.Sprintf("%d-tail-sampling", settings.ProjectID), settings, privateModel.ProductTypeTraces, query)
+}
+
+func getCompiledQuery(key string, settings *model.ProjectFilterSettings, product privateModel.ProductType, query string) *compiledQuery {
+	if cached, ok := compiledQueries.Get(key); ok && cached.version.Equal(settings.UpdatedAt) && cached.query == query {
+		return cached
+	}
+
+	compiled := &compiledQuery{
+		version: settings.UpdatedAt,
+		query:   query,
+		matches: compileExclusionQuery(product, query),
+	}
+	compiledQueries.Add(key, compiled)
+	return compiled
+}
+
+func compileExclusionQuery(product privateModel.ProductType, query string) func(object interface{}) bool {
+	switch product {
+	case privateModel.ProductTypeSessions:
+		matches := clickhouse.CompileSessionQuery(query)
+		return func(object interface{}) bool {
+			return matches(object.(*model.Session))
+		}
+	case privateModel.ProductTypeErrors:
+		matches := clickhouse.CompileErrorQuery(query)
+		return func(object interface{}) bool {
+			return matches(object.(*modelInputs.BackendErrorObjectInput))
+		}
+	case privateModel.ProductTypeLogs:
+		matches := clickhouse.CompileLogQuery(query)
+		return func(object interface{}) bool {
+			return matches(object.(*clickhouse.LogRow))
+		}
+	case privateModel.ProductTypeTraces:
+		matches := clickhouse.CompileTraceQuery(query)
+		return func(object interface{}) bool {
+			return matches(object.(*clickhouse.TraceRow))
 		}
+	}
+	return func(object interface{}) bool {
 		return false
-	}()
-	return !excluded
+	}
 }
 
 func isIngestedBySample(ctx context.Context, key string, rate float64) bool {
@@ -427,21 +539,51 @@ func isIngestedBySample(ctx context.Context, key string, rate float64) bool {
 	return sum < threshold
 }
 
-// isIngestedByRateLimit limits ingestion for a key at a max items per minute
-func (r *Resolver) isIngestedByRateLimit(ctx context.Context, key string, max int64, minute int) bool {
-	key = fmt.Sprintf("%s-%d", key, minute)
+// isIngestedByRateLimit limits ingestion for a key at a max items per minute, with a token bucket
+// that allows bursts of up to `burst` items
+func (r *Resolver) isIngestedByRateLimit(ctx context.Context, key string, max int64, burst int64) bool {
+	if max <= 0 {
+		return false
+	}
+	if burst < 1 {
+		burst = 1
+	}
 
-	// based on https://redis.com/glossary/rate-limiting/
-	count, _ := r.Redis.Client.Get(ctx, key).Int64()
+	rate := float64(max) / time.Minute.Seconds()
+	if isIngestPreview(ctx) {
+		tokens, err := r.Redis.GetRateLimitTokens(ctx, key, rate, burst)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).WithField("key", key).Error("failed to get rate limit tokens")
+			return true
+		}
+		return tokens >= 1
+	}
 
-	if count >= max {
-		return false
+	ingested, err := r.Redis.TakeRateLimitToken(ctx, key, rate, burst)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithField("key", key).Error("failed to rate limit")
+		return true
 	}
+	return ingested
+}
 
-	r.Redis.Client.Incr(ctx, key)
-	r.Redis.Client.Expire(ctx, key, 59*time.Second)
+type ingestPreviewContextKey struct{}
 
-	return true
+// WithIngestPreview makes the ingest decisions made with a context free of side effects, so that items
+// can be previewed without being counted for adaptive sampling or taking rate limit tokens.
+// When set, the settings are used in place of the saved filter settings of their project.
+func WithIngestPreview(ctx context.Context, settings *model.ProjectFilterSettings) context.Context {
+	return context.WithValue(ctx, ingestPreviewContextKey{}, settings)
+}
+
+func getIngestPreviewSettings(ctx context.Context) (*model.ProjectFilterSettings, bool) {
+	settings, ok := ctx.Value(ingestPreviewContextKey{}).(*model.ProjectFilterSettings)
+	return settings, ok
+}
+
+func isIngestPreview(ctx context.Context) bool {
+	_, ok := getIngestPreviewSettings(ctx)
+	return ok
 }
 
 func (r *Resolver) getSettings(ctx context.Context, projectID int, sessionSecureID *string) (*model.ProjectFilterSettings, error) {
@@ -458,6 +600,9 @@ func (r *Resolver) getSettings(ctx context.Context, projectID int, sessionSecure
 
 		projectID = session.ProjectID
 	}
+	if settings, _ := getIngestPreviewSettings(ctx); settings != nil && settings.ProjectID == projectID {
+		return settings, nil
+	}
 	settings, err := r.Store.GetProjectFilterSettings(ctx, projectID)
 	if err != nil {
 		log.WithContext(ctx).WithError(err).Error("failed to get project filter settings")
@@ -467,6 +612,56 @@ func (r *Resolver) getSettings(ctx context.Context, projectID int, sessionSecure
 	return settings, nil
 }
 
+// GetRedactor returns the redactor of the redaction rules of a project, or nil when the project has none.
+func (r *Resolver) GetRedactor(ctx context.Context, projectID int) *redact.Redactor {
+	settings, err := r.getSettings(ctx, projectID, nil)
+	if err != nil || len(settings.RedactionRules) == 0 {
+		return nil
+	}
+	redactor, err := redact.New(projectID, settings.RedactionRules)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithField("project_id", projectID).Error("invalid project redaction rules")
+		return nil
+	}
+	return redactor
+}
+
+// logPipeline is a log pipeline compiled for a version of the filter settings of a project.
+type logPipeline struct {
+	version  time.Time
+	pipeline *pipeline.Pipeline
+}
+
+// logPipelines caches the compiled log pipelines by project, so that pipelines are not
+// compiled for each batch of logs.
+var logPipelines, _ = lru.New[int, *logPipeline](10_000)
+
+// GetLogPipeline returns the compiled log pipeline of a project, or nil when the project has none.
+func (r *Resolver) GetLogPipeline(ctx context.Context, projectID int) *pipeline.Pipeline {
+	settings, err := r.getSettings(ctx, projectID, nil)
+	if err != nil || len(settings.LogPipeline) == 0 {
+		return nil
+	}
+
+	// previewed settings are not cached, so that they do not evict the pipelines of saved settings
+	preview := isIngestPreview(ctx)
+	if !preview {
+		if cached, ok := logPipelines.Get(projectID); ok && cached.version.Equal(settings.UpdatedAt) {
+			return cached.pipeline
+		}
+	}
+
+	p, err := pipeline.New(settings.LogPipeline)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithField("project_id", projectID).Error("invalid project log pipeline")
+		p = nil
+	}
+	if !preview {
+		logPipelines.Add(projectID, &logPipeline{version: settings.UpdatedAt, pipeline: p})
+	}
+	return p
+}
+
 func (r *Resolver) isExcludedError(ctx context.Context, projectID int, errorFilters []string, errorEvent string) bool {
 	if errorEvent == "[{}]" {
 		log.WithContext(ctx).
@@ -503,6 +698,13 @@ func (r *Resolver) isExcludedError(ctx context.Context, projectID int, errorFilt
 	return false
 }
 
+func getErrorServiceName(errorObject *modelInputs.BackendErrorObjectInput) string {
+	if errorObject.Service == nil {
+		return ""
+	}
+	return errorObject.Service.Name
+}
+
 func getErrorObjectID(errorObject *modelInputs.BackendErrorObjectInput) string {
 	id := ptr.ToString(errorObject.RequestID)
 	if id == "" {
@@ -2,7 +2,9 @@ package graph
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
+	"github.com/highlight-run/highlight/backend/clickhouse"
 	"github.com/highlight-run/highlight/backend/model"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	model2 "github.com/highlight-run/highlight/backend/public-graph/graph/model"
@@ -11,6 +13,8 @@ import (
 	"github.com/openlyinc/pointy"
 	"github.com/stretchr/testify/assert"
 	"math"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -83,17 +87,45 @@ func Test_isIngestedByRate(t *testing.T) {
 	const N = 10_000
 	ctx := context.TODO()
 	for max := int64(0); max < N; max += N / 10 {
-		// pin a consistent minute throughout the test to avoid hitting multiple different minute buckets
-		minute := time.Now().Minute()
 		_ = r.Redis.FlushDB(ctx)
-		var ingested int64 = 0
-		for i := 0; i < N; i++ {
-			if r.isIngestedByRateLimit(ctx, "test-project-1", max, minute) {
-				ingested += 1
-			}
+		burst := max / 10
+		start := time.Now()
+
+		// concurrent callers share a bucket, like public graph replicas do
+		var ingested atomic.Int64
+		var wg sync.WaitGroup
+		for w := 0; w < 10; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < N/10; i++ {
+					if r.isIngestedByRateLimit(ctx, "test-project-1", max, burst) {
+						ingested.Add(1)
+					}
+				}
+			}()
 		}
-		assert.LessOrEqualf(t, ingested, max, "expected ingested lte max, max %+v", max)
+		wg.Wait()
+
+		refilled := int64(math.Ceil(time.Since(start).Seconds() * float64(max) / 60))
+		assert.LessOrEqualf(t, ingested.Load(), burst+refilled, "expected ingested lte burst and refilled tokens, max %+v", max)
+		if max > 0 {
+			assert.GreaterOrEqualf(t, ingested.Load(), burst, "expected the full bucket to be ingested, max %+v", max)
+		}
+	}
+}
+
+func Test_isIngestedByRate_Preview(t *testing.T) {
+	r := Resolver{Redis: redis.NewClient()}
+	ctx := context.TODO()
+	_ = r.Redis.FlushDB(ctx)
+
+	preview := WithIngestPreview(ctx, nil)
+	for i := 0; i < 10; i++ {
+		assert.True(t, r.isIngestedByRateLimit(preview, "test-project-1", 60, 1))
 	}
+	assert.True(t, r.isIngestedByRateLimit(ctx, "test-project-1", 60, 1))
+	assert.False(t, r.isIngestedByRateLimit(preview, "test-project-1", 60, 1))
 }
 
 func Test_IsSessionExcluded(t *testing.T) {
@@ -161,3 +193,87 @@ func Test_isExcludedError(t *testing.T) {
 	assert.False(t, resolver.IsErrorIngestedByFilter(ctx, p2.ID, &model2.BackendErrorObjectInput{Event: "foo bar baz"}))
 	assert.True(t, resolver.IsErrorIngestedByFilter(ctx, p3.ID, &model2.BackendErrorObjectInput{Event: "foo bar baz"}))
 }
+
+func Test_getExclusionQuery(t *testing.T) {
+	settings := &model.ProjectFilterSettings{ProjectID: 1}
+	settings.UpdatedAt = time.Now()
+
+	query := getExclusionQuery(settings, modelInputs.ProductTypeLogs, "service_name:worker")
+	assert.True(t, query.matches(&clickhouse.LogRow{ServiceName: "worker"}))
+	assert.False(t, query.matches(&clickhouse.LogRow{ServiceName: "api"}))
+	assert.Same(t, query, getExclusionQuery(settings, modelInputs.ProductTypeLogs, "service_name:worker"))
+
+	settings.UpdatedAt = settings.UpdatedAt.Add(time.Second)
+	query = getExclusionQuery(settings, modelInputs.ProductTypeLogs, "service_name:api")
+	assert.False(t, query.matches(&clickhouse.LogRow{ServiceName: "worker"}))
+	assert.True(t, query.matches(&clickhouse.LogRow{ServiceName: "api"}))
+	assert.NotSame(t, query, getExclusionQuery(settings, modelInputs.ProductTypeTraces, "service_name:api"))
+}
+
+func Test_getTailSampleFactor(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	root := clickhouse.NewTraceRow(now, 1).WithSpanId("root").WithServiceName("frontend").WithDuration(now, now.Add(50*time.Millisecond))
+	child := clickhouse.NewTraceRow(now.Add(time.Millisecond), 1).WithSpanId("child").WithParentSpanId("root").WithServiceName("payments").WithDuration(now, now.Add(5*time.Second))
+	assert.Equal(t, root, getRootSpan([]*clickhouse.TraceRow{child, root}))
+	assert.Equal(t, child, getRootSpan([]*clickhouse.TraceRow{child}))
+
+	settings := &model.ProjectFilterSettings{TraceTailSampling: true}
+	assert.Zero(t, getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root, child}))
+
+	// slow child spans do not keep the trace, only the root span duration does
+	settings.TraceTailSamplingMinDurationMs = pointy.Int64(100)
+	assert.Zero(t, getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root, child}))
+	settings.TraceTailSamplingMinDurationMs = pointy.Int64(10)
+	assert.Equal(t, 1., getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root, child}))
+
+	settings = &model.ProjectFilterSettings{TraceTailSampling: true, TraceTailSamplingQuery: pointy.String("service_name=payments")}
+	assert.Equal(t, 1., getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root, child}))
+	assert.Zero(t, getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root}))
+
+	failed := clickhouse.NewTraceRow(now, 1).WithSpanId("failed").WithParentSpanId("root").WithHasErrors(true)
+	assert.Equal(t, 1., getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root, failed}))
+
+	settings = &model.ProjectFilterSettings{TraceTailSampling: true, TraceSamplingRate: 1}
+	assert.Equal(t, 1., getTailSampleFactor(ctx, settings, "trace", []*clickhouse.TraceRow{root}))
+
+	// traces kept by the sampling rate stand for the traces dropped with them
+	settings = &model.ProjectFilterSettings{TraceTailSampling: true, TraceSamplingRate: 0.25}
+	assert.Equal(t, 4., getTailSampleFactor(ctx, settings, "a87ff679a2f3e71d9181a67b7542122c", []*clickhouse.TraceRow{root}))
+	assert.Zero(t, getTailSampleFactor(ctx, settings, "e4da3b7fbbce2345d7772b0674a318d5", []*clickhouse.TraceRow{root}))
+	assert.Equal(t, 1., getTailSampleFactor(ctx, settings, "e4da3b7fbbce2345d7772b0674a318d5", []*clickhouse.TraceRow{root, failed}))
+}
+
+func Test_getAdaptiveSamplingRates(t *testing.T) {
+	assert.Equal(t, map[string]float64{"api": 1, "worker": 1}, getAdaptiveSamplingRates(map[string]int64{"api": 10, "worker": 20}, 100))
+
+	// quiet services are kept, while the chatty ones share what is left of the target
+	rates := getAdaptiveSamplingRates(map[string]int64{"cron": 10, "api": 1_000, "worker": 10_000}, 1_000)
+	assert.Equal(t, 1., rates["cron"])
+	assert.InDelta(t, 495./1_000, rates["api"], 0.0001)
+	assert.InDelta(t, 495./10_000, rates["worker"], 0.0001)
+
+	var volume float64
+	for group, count := range map[string]int64{"cron": 10, "api": 1_000, "worker": 10_000} {
+		volume += rates[group] * float64(count)
+	}
+	assert.InDelta(t, 1_000, volume, 0.0001)
+
+	assert.Equal(t, 0., getAdaptiveSamplingRates(map[string]int64{"api": 10}, 0)["api"])
+}
+
+func TestAdaptiveSamplingCounts(t *testing.T) {
+	counts := NewAdaptiveSamplingCounts(nil)
+	for i := 0; i < adaptiveSamplingMaxGroups+10; i++ {
+		counts.Add(1, modelInputs.ProductTypeLogs, 100, fmt.Sprintf("service-%d", i))
+	}
+	counts.Add(1, modelInputs.ProductTypeLogs, 100, "service-0")
+	counts.Add(1, modelInputs.ProductTypeTraces, 100, "service-0")
+
+	// items are counted in memory until they are flushed, with the groups beyond the limit in the overflow group
+	groups := counts.counts[adaptiveSamplingCountsKey{projectID: 1, product: modelInputs.ProductTypeLogs, window: 100}]
+	assert.Len(t, groups, adaptiveSamplingMaxGroups+1)
+	assert.Equal(t, int64(2), groups["service-0"])
+	assert.Equal(t, int64(10), groups[adaptiveSamplingOverflowGroup])
+	assert.Len(t, counts.counts, 2)
+}
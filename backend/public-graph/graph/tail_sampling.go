@@ -0,0 +1,206 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/smithy-go/ptr"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	kafkaqueue "github.com/highlight-run/highlight/backend/kafka-queue"
+	"github.com/highlight-run/highlight/backend/model"
+	privateModel "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	"github.com/highlight-run/highlight/backend/redis"
+)
+
+// TraceTailSamplingWindow is how long the spans of a trace are buffered before its sampling decision.
+const TraceTailSamplingWindow = 30 * time.Second
+
+// TraceTailSamplingLock is how long a trace being sampled is locked from other replicas, after which sampling is retried.
+const TraceTailSamplingLock = 5 * time.Minute
+
+const traceTailSamplingLimit = 1000
+
+// IsTraceTailSampled returns whether the traces of a project are sampled once their spans are buffered,
+// rather than by the trace id as they are ingested.
+func (r *Resolver) IsTraceTailSampled(ctx context.Context, projectID int) bool {
+	settings, err := r.getSettings(ctx, projectID, nil)
+	if err != nil {
+		return false
+	}
+	return settings.TraceTailSampling
+}
+
+// BufferTraceForTailSampling buffers the spans of a project until the sampling decision of their trace.
+// Spans arriving after the decision follow it, returning the number of spans dropped by an earlier decision.
+func (r *Resolver) BufferTraceForTailSampling(ctx context.Context, projectID int, traceID string, traceRows []*clickhouse.TraceRow) (int, error) {
+	var spans [][]byte
+	for _, traceRow := range traceRows {
+		span, err := json.Marshal(traceRow)
+		if err != nil {
+			return 0, e.Wrap(err, "failed to marshal trace span")
+		}
+		spans = append(spans, span)
+	}
+
+	trace := redis.TraceToSample{ProjectID: projectID, TraceID: traceID}
+	sampleFactor, err := r.Redis.BufferTraceSpans(ctx, trace, spans, TraceTailSamplingWindow)
+	if err != nil {
+		return 0, err
+	}
+	if sampleFactor == nil {
+		return 0, nil
+	} else if *sampleFactor <= 0 {
+		r.recordTraceDrops(ctx, traceRows, privateModel.IngestReasonSample)
+		return len(traceRows), nil
+	}
+	return 0, r.submitTailSampledTrace(ctx, traceID, traceRows, *sampleFactor)
+}
+
+// SampleTraces makes the sampling decision of the buffered traces whose window has passed.
+// Decisions are stored in redis, so that replicas agree on the decision of a trace and its late spans.
+func (r *Resolver) SampleTraces(ctx context.Context) error {
+	traces, err := r.Redis.GetTracesToSample(ctx, TraceTailSamplingLock, traceTailSamplingLimit)
+	if err != nil {
+		return err
+	}
+	for _, trace := range traces {
+		if err := r.sampleTrace(ctx, trace); err != nil {
+			log.WithContext(ctx).WithError(err).WithField("project_id", trace.ProjectID).WithField("trace_id", trace.TraceID).Error("failed to tail sample trace")
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) sampleTrace(ctx context.Context, trace redis.TraceToSample) error {
+	traceRows, err := r.popTraceSpans(ctx, trace)
+	if err != nil {
+		return err
+	}
+
+	if len(traceRows) > 0 {
+		sampleFactor := 1.
+		if settings, err := r.getSettings(ctx, trace.ProjectID, nil); err == nil {
+			sampleFactor = getTailSampleFactor(ctx, settings, trace.TraceID, traceRows)
+		}
+		// another replica may have decided the trace if its lock expired
+		if sampleFactor, err = r.Redis.SetTraceSampleDecision(ctx, trace, sampleFactor); err != nil {
+			return err
+		}
+
+		// spans buffered while the trace was being decided
+		late, err := r.popTraceSpans(ctx, trace)
+		if err != nil {
+			return err
+		}
+		traceRows = append(traceRows, late...)
+
+		if sampleFactor > 0 {
+			if err := r.submitTailSampledTrace(ctx, trace.TraceID, traceRows, sampleFactor); err != nil {
+				return err
+			}
+		} else {
+			r.recordTraceDrops(ctx, traceRows, privateModel.IngestReasonSample)
+		}
+	}
+
+	return r.Redis.RemoveTraceToSample(ctx, trace)
+}
+
+func (r *Resolver) popTraceSpans(ctx context.Context, trace redis.TraceToSample) ([]*clickhouse.TraceRow, error) {
+	spans, err := r.Redis.PopTraceSpans(ctx, trace)
+	if err != nil {
+		return nil, err
+	}
+	var traceRows []*clickhouse.TraceRow
+	for _, span := range spans {
+		var traceRow clickhouse.TraceRow
+		if err := json.Unmarshal(span, &traceRow); err != nil {
+			return nil, e.Wrap(err, "failed to unmarshal trace span")
+		}
+		traceRows = append(traceRows, &traceRow)
+	}
+	return traceRows, nil
+}
+
+func (r *Resolver) submitTailSampledTrace(ctx context.Context, traceID string, traceRows []*clickhouse.TraceRow, sampleFactor float64) error {
+	var messages []kafkaqueue.RetryableMessage
+	for _, traceRow := range traceRows {
+		traceRow.SampleFactor = sampleFactor
+		if !r.IsTraceIngestedByRateLimit(ctx, traceRow) {
+			r.RecordIngestDrop(ctx, int(traceRow.ProjectId), privateModel.ProductTypeTraces, privateModel.IngestReasonRate, traceRow.ServiceName, 1)
+			continue
+		}
+		messages = append(messages, &kafkaqueue.TraceRowMessage{
+			Type:               kafkaqueue.PushTracesFlattened,
+			ClickhouseTraceRow: clickhouse.ConvertTraceRow(traceRow),
+		})
+	}
+	if err := r.TracesQueue.Submit(ctx, traceID, messages...); err != nil {
+		return e.Wrap(err, "failed to submit tail sampled traces to public worker queue")
+	}
+	return nil
+}
+
+func (r *Resolver) recordTraceDrops(ctx context.Context, traceRows []*clickhouse.TraceRow, reason privateModel.IngestReason) {
+	for _, traceRow := range traceRows {
+		r.RecordIngestDrop(ctx, int(traceRow.ProjectId), privateModel.ProductTypeTraces, reason, traceRow.ServiceName, 1)
+	}
+}
+
+// getRootSpan returns the span without a parent, or the earliest span when the root span was not received.
+func getRootSpan(traceRows []*clickhouse.TraceRow) *clickhouse.TraceRow {
+	root := traceRows[0]
+	for _, traceRow := range traceRows {
+		if traceRow.ParentSpanId == "" {
+			return traceRow
+		} else if traceRow.Timestamp.Before(root.Timestamp) {
+			root = traceRow
+		}
+	}
+	return root
+}
+
+// getTailSampleFactor keeps traces with errors, slow root spans or spans matching the tail sampling query,
+// and samples the other traces by the trace sampling rate. Returns the sample factor of a kept trace,
+// the inverse of the rate it was sampled at, or 0 when the trace is dropped.
+func getTailSampleFactor(ctx context.Context, settings *model.ProjectFilterSettings, traceID string, traceRows []*clickhouse.TraceRow) float64 {
+	for _, traceRow := range traceRows {
+		if traceRow.HasErrors {
+			return 1
+		}
+	}
+
+	if settings.TraceTailSamplingMinDurationMs != nil {
+		root := getRootSpan(traceRows)
+		if time.Duration(root.Duration) > time.Duration(*settings.TraceTailSamplingMinDurationMs)*time.Millisecond {
+			return 1
+		}
+	}
+
+	if query := ptr.ToString(settings.TraceTailSamplingQuery); query != "" {
+		// previewed settings are not cached, so that they do not evict the compiled queries of saved settings
+		var matches func(object interface{}) bool
+		if isIngestPreview(ctx) {
+			matches = compileExclusionQuery(privateModel.ProductTypeTraces, query)
+		} else {
+			matches = getTailSamplingQuery(settings, query).matches
+		}
+		for _, traceRow := range traceRows {
+			if matches(traceRow) {
+				return 1
+			}
+		}
+	}
+
+	rate := settings.TraceSamplingRate
+	if rate >= 1 {
+		return 1
+	} else if !isIngestedBySample(ctx, traceID, rate) {
+		return 0
+	}
+	return 1 / rate
+}
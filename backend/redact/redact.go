@@ -0,0 +1,242 @@
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	e "github.com/pkg/errors"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
+	publicModel "github.com/highlight-run/highlight/backend/public-graph/graph/model"
+)
+
+// Mask replaces values redacted by mask rules.
+const Mask = "[REDACTED]"
+
+// HashPrefix prefixes the truncated sha256 of values redacted by hash rules.
+const HashPrefix = "sha256:"
+
+var detectors = map[modelInputs.RedactionRuleType]*regexp.Regexp{
+	modelInputs.RedactionRuleTypeEmail:      regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`),
+	modelInputs.RedactionRuleTypeCreditCard: regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`),
+	modelInputs.RedactionRuleTypeJwt:        regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`),
+	// access key ids, and secret access keys following their usual variable names
+	modelInputs.RedactionRuleTypeAWSKey: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b|(?i:aws_?secret_?access_?key)["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}\b`),
+}
+
+type rule struct {
+	*modelInputs.RedactionRule
+	pattern *regexp.Regexp
+}
+
+// Redactor masks or hashes the sensitive data of a project's telemetry, following the redaction rules of the project.
+// A nil Redactor leaves values untouched.
+type Redactor struct {
+	projectID int
+	values    []*rule
+	keys      []*rule
+}
+
+// New compiles the redaction rules of a project, returning an error for invalid rules.
+func New(projectID int, rules model.RedactionRules) (*Redactor, error) {
+	r := &Redactor{projectID: projectID}
+	for idx, rr := range rules {
+		if !rr.Type.IsValid() {
+			return nil, fmt.Errorf("invalid redaction rule %d: unknown type %s", idx, rr.Type)
+		}
+		if !rr.Mode.IsValid() {
+			return nil, fmt.Errorf("invalid redaction rule %d: unknown mode %s", idx, rr.Mode)
+		}
+		switch rr.Type {
+		case modelInputs.RedactionRuleTypeAttributeKey:
+			if rr.Pattern == "" {
+				return nil, fmt.Errorf("invalid redaction rule %d: attribute key rule requires a key", idx)
+			}
+			r.keys = append(r.keys, &rule{RedactionRule: rr})
+		case modelInputs.RedactionRuleTypeRegex:
+			pattern, err := regexp.Compile(rr.Pattern)
+			if err != nil {
+				return nil, e.Wrapf(err, "invalid redaction rule %d", idx)
+			}
+			r.values = append(r.values, &rule{RedactionRule: rr, pattern: pattern})
+		default:
+			r.values = append(r.values, &rule{RedactionRule: rr, pattern: detectors[rr.Type]})
+		}
+	}
+	return r, nil
+}
+
+func (r *Redactor) redact(mode modelInputs.RedactionMode, value string) string {
+	if mode == modelInputs.RedactionModeHash {
+		// values are hashed with the project so that they can only be correlated within the project
+		sum := sha256.Sum256([]byte(strconv.Itoa(r.projectID) + ":" + value))
+		return HashPrefix + hex.EncodeToString(sum[:8])
+	}
+	return Mask
+}
+
+// String redacts the matches of the value rules in a string.
+func (r *Redactor) String(value string) string {
+	if r == nil {
+		return value
+	}
+	for _, rr := range r.values {
+		value = rr.pattern.ReplaceAllStringFunc(value, func(match string) string {
+			if rr.Type == modelInputs.RedactionRuleTypeCreditCard && !isLuhnValid(match) {
+				return match
+			}
+			return r.redact(rr.Mode, match)
+		})
+	}
+	return value
+}
+
+// key returns the rule matching an attribute key, also matching the attributes nested under a denied key.
+func (r *Redactor) key(key string) *rule {
+	for _, rr := range r.keys {
+		if strings.EqualFold(key, rr.Pattern) || (len(key) > len(rr.Pattern) && strings.EqualFold(key[:len(rr.Pattern)+1], rr.Pattern+".")) {
+			return rr
+		}
+	}
+	return nil
+}
+
+// Attributes redacts the values of denied attribute keys, and the matches of the value rules in the other attributes.
+func (r *Redactor) Attributes(attributes map[string]string) {
+	if r == nil {
+		return
+	}
+	for k, v := range attributes {
+		if rr := r.key(k); rr != nil {
+			attributes[k] = r.redact(rr.Mode, v)
+		} else {
+			attributes[k] = r.String(v)
+		}
+	}
+}
+
+// JSON redacts a json document, redacting the values of denied keys at any depth.
+// Values that are not json are redacted as strings.
+func (r *Redactor) JSON(value string) string {
+	if r == nil {
+		return value
+	}
+	var doc interface{}
+	if len(r.keys) == 0 || json.Unmarshal([]byte(value), &doc) != nil {
+		return r.String(value)
+	}
+	b, err := json.Marshal(r.walk(doc))
+	if err != nil {
+		return r.String(value)
+	}
+	return string(b)
+}
+
+func (r *Redactor) walk(doc interface{}) interface{} {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if rr := r.key(key); rr != nil {
+				b, _ := json.Marshal(value)
+				v[key] = r.redact(rr.Mode, strings.Trim(string(b), `"`))
+			} else {
+				v[key] = r.walk(value)
+			}
+		}
+	case []interface{}:
+		for idx, value := range v {
+			v[idx] = r.walk(value)
+		}
+	case string:
+		return r.String(v)
+	}
+	return doc
+}
+
+// LogRow redacts the body and attributes of a log.
+func (r *Redactor) LogRow(logRow *clickhouse.LogRow) {
+	if r == nil {
+		return
+	}
+	logRow.Body = r.String(logRow.Body)
+	r.Attributes(logRow.LogAttributes)
+}
+
+// TraceRow redacts the attributes of a span, its events and links, and the status message.
+func (r *Redactor) TraceRow(traceRow *clickhouse.TraceRow) {
+	if r == nil {
+		return
+	}
+	traceRow.StatusMessage = r.String(traceRow.StatusMessage)
+	r.Attributes(traceRow.TraceAttributes)
+	for _, event := range traceRow.Events {
+		r.Attributes(event.Attributes)
+	}
+	for _, link := range traceRow.Links {
+		r.Attributes(link.Attributes)
+	}
+}
+
+// BackendError redacts the event, url, payload and stack trace of a backend error.
+func (r *Redactor) BackendError(errorObject *publicModel.BackendErrorObjectInput) {
+	if r == nil {
+		return
+	}
+	errorObject.Event = r.String(errorObject.Event)
+	errorObject.URL = r.String(errorObject.URL)
+	errorObject.StackTrace = r.JSON(errorObject.StackTrace)
+	if errorObject.Payload != nil {
+		payload := r.JSON(*errorObject.Payload)
+		errorObject.Payload = &payload
+	}
+}
+
+// FrontendError redacts the event, url, payload and the source and arguments of the stack frames of a frontend error.
+func (r *Redactor) FrontendError(errorObject *publicModel.ErrorObjectInput) {
+	if r == nil {
+		return
+	}
+	errorObject.Event = r.String(errorObject.Event)
+	errorObject.URL = r.String(errorObject.URL)
+	if errorObject.Payload != nil {
+		payload := r.JSON(*errorObject.Payload)
+		errorObject.Payload = &payload
+	}
+	for _, frame := range errorObject.StackTrace {
+		if frame.Source != nil {
+			source := r.String(*frame.Source)
+			frame.Source = &source
+		}
+		for idx, arg := range frame.Args {
+			frame.Args[idx] = r.walk(arg)
+		}
+	}
+}
+
+// isLuhnValid returns whether a card number candidate passes the luhn checksum, ignoring separators.
+func isLuhnValid(number string) bool {
+	var sum, digits int
+	for idx := len(number) - 1; idx >= 0; idx-- {
+		c := number[idx]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && sum%10 == 0
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/highlight-run/highlight/backend/env"
+	"math"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/cache/v9"
@@ -24,6 +26,10 @@ import (
 
 const CacheKeyHubspotCompanies = "hubspot-companies"
 const CacheKeySessionsToProcess = "sessions-to-process"
+const CacheKeyTracesToSample = "traces-to-sample"
+
+// TraceSampleDecisionTTL is how long the tail sampling decision of a trace is kept for its late spans.
+const TraceSampleDecisionTTL = time.Hour
 
 type Client struct {
 	Client  redis.Cmdable
@@ -73,6 +79,39 @@ func GitHubFileErrorKey(gitHubRepo string, version string, fileName string) stri
 	return fmt.Sprintf("github-file-error-%s-%s-%s", gitHubRepo, version, fileName)
 }
 
+// the keys of a trace share a hash tag so that the tail sampling scripts can use them in a redis cluster.
+// Trace ids are chosen by clients, so the keys of a trace are scoped by its project.
+func TraceSampleSpansKey(projectID int, traceID string) string {
+	return fmt.Sprintf("trace-sample-spans-{%d-%s}", projectID, traceID)
+}
+
+func TraceSampleDecisionKey(projectID int, traceID string) string {
+	return fmt.Sprintf("trace-sample-decision-{%d-%s}", projectID, traceID)
+}
+
+// TraceToSample is a trace buffered for tail sampling.
+type TraceToSample struct {
+	ProjectID int
+	TraceID   string
+}
+
+func (t TraceToSample) member() string {
+	return fmt.Sprintf("%d-%s", t.ProjectID, t.TraceID)
+}
+
+func parseTraceToSample(member string) (TraceToSample, bool) {
+	project, traceID, found := strings.Cut(member, "-")
+	projectID, err := strconv.Atoi(project)
+	if !found || err != nil {
+		return TraceToSample{}, false
+	}
+	return TraceToSample{ProjectID: projectID, TraceID: traceID}, true
+}
+
+func AdaptiveSamplingCountsKey(projectID int, product string, window int64) string {
+	return fmt.Sprintf("adaptive-sampling-counts-%d-%s-%d", projectID, product, window)
+}
+
 func NewClient() *Client {
 	var lfu cache.LocalCache
 	// disable lfu cache locally to allow flushing cache between test-cases
@@ -436,6 +475,273 @@ func (r *Client) GetSessionsToProcess(ctx context.Context, lockPeriod int, limit
 	return cmd.Int64Slice()
 }
 
+// Buffers the spans of a trace until its tail sampling decision, which is made `delay` in the future.
+// If the trace was already decided, the spans are not buffered and the decision is returned.
+// A decision is the sample factor of the kept trace, or 0 when the trace is dropped.
+func (r *Client) BufferTraceSpans(ctx context.Context, trace TraceToSample, spans [][]byte, delay time.Duration) (*float64, error) {
+	var script = redis.NewScript(`
+		local decisionKey = KEYS[1]
+		local spansKey = KEYS[2]
+		local ttl = ARGV[1]
+
+		local decision = redis.call("GET", decisionKey)
+		if decision then
+			return decision
+		end
+
+		-- unpack is bounded by the lua stack, so spans are pushed in chunks
+		for i = 2, #ARGV, 1000 do
+			redis.call("RPUSH", spansKey, unpack(ARGV, i, math.min(i + 999, #ARGV)))
+		end
+		redis.call("EXPIRE", spansKey, ttl)
+		return false
+	`)
+
+	keys := []string{TraceSampleDecisionKey(trace.ProjectID, trace.TraceID), TraceSampleSpansKey(trace.ProjectID, trace.TraceID)}
+	values := []interface{}{int64(TraceSampleDecisionTTL.Seconds())}
+	for _, span := range spans {
+		values = append(values, snappy.Encode(nil, span))
+	}
+	decision, err := script.Run(ctx, r.Client, keys, values...).Float64()
+	if err == nil {
+		return pointy.Float64(decision), nil
+	} else if !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrap(err, "error buffering trace spans in Redis")
+	}
+
+	// the trace keeps the score of its first spans, so that its decision is not delayed by its later spans
+	if err := r.Client.ZAddNX(ctx, CacheKeyTracesToSample, redis.Z{
+		Score:  float64(time.Now().Add(delay).Unix()),
+		Member: trace.member(),
+	}).Err(); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Retrieves up to `limit` traces to sample. Sets the sampling time of each
+// to `lockPeriod` after the current time, so they can be retried in case sampling fails.
+func (r *Client) GetTracesToSample(ctx context.Context, lockPeriod time.Duration, limit int) ([]TraceToSample, error) {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local now = ARGV[1]
+		local timeAfterLock = ARGV[2]
+		local limit = ARGV[3]
+		local range = redis.call("ZRANGEBYSCORE", key, 0, now, "LIMIT", 0, limit)
+
+		local input = {}
+		for k, item in pairs(range) do
+			table.insert(input, timeAfterLock)
+			table.insert(input, item)
+		end
+
+		if #input == 0 then
+			return {}
+		end
+
+		redis.call("ZADD", key, unpack(input))
+
+		return range
+	`)
+
+	now := time.Now()
+	keys := []string{CacheKeyTracesToSample}
+	values := []interface{}{now.Unix(), now.Add(lockPeriod).Unix(), limit}
+	cmd := script.Run(ctx, r.Client, keys, values...)
+
+	if err := cmd.Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	members, err := cmd.StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	var traces []TraceToSample
+	for _, member := range members {
+		if trace, ok := parseTraceToSample(member); ok {
+			traces = append(traces, trace)
+		} else if err := r.Client.ZRem(ctx, CacheKeyTracesToSample, member).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return traces, nil
+}
+
+// Removes and returns the buffered spans of a trace.
+func (r *Client) PopTraceSpans(ctx context.Context, trace TraceToSample) ([][]byte, error) {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local spans = redis.call("LRANGE", key, 0, -1)
+		redis.call("DEL", key)
+		return spans
+	`)
+
+	cmd := script.Run(ctx, r.Client, []string{TraceSampleSpansKey(trace.ProjectID, trace.TraceID)})
+	if err := cmd.Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	encoded, err := cmd.StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	var spans [][]byte
+	for _, value := range encoded {
+		span, err := snappy.Decode(nil, []byte(value))
+		if err != nil {
+			return nil, errors.Wrap(err, "error decoding trace span")
+		}
+		spans = append(spans, span)
+	}
+	return spans, nil
+}
+
+// Sets the tail sampling decision of a trace, the sample factor of a kept trace or 0 for a dropped trace.
+// The first decision of a trace wins, and is returned.
+func (r *Client) SetTraceSampleDecision(ctx context.Context, trace TraceToSample, sampleFactor float64) (float64, error) {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local decision = ARGV[1]
+		local ttl = ARGV[2]
+
+		redis.call("SET", key, decision, "NX", "EX", ttl)
+		return redis.call("GET", key)
+	`)
+
+	keys := []string{TraceSampleDecisionKey(trace.ProjectID, trace.TraceID)}
+	values := []interface{}{sampleFactor, int64(TraceSampleDecisionTTL.Seconds())}
+	return script.Run(ctx, r.Client, keys, values...).Float64()
+}
+
+// Removes a trace after it was sampled.
+func (r *Client) RemoveTraceToSample(ctx context.Context, trace TraceToSample) error {
+	return r.Client.ZRem(ctx, CacheKeyTracesToSample, trace.member()).Err()
+}
+
+// Takes a token from the token bucket at `key`, which holds up to `burst` tokens and is refilled at
+// `rate` tokens per second. Returns whether a token was taken. The bucket starts full.
+// Buckets are refilled by the clock of redis, so that replicas with skewed clocks share them fairly.
+func (r *Client) TakeRateLimitToken(ctx context.Context, key string, rate float64, burst int64) (bool, error) {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local rate = tonumber(ARGV[1])
+		local burst = tonumber(ARGV[2])
+		local ttl = ARGV[3]
+
+		local time = redis.call("TIME")
+		local now = tonumber(time[1]) + tonumber(time[2]) / 1e6
+
+		local bucket = redis.call("HMGET", key, "tokens", "ts")
+		local tokens = tonumber(bucket[1])
+		local ts = tonumber(bucket[2])
+		if tokens == nil or ts == nil then
+			tokens = burst
+			ts = now
+		end
+
+		tokens = math.min(burst, tokens + math.max(0, now - ts) * rate)
+		local taken = 0
+		if tokens >= 1 then
+			tokens = tokens - 1
+			taken = 1
+		end
+
+		redis.call("HSET", key, "tokens", tostring(tokens), "ts", tostring(math.max(now, ts)))
+		redis.call("EXPIRE", key, ttl)
+		return taken
+	`)
+
+	// the bucket is full again once it expires
+	ttl := int64(math.Ceil(float64(burst)/rate)) + 1
+	keys := []string{key}
+	values := []interface{}{rate, burst, ttl}
+	taken, err := script.Run(ctx, r.Client, keys, values...).Int64()
+	if err != nil {
+		return false, errors.Wrap(err, "error taking rate limit token in Redis")
+	}
+	return taken == 1, nil
+}
+
+// Returns the tokens left in the token bucket at `key` without taking one, see TakeRateLimitToken.
+func (r *Client) GetRateLimitTokens(ctx context.Context, key string, rate float64, burst int64) (float64, error) {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local rate = tonumber(ARGV[1])
+		local burst = tonumber(ARGV[2])
+
+		local bucket = redis.call("HMGET", key, "tokens", "ts")
+		local tokens = tonumber(bucket[1])
+		local ts = tonumber(bucket[2])
+		if tokens == nil or ts == nil then
+			return tostring(burst)
+		end
+
+		local time = redis.call("TIME")
+		local now = tonumber(time[1]) + tonumber(time[2]) / 1e6
+		return tostring(math.min(burst, tokens + math.max(0, now - ts) * rate))
+	`)
+
+	keys := []string{key}
+	values := []interface{}{rate, burst}
+	tokens, err := script.Run(ctx, r.Client, keys, values...).Float64()
+	if err != nil {
+		return 0, errors.Wrap(err, "error getting rate limit tokens from Redis")
+	}
+	return tokens, nil
+}
+
+// Adds the item counts of adaptive sampling groups to the counts of a window. Once `maxGroups` groups are
+// counted, items of other groups are counted in the overflow group.
+func (r *Client) IncrementAdaptiveSamplingCounts(ctx context.Context, key string, counts map[string]int64, overflow string, maxGroups int, ttl time.Duration) error {
+	var script = redis.NewScript(`
+		local key = KEYS[1]
+		local overflow = ARGV[1]
+		local maxGroups = tonumber(ARGV[2])
+		local ttl = ARGV[3]
+
+		for i = 4, #ARGV, 2 do
+			local group = ARGV[i]
+			if redis.call("HEXISTS", key, group) == 0 and redis.call("HLEN", key) >= maxGroups then
+				group = overflow
+			end
+			redis.call("HINCRBY", key, group, ARGV[i + 1])
+		end
+		if redis.call("TTL", key) < 0 then
+			redis.call("EXPIRE", key, ttl)
+		end
+		return 0
+	`)
+
+	keys := []string{key}
+	values := []interface{}{overflow, maxGroups, int64(ttl.Seconds())}
+	for group, count := range counts {
+		values = append(values, group, count)
+	}
+	if err := script.Run(ctx, r.Client, keys, values...).Err(); err != nil {
+		return errors.Wrap(err, "error counting adaptive sampling groups in Redis")
+	}
+	return nil
+}
+
+// Returns the item counts of the adaptive sampling groups of a window.
+func (r *Client) GetAdaptiveSamplingCounts(ctx context.Context, key string) (map[string]int64, error) {
+	values, err := r.Client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting adaptive sampling counts from Redis")
+	}
+
+	counts := make(map[string]int64, len(values))
+	for group, value := range values {
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing adaptive sampling count")
+		}
+		counts[group] = count
+	}
+	return counts, nil
+}
+
 func (r *Client) AddPayload(ctx context.Context, sessionID int, score float64, payloadType model.RawPayloadType, payload []byte) (int, error) {
 	encoded := string(snappy.Encode(nil, payload))
 
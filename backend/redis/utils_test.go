@@ -100,3 +100,38 @@ func BenchmarkLock(b *testing.B) {
 		assert.NoError(b, err)
 	}
 }
+
+func TestTraceToSample(t *testing.T) {
+	trace := TraceToSample{ProjectID: 1, TraceID: "0123456789abcdef0123456789abcdef"}
+	parsed, ok := parseTraceToSample(trace.member())
+	assert.True(t, ok)
+	assert.Equal(t, trace, parsed)
+
+	_, ok = parseTraceToSample("0123456789abcdef0123456789abcdef")
+	assert.False(t, ok)
+
+	// traces of different projects with the same id do not share their spans or decision
+	assert.NotEqual(t, TraceSampleSpansKey(1, trace.TraceID), TraceSampleSpansKey(2, trace.TraceID))
+	assert.NotEqual(t, TraceSampleDecisionKey(1, trace.TraceID), TraceSampleDecisionKey(2, trace.TraceID))
+}
+
+func TestBufferTraceSpans(t *testing.T) {
+	ctx := context.TODO()
+	r := NewClient()
+	traceID, _ := randomString()
+	trace := TraceToSample{ProjectID: 1, TraceID: *traceID}
+
+	// more spans than lua can unpack at once
+	var spans [][]byte
+	for i := 0; i < 10000; i++ {
+		spans = append(spans, []byte("span"))
+	}
+	decision, err := r.BufferTraceSpans(ctx, trace, spans, time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, decision)
+
+	buffered, err := r.PopTraceSpans(ctx, trace)
+	assert.NoError(t, err)
+	assert.Len(t, buffered, len(spans))
+	assert.NoError(t, r.RemoveTraceToSample(ctx, trace))
+}
@@ -0,0 +1,262 @@
+package statsd
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	highlight "github.com/highlight/highlight/sdk/highlight-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
+
+// tags following the datadog unified service tagging convention
+const (
+	ServiceTag     = "service"
+	EnvironmentTag = "env"
+	VersionTag     = "version"
+)
+
+// defaultBounds are the explicit bucket bounds of timers and histograms, matching the otel sdk defaults.
+var defaultBounds = []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+
+type aggregate struct {
+	projectID int
+	name      string
+	typ       string
+	tags      map[string]string
+
+	value float64
+	// count and buckets are weighted by the sample rate, so they are rounded when flushed
+	count   float64
+	sum     float64
+	min     float64
+	max     float64
+	buckets []float64
+	members map[string]struct{}
+}
+
+// Aggregator accumulates statsd metrics until they are flushed as metric rows.
+// Counters are flushed as delta sums, gauges as their last value, sets as the
+// number of unique members and timers, histograms and distributions as histograms.
+type Aggregator struct {
+	mu         sync.Mutex
+	start      time.Time
+	aggregates map[string]*aggregate
+	// gauges retain their value into the next flush interval so that relative updates can be applied,
+	// and are dropped once a flush interval passes without an update
+	gauges map[string]float64
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{
+		start:      time.Now(),
+		aggregates: map[string]*aggregate{},
+		gauges:     map[string]float64{},
+	}
+}
+
+func aggregateKey(projectID int, m *Metric) string {
+	tags := make([]string, 0, len(m.Tags))
+	for k, v := range m.Tags {
+		tags = append(tags, k+":"+v)
+	}
+	sort.Strings(tags)
+	return strings.Join(append([]string{strconv.Itoa(projectID), m.Name, m.Type}, tags...), "|")
+}
+
+func (a *Aggregator) Add(projectID int, m *Metric) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := aggregateKey(projectID, m)
+	agg, ok := a.aggregates[key]
+	if !ok {
+		agg = &aggregate{
+			projectID: projectID,
+			name:      m.Name,
+			typ:       m.Type,
+			tags:      m.Tags,
+			min:       math.Inf(1),
+			max:       math.Inf(-1),
+			buckets:   make([]float64, len(defaultBounds)+1),
+			members:   map[string]struct{}{},
+		}
+		a.aggregates[key] = agg
+	}
+
+	// each sampled value represents 1 / rate values
+	weight := 1 / m.SampleRate
+	switch m.Type {
+	case TypeCounter:
+		for _, v := range m.Values {
+			agg.value += v * weight
+		}
+	case TypeGauge:
+		for _, v := range m.Values {
+			if m.Relative {
+				a.gauges[key] += v
+			} else {
+				a.gauges[key] = v
+			}
+		}
+		agg.value = a.gauges[key]
+	case TypeSet:
+		for _, member := range m.Members {
+			agg.members[member] = struct{}{}
+		}
+	case TypeTimer, TypeHistogram, TypeDistribution:
+		for _, v := range m.Values {
+			agg.count += weight
+			agg.sum += v * weight
+			agg.min = math.Min(agg.min, v)
+			agg.max = math.Max(agg.max, v)
+			agg.buckets[sort.SearchFloat64s(defaultBounds, v)] += weight
+		}
+	}
+}
+
+// merge adds the values of an aggregate of an earlier flush interval, which failed to be submitted.
+func (agg *aggregate) merge(earlier *aggregate) {
+	switch agg.typ {
+	case TypeCounter:
+		agg.value += earlier.value
+	case TypeSet:
+		for member := range earlier.members {
+			agg.members[member] = struct{}{}
+		}
+	case TypeTimer, TypeHistogram, TypeDistribution:
+		agg.count += earlier.count
+		agg.sum += earlier.sum
+		agg.min = math.Min(agg.min, earlier.min)
+		agg.max = math.Max(agg.max, earlier.max)
+		for i := range agg.buckets {
+			agg.buckets[i] += earlier.buckets[i]
+		}
+	}
+	// gauges keep their latest value
+}
+
+func (agg *aggregate) newRow(timestamp time.Time, start time.Time, name string) *clickhouse.MetricRow {
+	attributes := map[string]string{}
+	for k, v := range agg.tags {
+		attributes[k] = v
+	}
+	row := clickhouse.NewMetricRow(timestamp, agg.projectID).
+		WithMetricName(name).
+		WithStartTimestamp(start)
+	if service, ok := attributes[ServiceTag]; ok {
+		row = row.WithServiceName(service)
+		delete(attributes, ServiceTag)
+	} else if service, ok := attributes[string(semconv.ServiceNameKey)]; ok {
+		row = row.WithServiceName(service)
+	}
+	if environment, ok := attributes[EnvironmentTag]; ok {
+		row = row.WithEnvironment(environment)
+		delete(attributes, EnvironmentTag)
+	}
+	if version, ok := attributes[VersionTag]; ok {
+		row = row.WithServiceVersion(version)
+		delete(attributes, VersionTag)
+	}
+	delete(attributes, highlight.DeprecatedProjectIDAttribute)
+	return row.WithAttributes(attributes)
+}
+
+func (agg *aggregate) toRows(timestamp time.Time, start time.Time) []*clickhouse.MetricRow {
+	switch agg.typ {
+	case TypeCounter:
+		return []*clickhouse.MetricRow{agg.newRow(timestamp, start, agg.name).
+			WithMetricType(clickhouse.MetricTypeSum).
+			WithTemporality(clickhouse.MetricTemporalityDelta, agg.value >= 0).
+			WithValue(agg.value)}
+	case TypeGauge:
+		return []*clickhouse.MetricRow{agg.newRow(timestamp, start, agg.name).
+			WithValue(agg.value)}
+	case TypeSet:
+		return []*clickhouse.MetricRow{agg.newRow(timestamp, start, agg.name).
+			WithValue(float64(len(agg.members)))}
+	}
+
+	unit := ""
+	if agg.typ == TypeTimer {
+		unit = "ms"
+	}
+	count := uint64(math.Round(agg.count))
+	buckets := make([]uint64, len(agg.buckets))
+	for i, bucket := range agg.buckets {
+		buckets[i] = uint64(math.Round(bucket))
+	}
+	// like otel histograms, the count and sum are also recorded as counters
+	return []*clickhouse.MetricRow{
+		agg.newRow(timestamp, start, agg.name+".count").
+			WithMetricType(clickhouse.MetricTypeSum).
+			WithTemporality(clickhouse.MetricTemporalityDelta, true).
+			WithValue(float64(count)),
+		agg.newRow(timestamp, start, agg.name+".sum").
+			WithMetricType(clickhouse.MetricTypeSum).
+			WithTemporality(clickhouse.MetricTemporalityDelta, true).
+			WithUnit(unit).
+			WithValue(agg.sum),
+		agg.newRow(timestamp, start, agg.name).
+			WithMetricType(clickhouse.MetricTypeHistogram).
+			WithTemporality(clickhouse.MetricTemporalityDelta, false).
+			WithUnit(unit).
+			WithAggregate(count, agg.sum, agg.min, agg.max).
+			WithBuckets(buckets, defaultBounds),
+	}
+}
+
+// take resets the aggregates, returning the aggregates of the flush interval along with its start.
+// Gauges that were not updated during the interval are dropped.
+func (a *Aggregator) take(timestamp time.Time) (map[string]*aggregate, time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	aggregates, start := a.aggregates, a.start
+	a.aggregates = map[string]*aggregate{}
+	a.start = timestamp
+	for key := range a.gauges {
+		if _, ok := aggregates[key]; !ok {
+			delete(a.gauges, key)
+		}
+	}
+	return aggregates, start
+}
+
+// restore merges the aggregates of a flush interval that failed to be submitted back into the current interval.
+func (a *Aggregator) restore(aggregates map[string]*aggregate, start time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for key, earlier := range aggregates {
+		if agg, ok := a.aggregates[key]; ok {
+			agg.merge(earlier)
+		} else {
+			a.aggregates[key] = earlier
+		}
+	}
+	if start.Before(a.start) {
+		a.start = start
+	}
+}
+
+func getRows(aggregates map[string]*aggregate, timestamp time.Time, start time.Time) []*clickhouse.MetricRow {
+	var rows []*clickhouse.MetricRow
+	for _, agg := range aggregates {
+		if agg.typ == TypeTimer || agg.typ == TypeHistogram || agg.typ == TypeDistribution {
+			if agg.count == 0 {
+				continue
+			}
+		}
+		rows = append(rows, agg.toRows(timestamp, start)...)
+	}
+	return rows
+}
+
+// Flush returns the metric rows aggregated since the previous flush and resets the aggregates.
+func (a *Aggregator) Flush(timestamp time.Time) []*clickhouse.MetricRow {
+	aggregates, start := a.take(timestamp)
+	return getRows(aggregates, timestamp, start)
+}
@@ -0,0 +1,95 @@
+package statsd
+
+import (
+	"strconv"
+	"strings"
+
+	e "github.com/pkg/errors"
+)
+
+const (
+	TypeCounter      = "c"
+	TypeGauge        = "g"
+	TypeTimer        = "ms"
+	TypeHistogram    = "h"
+	TypeDistribution = "d"
+	TypeSet          = "s"
+)
+
+// Metric is a single statsd line, which may carry multiple values in the dogstatsd format.
+type Metric struct {
+	Name       string
+	Type       string
+	Values     []float64
+	Members    []string
+	Relative   bool
+	SampleRate float64
+	Tags       map[string]string
+}
+
+// ParseLine parses a statsd line such as `page.views:1|c|@0.5|#env:prod,service:api`.
+// Dogstatsd events (_e) and service checks (_sc) are not metrics and return an error.
+func ParseLine(line string) (*Metric, error) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "_e{") || strings.HasPrefix(line, "_sc|") {
+		return nil, e.New("dogstatsd events and service checks are not supported")
+	}
+
+	nameEnd := strings.Index(line, ":")
+	if nameEnd <= 0 {
+		return nil, e.Errorf("invalid statsd line %q: missing metric name", line)
+	}
+	sections := strings.Split(line[nameEnd+1:], "|")
+	if len(sections) < 2 {
+		return nil, e.Errorf("invalid statsd line %q: missing metric type", line)
+	}
+
+	m := &Metric{
+		Name:       line[:nameEnd],
+		Type:       sections[1],
+		SampleRate: 1,
+		Tags:       map[string]string{},
+	}
+	switch m.Type {
+	case TypeCounter, TypeGauge, TypeTimer, TypeHistogram, TypeDistribution, TypeSet:
+	default:
+		return nil, e.Errorf("invalid statsd line %q: unknown metric type %s", line, m.Type)
+	}
+
+	// dogstatsd allows multiple values separated by colons
+	for _, raw := range strings.Split(sections[0], ":") {
+		if m.Type == TypeSet {
+			m.Members = append(m.Members, raw)
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, e.Errorf("invalid statsd line %q: invalid value %s", line, raw)
+		}
+		if m.Type == TypeGauge && (strings.HasPrefix(raw, "+") || strings.HasPrefix(raw, "-")) {
+			m.Relative = true
+		}
+		m.Values = append(m.Values, value)
+	}
+
+	for _, section := range sections[2:] {
+		switch {
+		case strings.HasPrefix(section, "@"):
+			rate, err := strconv.ParseFloat(section[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return nil, e.Errorf("invalid statsd line %q: invalid sample rate %s", line, section)
+			}
+			m.SampleRate = rate
+		case strings.HasPrefix(section, "#"):
+			for _, tag := range strings.Split(section[1:], ",") {
+				if tag == "" {
+					continue
+				}
+				key, value, _ := strings.Cut(tag, ":")
+				m.Tags[key] = value
+			}
+		}
+		// other dogstatsd extensions, such as container ids and timestamps, are ignored
+	}
+	return m, nil
+}
@@ -0,0 +1,189 @@
+package statsd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/highlight-run/highlight/backend/model"
+	highlight "github.com/highlight/highlight/sdk/highlight-go"
+	e "github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// FlushInterval matches the default flush interval of the statsd and dogstatsd agents.
+const FlushInterval = 10 * time.Second
+
+// maxPacketSize is the largest udp datagram accepted, larger than the 8KB dogstatsd default buffer.
+const maxPacketSize = 64 * 1024
+
+type SubmitMetricRows func(ctx context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error)
+
+type Server struct {
+	submit     SubmitMetricRows
+	projectID  int
+	aggregator *Aggregator
+}
+
+// New creates a statsd server writing metrics to the project of the configured project token.
+// Metrics may also set the project with a highlight_project_id tag.
+func New(submit SubmitMetricRows, projectToken string) *Server {
+	s := &Server{
+		submit:     submit,
+		aggregator: NewAggregator(),
+	}
+	if projectToken != "" {
+		projectID, err := model.FromVerboseID(projectToken)
+		if err != nil {
+			log.WithError(err).WithField("projectVerboseID", projectToken).Error("invalid statsd project token")
+		}
+		s.projectID = projectID
+	}
+	return s
+}
+
+func (s *Server) getProjectID(ctx context.Context, m *Metric) int {
+	projectToken, ok := m.Tags[highlight.DeprecatedProjectIDAttribute]
+	if !ok {
+		return s.projectID
+	}
+	projectID, err := model.FromVerboseID(projectToken)
+	if err != nil {
+		log.WithContext(ctx).WithError(err).WithField("projectVerboseID", projectToken).Warn("invalid highlight project id statsd tag")
+		return s.projectID
+	}
+	return projectID
+}
+
+// HandleLine parses a statsd line and adds it to the current flush interval.
+func (s *Server) HandleLine(ctx context.Context, line string) error {
+	if strings.TrimSpace(line) == "" {
+		return nil
+	}
+	m, err := ParseLine(line)
+	if err != nil {
+		return err
+	}
+	projectID := s.getProjectID(ctx, m)
+	if projectID == 0 {
+		return e.Errorf("no highlight project for statsd metric %s", m.Name)
+	}
+	s.aggregator.Add(projectID, m)
+	return nil
+}
+
+func (s *Server) handlePacket(ctx context.Context, packet string) {
+	for _, line := range strings.Split(packet, "\n") {
+		if err := s.HandleLine(ctx, line); err != nil {
+			log.WithContext(ctx).WithError(err).Debug("dropped statsd line")
+		}
+	}
+}
+
+// Flush submits the metrics aggregated since the previous flush.
+// Metrics that fail to be submitted are merged into the next flush.
+func (s *Server) Flush(ctx context.Context) error {
+	now := time.Now()
+	aggregates, start := s.aggregator.take(now)
+	rows := getRows(aggregates, now, start)
+	if len(rows) == 0 {
+		return nil
+	}
+	rejected, err := s.submit(ctx, rows)
+	if err != nil {
+		s.aggregator.restore(aggregates, start)
+		return e.Wrap(err, "failed to submit statsd metrics")
+	}
+	if len(rejected) > 0 {
+		log.WithContext(ctx).WithField("rejected", len(rejected)).Warn("rejected statsd metrics")
+	}
+	return nil
+}
+
+// Stop flushes the metrics aggregated since the previous flush, so that they are not lost on shutdown.
+func (s *Server) Stop(ctx context.Context) {
+	if err := s.Flush(ctx); err != nil {
+		log.WithContext(ctx).WithError(err).Error("failed to flush statsd metrics on shutdown")
+	}
+}
+
+func (s *Server) serveUDP(ctx context.Context, conn net.PacketConn) error {
+	buf := make([]byte, maxPacketSize)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return e.Wrap(err, "failed to read statsd udp packet")
+		}
+		s.handlePacket(ctx, string(buf[:n]))
+	}
+}
+
+func (s *Server) serveTCP(ctx context.Context, lis net.Listener) error {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return e.Wrap(err, "failed to accept statsd tcp connection")
+		}
+		go func() {
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			scanner.Buffer(make([]byte, maxPacketSize), maxPacketSize)
+			for scanner.Scan() {
+				if err := s.HandleLine(ctx, scanner.Text()); err != nil {
+					log.WithContext(ctx).WithError(err).Debug("dropped statsd line")
+				}
+			}
+		}()
+	}
+}
+
+// Listen serves statsd over udp and tcp on the provided port, typically 8125,
+// flushing the aggregated metrics every FlushInterval until the context is done.
+func (s *Server) Listen(ctx context.Context, port string) error {
+	conn, err := net.ListenPacket("udp", ":"+port)
+	if err != nil {
+		return e.Wrapf(err, "failed to listen for statsd udp on port %s", port)
+	}
+	defer conn.Close()
+
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return e.Wrapf(err, "failed to listen for statsd tcp on port %s", port)
+	}
+	defer lis.Close()
+
+	go func() {
+		if err := s.serveTCP(ctx, lis); err != nil {
+			log.WithContext(ctx).WithError(err).Error("statsd tcp listener failed")
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				_ = conn.Close()
+				_ = lis.Close()
+				return
+			case <-ticker.C:
+				if err := s.Flush(ctx); err != nil {
+					log.WithContext(ctx).WithError(err).Error("failed to flush statsd metrics")
+				}
+			}
+		}
+	}()
+
+	return s.serveUDP(ctx, conn)
+}
@@ -0,0 +1,191 @@
+package statsd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/highlight-run/highlight/backend/clickhouse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLine(t *testing.T) {
+	m, err := ParseLine("page.views:1|c|@0.5|#env:prod,service:api,canary")
+	assert.NoError(t, err)
+	assert.Equal(t, "page.views", m.Name)
+	assert.Equal(t, TypeCounter, m.Type)
+	assert.Equal(t, []float64{1}, m.Values)
+	assert.Equal(t, 0.5, m.SampleRate)
+	assert.Equal(t, map[string]string{"env": "prod", "service": "api", "canary": ""}, m.Tags)
+
+	m, err = ParseLine("request.latency:12.5:30:7|ms|#route:/users|T1656581400|c:83c0a99c")
+	assert.NoError(t, err)
+	assert.Equal(t, TypeTimer, m.Type)
+	assert.Equal(t, []float64{12.5, 30, 7}, m.Values)
+	assert.Equal(t, map[string]string{"route": "/users"}, m.Tags)
+
+	m, err = ParseLine("queue.depth:-3|g")
+	assert.NoError(t, err)
+	assert.True(t, m.Relative)
+
+	m, err = ParseLine("users.unique:alice|s")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice"}, m.Members)
+
+	for _, line := range []string{"invalid", "name:1", "name:abc|c", "name:1|x", "name:1|c|@2", "_e{5,4}:title|text", "_sc|check|0"} {
+		_, err := ParseLine(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	a := NewAggregator()
+	for _, line := range []string{
+		"page.views:1|c|#service:api,env:prod",
+		"page.views:2|c|@0.5|#env:prod,service:api",
+		"queue.depth:10|g",
+		"queue.depth:-3|g",
+		"users.unique:alice|s",
+		"users.unique:bob|s",
+		"users.unique:alice|s",
+		"request.latency:3:20:400|ms",
+	} {
+		m, err := ParseLine(line)
+		assert.NoError(t, err)
+		a.Add(1, m)
+	}
+
+	now := time.Now()
+	rows := map[string]*clickhouse.MetricRow{}
+	for _, row := range a.Flush(now) {
+		assert.Equal(t, uint32(1), row.ProjectId)
+		rows[row.MetricName] = row
+	}
+	assert.Len(t, rows, 6)
+
+	counter := rows["page.views"]
+	assert.Equal(t, 5., counter.Value)
+	assert.Equal(t, clickhouse.MetricTypeSum, counter.MetricType)
+	assert.Equal(t, clickhouse.MetricTemporalityDelta, counter.Temporality)
+	assert.Equal(t, "api", counter.ServiceName)
+	assert.Equal(t, "prod", counter.Environment)
+	assert.Empty(t, counter.Attributes)
+
+	assert.Equal(t, 7., rows["queue.depth"].Value)
+	assert.Equal(t, clickhouse.MetricTypeGauge, rows["queue.depth"].MetricType)
+	assert.Equal(t, 2., rows["users.unique"].Value)
+
+	histogram := rows["request.latency"]
+	assert.Equal(t, clickhouse.MetricTypeHistogram, histogram.MetricType)
+	assert.Equal(t, uint64(3), histogram.Count)
+	assert.Equal(t, 423., histogram.Sum)
+	assert.Equal(t, 3., histogram.Min)
+	assert.Equal(t, 400., histogram.Max)
+	assert.Equal(t, defaultBounds, histogram.ExplicitBounds)
+	assert.Equal(t, uint64(1), histogram.BucketCounts[1])
+	assert.Equal(t, uint64(1), histogram.BucketCounts[3])
+	assert.Equal(t, uint64(1), histogram.BucketCounts[8])
+	assert.Equal(t, 3., rows["request.latency.count"].Value)
+	assert.Equal(t, 423., rows["request.latency.sum"].Value)
+
+	// gauges retain their value for relative updates in the next interval
+	m, _ := ParseLine("queue.depth:+1|g")
+	a.Add(1, m)
+	rows = map[string]*clickhouse.MetricRow{}
+	for _, row := range a.Flush(now.Add(FlushInterval)) {
+		rows[row.MetricName] = row
+	}
+	assert.Len(t, rows, 1)
+	assert.Equal(t, 8., rows["queue.depth"].Value)
+
+	// gauges are dropped once a flush interval passes without an update
+	assert.Empty(t, a.Flush(now.Add(2*FlushInterval)))
+	assert.Empty(t, a.Flush(now.Add(3*FlushInterval)))
+	assert.Empty(t, a.gauges)
+	a.Add(1, m)
+	rows = map[string]*clickhouse.MetricRow{}
+	for _, row := range a.Flush(now.Add(4 * FlushInterval)) {
+		rows[row.MetricName] = row
+	}
+	assert.Equal(t, 1., rows["queue.depth"].Value)
+}
+
+func TestAggregatorSampleRate(t *testing.T) {
+	a := NewAggregator()
+	for i := 0; i < 7; i++ {
+		m, err := ParseLine("request.latency:20|ms|@0.7")
+		assert.NoError(t, err)
+		a.Add(1, m)
+	}
+
+	rows := map[string]*clickhouse.MetricRow{}
+	for _, row := range a.Flush(time.Now()) {
+		rows[row.MetricName] = row
+	}
+	// 7 values sampled at 0.7 stand for 10 values
+	assert.Equal(t, uint64(10), rows["request.latency"].Count)
+	assert.Equal(t, uint64(10), rows["request.latency"].BucketCounts[3])
+	assert.InDelta(t, 200., rows["request.latency.sum"].Value, 1e-9)
+}
+
+func TestServerFlushRetries(t *testing.T) {
+	healthy := false
+	var submitted []*clickhouse.MetricRow
+	s := New(func(ctx context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error) {
+		if !healthy {
+			return nil, errors.New("queue is down")
+		}
+		submitted = append(submitted, metricRows...)
+		return nil, nil
+	}, "1")
+
+	ctx := context.Background()
+	assert.NoError(t, s.HandleLine(ctx, "jobs.processed:2|c"))
+	assert.Error(t, s.Flush(ctx))
+	assert.NoError(t, s.HandleLine(ctx, "jobs.processed:3|c"))
+
+	// the failed interval is merged into the flush on shutdown
+	healthy = true
+	s.Stop(ctx)
+	assert.Len(t, submitted, 1)
+	assert.Equal(t, 5., submitted[0].Value)
+}
+
+func TestServerListen(t *testing.T) {
+	var submitted []*clickhouse.MetricRow
+	s := New(func(ctx context.Context, metricRows []*clickhouse.MetricRow) (map[*clickhouse.MetricRow]string, error) {
+		submitted = append(submitted, metricRows...)
+		return nil, nil
+	}, "1")
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = s.serveUDP(ctx, conn)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	assert.NoError(t, err)
+	_, err = client.Write([]byte("jobs.processed:1|c\njobs.processed:1|c|#highlight_project_id:2\nnot a metric"))
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		s.aggregator.mu.Lock()
+		defer s.aggregator.mu.Unlock()
+		return len(s.aggregator.aggregates) == 2
+	}, time.Second, 10*time.Millisecond)
+	_ = conn.Close()
+
+	assert.NoError(t, s.Flush(ctx))
+	assert.Len(t, submitted, 2)
+	projects := map[uint32]float64{}
+	for _, row := range submitted {
+		projects[row.ProjectId] = row.Value
+		assert.NotContains(t, row.Attributes, "highlight_project_id")
+	}
+	assert.Equal(t, map[uint32]float64{1: 1, 2: 1}, projects)
+}
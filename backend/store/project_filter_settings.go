@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/smithy-go/ptr"
 	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/highlight-run/highlight/backend/redis"
 
@@ -29,6 +30,8 @@ type UpdateProjectFilterSettingsParams struct {
 	AutoResolveStaleErrorsDayInterval *int
 	FilterSessionsWithoutError        *bool
 	Sampling                          *modelInputs.SamplingInput
+	LogPipeline                       []*modelInputs.LogPipelineStageInput
+	RedactionRules                    []*modelInputs.RedactionRuleInput
 }
 
 func (store *Store) UpdateProjectFilterSettings(ctx context.Context, projectID int, updates UpdateProjectFilterSettingsParams) (*model.ProjectFilterSettings, error) {
@@ -37,11 +40,39 @@ func (store *Store) UpdateProjectFilterSettings(ctx context.Context, projectID i
 		return projectFilterSettings, err
 	}
 
-	workspaceSettings, err := store.GetAllWorkspaceSettingsByProject(ctx, projectID)
-	if err != nil {
+	if err := store.applyProjectFilterSettings(ctx, projectFilterSettings, updates); err != nil {
 		return projectFilterSettings, err
 	}
 
+	result := store.DB.Save(&projectFilterSettings)
+	if result.Error != nil {
+		return nil, err
+	}
+
+	return projectFilterSettings, store.Redis.Del(ctx, getKey(projectID))
+}
+
+// PreviewProjectFilterSettings returns the filter settings of a project with the updates applied, without saving them.
+func (store *Store) PreviewProjectFilterSettings(ctx context.Context, projectID int, updates UpdateProjectFilterSettingsParams) (*model.ProjectFilterSettings, error) {
+	saved, err := store.GetProjectFilterSettings(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the saved settings are cached, so the updates are applied to a copy
+	projectFilterSettings := *saved
+	if err := store.applyProjectFilterSettings(ctx, &projectFilterSettings, updates); err != nil {
+		return nil, err
+	}
+	return &projectFilterSettings, nil
+}
+
+func (store *Store) applyProjectFilterSettings(ctx context.Context, projectFilterSettings *model.ProjectFilterSettings, updates UpdateProjectFilterSettingsParams) error {
+	workspaceSettings, err := store.GetAllWorkspaceSettingsByProject(ctx, projectFilterSettings.ProjectID)
+	if err != nil {
+		return err
+	}
+
 	if updates.AutoResolveStaleErrorsDayInterval != nil {
 		projectFilterSettings.AutoResolveStaleErrorsDayInterval = *updates.AutoResolveStaleErrorsDayInterval
 	}
@@ -76,6 +107,51 @@ func (store *Store) UpdateProjectFilterSettings(ctx context.Context, projectID i
 			if updates.Sampling.TraceMinuteRateLimit != nil {
 				projectFilterSettings.TraceMinuteRateLimit = updates.Sampling.TraceMinuteRateLimit
 			}
+			if updates.Sampling.SessionMinuteRateLimitBurst != nil {
+				projectFilterSettings.SessionMinuteRateLimitBurst = updates.Sampling.SessionMinuteRateLimitBurst
+			}
+			if updates.Sampling.ErrorMinuteRateLimitBurst != nil {
+				projectFilterSettings.ErrorMinuteRateLimitBurst = updates.Sampling.ErrorMinuteRateLimitBurst
+			}
+			if updates.Sampling.LogMinuteRateLimitBurst != nil {
+				projectFilterSettings.LogMinuteRateLimitBurst = updates.Sampling.LogMinuteRateLimitBurst
+			}
+			if updates.Sampling.TraceMinuteRateLimitBurst != nil {
+				projectFilterSettings.TraceMinuteRateLimitBurst = updates.Sampling.TraceMinuteRateLimitBurst
+			}
+			if updates.Sampling.SessionMinuteRateLimitKey != nil {
+				projectFilterSettings.SessionMinuteRateLimitKey = updates.Sampling.SessionMinuteRateLimitKey
+			}
+			if updates.Sampling.ErrorMinuteRateLimitKey != nil {
+				projectFilterSettings.ErrorMinuteRateLimitKey = updates.Sampling.ErrorMinuteRateLimitKey
+			}
+			if updates.Sampling.LogMinuteRateLimitKey != nil {
+				projectFilterSettings.LogMinuteRateLimitKey = updates.Sampling.LogMinuteRateLimitKey
+			}
+			if updates.Sampling.TraceMinuteRateLimitKey != nil {
+				projectFilterSettings.TraceMinuteRateLimitKey = updates.Sampling.TraceMinuteRateLimitKey
+			}
+			if updates.Sampling.TraceTailSampling != nil {
+				projectFilterSettings.TraceTailSampling = *updates.Sampling.TraceTailSampling
+			}
+			if updates.Sampling.TraceTailSamplingMinDurationMs != nil {
+				projectFilterSettings.TraceTailSamplingMinDurationMs = updates.Sampling.TraceTailSamplingMinDurationMs
+			}
+			if updates.Sampling.TraceTailSamplingQuery != nil {
+				projectFilterSettings.TraceTailSamplingQuery = updates.Sampling.TraceTailSamplingQuery
+			}
+			if updates.Sampling.LogAdaptiveSamplingTarget != nil {
+				projectFilterSettings.LogAdaptiveSamplingTarget = updates.Sampling.LogAdaptiveSamplingTarget
+			}
+			if updates.Sampling.LogAdaptiveSamplingKey != nil {
+				projectFilterSettings.LogAdaptiveSamplingKey = updates.Sampling.LogAdaptiveSamplingKey
+			}
+			if updates.Sampling.TraceAdaptiveSamplingTarget != nil {
+				projectFilterSettings.TraceAdaptiveSamplingTarget = updates.Sampling.TraceAdaptiveSamplingTarget
+			}
+			if updates.Sampling.TraceAdaptiveSamplingKey != nil {
+				projectFilterSettings.TraceAdaptiveSamplingKey = updates.Sampling.TraceAdaptiveSamplingKey
+			}
 		}
 		if updates.Sampling.SessionExclusionQuery != nil {
 			projectFilterSettings.SessionExclusionQuery = updates.Sampling.SessionExclusionQuery
@@ -91,12 +167,15 @@ func (store *Store) UpdateProjectFilterSettings(ctx context.Context, projectID i
 		}
 	}
 
-	result := store.DB.Save(&projectFilterSettings)
-	if result.Error != nil {
-		return nil, err
+	if updates.LogPipeline != nil {
+		projectFilterSettings.LogPipeline = GetLogPipeline(updates.LogPipeline)
 	}
 
-	return projectFilterSettings, store.Redis.Del(ctx, getKey(projectID))
+	if updates.RedactionRules != nil {
+		projectFilterSettings.RedactionRules = GetRedactionRules(updates.RedactionRules)
+	}
+
+	return nil
 }
 
 func (store *Store) FindProjectsWithAutoResolveSetting(ctx context.Context) ([]*model.ProjectFilterSettings, error) {
@@ -106,3 +185,38 @@ func (store *Store) FindProjectsWithAutoResolveSetting(ctx context.Context) ([]*
 	}
 	return projectFilterSettings, nil
 }
+
+// GetLogPipeline converts the log pipeline stage inputs to the stages stored with the project filter settings.
+func GetLogPipeline(stages []*modelInputs.LogPipelineStageInput) model.LogPipeline {
+	logPipeline := model.LogPipeline{}
+	for _, input := range stages {
+		stage := &modelInputs.LogPipelineStage{
+			Type:            input.Type,
+			Query:           ptr.ToString(input.Query),
+			Pattern:         ptr.ToString(input.Pattern),
+			Source:          ptr.ToString(input.Source),
+			Target:          ptr.ToString(input.Target),
+			Level:           input.Level,
+			ProjectID:       input.ProjectID,
+			SeverityMapping: []*modelInputs.LogSeverityMapping{},
+		}
+		for _, m := range input.SeverityMapping {
+			stage.SeverityMapping = append(stage.SeverityMapping, &modelInputs.LogSeverityMapping{From: m.From, Level: m.Level})
+		}
+		logPipeline = append(logPipeline, stage)
+	}
+	return logPipeline
+}
+
+// GetRedactionRules converts the redaction rule inputs to the rules stored with the project filter settings.
+func GetRedactionRules(rules []*modelInputs.RedactionRuleInput) model.RedactionRules {
+	redactionRules := model.RedactionRules{}
+	for _, input := range rules {
+		redactionRules = append(redactionRules, &modelInputs.RedactionRule{
+			Type:    input.Type,
+			Pattern: ptr.ToString(input.Pattern),
+			Mode:    input.Mode,
+		})
+	}
+	return redactionRules
+}
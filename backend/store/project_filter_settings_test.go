@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/smithy-go/ptr"
 	"github.com/highlight-run/highlight/backend/model"
+	modelInputs "github.com/highlight-run/highlight/backend/private-graph/graph/model"
 	"github.com/stretchr/testify/assert"
 	_ "gorm.io/driver/postgres"
 )
@@ -57,6 +58,27 @@ func TestUpdateProjectFilterSettings(t *testing.T) {
 	assert.Equal(t, updatedSettings.ProjectID, project.ID)
 	assert.Equal(t, originalSettings.ID, updatedSettings.ID)
 
+	pipelineSettings, err := store.UpdateProjectFilterSettings(ctx, project.ID, UpdateProjectFilterSettingsParams{
+		LogPipeline: []*modelInputs.LogPipelineStageInput{
+			{Type: modelInputs.LogPipelineStageTypeDrop, Source: ptr.String("password")},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, pipelineSettings.LogPipeline, 1)
+	assert.Equal(t, "password", pipelineSettings.LogPipeline[0].Source)
+
+	storedSettings, err := store.GetProjectFilterSettings(ctx, project.ID)
+	assert.NoError(t, err)
+	assert.Len(t, storedSettings.LogPipeline, 1)
+
+	redactionSettings, err := store.UpdateProjectFilterSettings(ctx, project.ID, UpdateProjectFilterSettingsParams{
+		RedactionRules: []*modelInputs.RedactionRuleInput{
+			{Type: modelInputs.RedactionRuleTypeEmail, Mode: modelInputs.RedactionModeHash},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, redactionSettings.RedactionRules, 1)
+	assert.Len(t, redactionSettings.LogPipeline, 1)
 }
 
 func TestFindProjectsWithAutoResolveSetting(t *testing.T) {
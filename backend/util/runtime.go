@@ -53,11 +53,12 @@ const (
 	PublicWorkerTraces       Handler = "public-worker-traces"
 	AutoResolveStaleErrors   Handler = "auto-resolve-stale-errors"
 	StartSessionDeleteJob    Handler = "start-session-delete-job"
+	TraceTailSampler         Handler = "trace-tail-sampler"
 )
 
 func (lt Handler) IsValid() bool {
 	switch lt {
-	case ReportStripeUsage, MigrateDB, MetricMonitors, LogAlerts, BackfillStackFrames, RefreshMaterializedViews, PublicWorkerMain, PublicWorkerBatched, PublicWorkerDataSync, PublicWorkerTraces, AutoResolveStaleErrors:
+	case ReportStripeUsage, MigrateDB, MetricMonitors, LogAlerts, BackfillStackFrames, RefreshMaterializedViews, PublicWorkerMain, PublicWorkerBatched, PublicWorkerDataSync, PublicWorkerTraces, AutoResolveStaleErrors, TraceTailSampler:
 		return true
 	}
 	return false
@@ -153,6 +153,7 @@ func (k *KafkaBatchWorker) flush(ctx context.Context) error {
 	var syncErrorObjectIds []int
 	var logRows []*clickhouse.LogRow
 	var traceRows []*clickhouse.ClickhouseTraceRow
+	var metricRows []*clickhouse.MetricRow
 
 	var lastMsg kafkaqueue.RetryableMessage
 	var oldestMsg = time.Now()
@@ -163,7 +164,7 @@ func (k *KafkaBatchWorker) flush(ctx context.Context) error {
 		}
 
 		publicWorkerMessage, ok := lastMsg.(*kafka_queue.Message)
-		if !ok && lastMsg.GetType() != kafkaqueue.PushLogsFlattened && lastMsg.GetType() != kafkaqueue.PushTracesFlattened {
+		if !ok && lastMsg.GetType() != kafkaqueue.PushLogsFlattened && lastMsg.GetType() != kafkaqueue.PushTracesFlattened && lastMsg.GetType() != kafkaqueue.PushMetricsFlattened {
 			log.WithContext(ctx).Errorf("type assertion failed for *kafka_queue.Message")
 			continue
 		}
@@ -193,6 +194,15 @@ func (k *KafkaBatchWorker) flush(ctx context.Context) error {
 			if traceRow != nil {
 				traceRows = append(traceRows, traceRow.ClickhouseTraceRow)
 			}
+		case kafkaqueue.PushMetricsFlattened:
+			metricRow, ok := lastMsg.(*kafka_queue.MetricRowMessage)
+			if !ok {
+				log.WithContext(ctx).Errorf("type assertion failed for *kafka_queue.MetricRowMessage")
+				continue
+			}
+			if metricRow != nil {
+				metricRows = append(metricRows, metricRow.MetricRow)
+			}
 		case kafkaqueue.PushLogs:
 			logRow := publicWorkerMessage.PushLogs.LogRow
 			if logRow != nil {
@@ -211,11 +221,12 @@ func (k *KafkaBatchWorker) flush(ctx context.Context) error {
 	k.log(
 		ctx,
 		log.Fields{
-			"session_ids":       syncSessionIds,
-			"error_group_ids":   syncErrorGroupIds,
-			"error_object_ids":  syncErrorObjectIds,
-			"log_rows_length":   len(logRows),
-			"trace_rows_length": len(traceRows),
+			"session_ids":        syncSessionIds,
+			"error_group_ids":    syncErrorGroupIds,
+			"error_object_ids":   syncErrorObjectIds,
+			"log_rows_length":    len(logRows),
+			"trace_rows_length":  len(traceRows),
+			"metric_rows_length": len(metricRows),
 		},
 		"KafkaBatchWorker organized messages",
 	)
@@ -244,6 +255,12 @@ func (k *KafkaBatchWorker) flush(ctx context.Context) error {
 			return err
 		}
 	}
+	if len(metricRows) > 0 {
+		if err := k.flushMetrics(wCtx, metricRows); err != nil {
+			workSpan.Finish(err)
+			return err
+		}
+	}
 	workSpan.Finish()
 
 	commitSpan, cCtx := util.StartSpanFromContext(ctx, fmt.Sprintf("worker.kafka.%s.flush.commit", k.Name))
@@ -374,6 +391,7 @@ func (k *KafkaBatchWorker) flushLogs(ctx context.Context, logRows []*clickhouse.
 
 		// Filter out any log rows for projects where the log quota has been exceeded
 		if quotaExceededByProject[logRow.ProjectId] {
+			k.Worker.PublicResolver.RecordIngestDrop(ctx, int(logRow.ProjectId), privateModel.ProductTypeLogs, privateModel.IngestReasonQuota, logRow.ServiceName, 1)
 			continue
 		}
 
@@ -427,6 +445,7 @@ func (k *KafkaBatchWorker) flushTraces(ctx context.Context, traceRows []*clickho
 	filteredTraceRows := []*clickhouse.ClickhouseTraceRow{}
 	for _, trace := range traceRows {
 		if quotaExceededByProject[trace.ProjectId] {
+			k.Worker.PublicResolver.RecordIngestDrop(ctx, int(trace.ProjectId), privateModel.ProductTypeTraces, privateModel.IngestReasonQuota, trace.ServiceName, 1)
 			continue
 		}
 		filteredTraceRows = append(filteredTraceRows, trace)
@@ -453,6 +472,21 @@ func (k *KafkaBatchWorker) flushTraces(ctx context.Context, traceRows []*clickho
 	return nil
 }
 
+// flushMetrics writes the metric rows to clickhouse. Unlike logs and traces, the billing quota of metrics
+// is enforced when they are submitted, so that the rejected data points can be reported to the exporter.
+func (k *KafkaBatchWorker) flushMetrics(ctx context.Context, metricRows []*clickhouse.MetricRow) error {
+	span, ctxT := util.StartSpanFromContext(ctx, fmt.Sprintf("worker.kafka.%s.flush.clickhouse", k.Name), util.WithHighlightTracingDisabled(true))
+	span.SetAttribute("NumMetricRows", len(metricRows))
+	err := k.Worker.PublicResolver.Clickhouse.BatchWriteMetricRows(ctxT, metricRows)
+	span.Finish(err)
+	if err != nil {
+		log.WithContext(ctxT).WithError(err).Error("failed to batch write metrics to clickhouse")
+		return err
+	}
+
+	return nil
+}
+
 func (k *KafkaBatchWorker) flushDataSync(ctx context.Context, sessionIds []int, errorGroupIds []int, errorObjectIds []int) error {
 	sessionIdChunks := lo.Chunk(lo.Uniq(sessionIds), SessionsMaxRowsPostgres)
 	if len(sessionIdChunks) > 0 {
@@ -509,6 +543,7 @@ func (k *KafkaBatchWorker) flushDataSync(ctx context.Context, sessionIds []int,
 				// fields are populated, so calculate whether session is excluded
 				if k.Worker.PublicResolver.IsSessionExcludedByFilter(ctx, session) {
 					reason := privateModel.SessionExcludedReasonExclusionFilter
+					k.Worker.PublicResolver.RecordSessionDrop(ctx, session, reason)
 					session.Excluded = true
 					session.ExcludedReason = &reason
 					span.SetAttribute("reason", session.ExcludedReason)
@@ -1146,6 +1146,20 @@ func (w *Worker) StartSessionDeleteJob(ctx context.Context) {
 	}
 }
 
+// StartTraceTailSampler decides the traces buffered for tail sampling once their decision window has passed.
+func (w *Worker) StartTraceTailSampler(ctx context.Context) {
+	log.WithContext(ctx).Info("Starting TraceTailSampler")
+
+	for range time.Tick(time.Second) {
+		span, sCtx := util.StartSpanFromContext(ctx, "worker.sampleTraces", util.ResourceName("worker.sampleTraces"))
+		err := w.PublicResolver.SampleTraces(sCtx)
+		if err != nil {
+			log.WithContext(ctx).WithError(err).Error("failed to sample traces")
+		}
+		span.Finish(err)
+	}
+}
+
 func (w *Worker) RefreshMaterializedViews(ctx context.Context) {
 	span, _ := util.StartSpanFromContext(ctx, "worker.refreshMaterializedViews",
 		util.ResourceName("worker.refreshMaterializedViews"))
@@ -1334,6 +1348,8 @@ func (w *Worker) GetHandler(ctx context.Context, handlerFlag util.Handler) func(
 		return w.AutoResolveStaleErrors
 	case util.StartSessionDeleteJob:
 		return w.StartSessionDeleteJob
+	case util.TraceTailSampler:
+		return w.StartTraceTailSampler
 	case "":
 		// no handler provided defaults to the session worker
 		return w.Start
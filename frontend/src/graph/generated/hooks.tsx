@@ -8903,6 +8903,73 @@ export type GetWorkspaceUsageHistoryQueryResult = Apollo.QueryResult<
 	Types.GetWorkspaceUsageHistoryQuery,
 	Types.GetWorkspaceUsageHistoryQueryVariables
 >
+export const GetWorkspaceIngestDropsDocument = gql`
+	query GetWorkspaceIngestDrops(
+		$workspace_id: ID!
+		$product_type: ProductType!
+		$date_range: DateRangeRequiredInput!
+	) {
+		ingestDrops(
+			workspace_id: $workspace_id
+			product_type: $product_type
+			date_range: $date_range
+		) {
+			reason
+			count
+		}
+	}
+`
+
+/**
+ * __useGetWorkspaceIngestDropsQuery__
+ *
+ * To run a query within a React component, call `useGetWorkspaceIngestDropsQuery` and pass it any options that fit your needs.
+ * When your component renders, `useGetWorkspaceIngestDropsQuery` returns an object from Apollo Client that contains loading, error, and data properties
+ * you can use to render your UI.
+ *
+ * @param baseOptions options that will be passed into the query, supported options are listed on: https://www.apollographql.com/docs/react/api/react-hooks/#options;
+ *
+ * @example
+ * const { data, loading, error } = useGetWorkspaceIngestDropsQuery({
+ *   variables: {
+ *      workspace_id: // value for 'workspace_id'
+ *      product_type: // value for 'product_type'
+ *      date_range: // value for 'date_range'
+ *   },
+ * });
+ */
+export function useGetWorkspaceIngestDropsQuery(
+	baseOptions: Apollo.QueryHookOptions<
+		Types.GetWorkspaceIngestDropsQuery,
+		Types.GetWorkspaceIngestDropsQueryVariables
+	>,
+) {
+	return Apollo.useQuery<
+		Types.GetWorkspaceIngestDropsQuery,
+		Types.GetWorkspaceIngestDropsQueryVariables
+	>(GetWorkspaceIngestDropsDocument, baseOptions)
+}
+export function useGetWorkspaceIngestDropsLazyQuery(
+	baseOptions?: Apollo.LazyQueryHookOptions<
+		Types.GetWorkspaceIngestDropsQuery,
+		Types.GetWorkspaceIngestDropsQueryVariables
+	>,
+) {
+	return Apollo.useLazyQuery<
+		Types.GetWorkspaceIngestDropsQuery,
+		Types.GetWorkspaceIngestDropsQueryVariables
+	>(GetWorkspaceIngestDropsDocument, baseOptions)
+}
+export type GetWorkspaceIngestDropsQueryHookResult = ReturnType<
+	typeof useGetWorkspaceIngestDropsQuery
+>
+export type GetWorkspaceIngestDropsLazyQueryHookResult = ReturnType<
+	typeof useGetWorkspaceIngestDropsLazyQuery
+>
+export type GetWorkspaceIngestDropsQueryResult = Apollo.QueryResult<
+	Types.GetWorkspaceIngestDropsQuery,
+	Types.GetWorkspaceIngestDropsQueryVariables
+>
 export const GetBillingDetailsDocument = gql`
 	query GetBillingDetails($workspace_id: ID!) {
 		billingDetails(workspace_id: $workspace_id) {
@@ -2960,6 +2960,18 @@ export type GetWorkspaceUsageHistoryQuery = { __typename?: 'Query' } & {
 	}
 }
 
+export type GetWorkspaceIngestDropsQueryVariables = Types.Exact<{
+	workspace_id: Types.Scalars['ID']
+	product_type: Types.ProductType
+	date_range: Types.DateRangeRequiredInput
+}>
+
+export type GetWorkspaceIngestDropsQuery = { __typename?: 'Query' } & {
+	ingestDrops: Array<
+		{ __typename?: 'IngestDrop' } & Pick<Types.IngestDrop, 'reason' | 'count'>
+	>
+}
+
 export type GetBillingDetailsQueryVariables = Types.Exact<{
 	workspace_id: Types.Scalars['ID']
 }>
@@ -5378,6 +5390,7 @@ export const namedOperations = {
 		GetProject: 'GetProject' as const,
 		GetBillingDetailsForProject: 'GetBillingDetailsForProject' as const,
 		GetWorkspaceUsageHistory: 'GetWorkspaceUsageHistory' as const,
+		GetWorkspaceIngestDrops: 'GetWorkspaceIngestDrops' as const,
 		GetBillingDetails: 'GetBillingDetails' as const,
 		GetSubscriptionDetails: 'GetSubscriptionDetails' as const,
 		GetErrorGroup: 'GetErrorGroup' as const,
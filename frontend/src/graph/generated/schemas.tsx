@@ -207,10 +207,12 @@ export type AllProjectSettings = {
 	filterSessionsWithoutError: Scalars['Boolean']
 	filter_chrome_extension?: Maybe<Scalars['Boolean']>
 	id: Scalars['ID']
+	log_pipeline: Array<LogPipelineStage>
 	name: Scalars['String']
 	rage_click_count?: Maybe<Scalars['Int']>
 	rage_click_radius_pixels?: Maybe<Scalars['Int']>
 	rage_click_window_seconds?: Maybe<Scalars['Int']>
+	redaction_rules: Array<RedactionRule>
 	sampling: Sampling
 	verbose_id: Scalars['String']
 	workspace_id: Scalars['ID']
@@ -841,8 +843,42 @@ export type HistogramBucket = {
 	range_start: Scalars['Float']
 }
 
+export type IngestDrop = {
+	__typename?: 'IngestDrop'
+	count: Scalars['UInt64']
+	reason: IngestReason
+}
+
+export type IngestPreviewError = {
+	__typename?: 'IngestPreviewError'
+	environment: Scalars['String']
+	event: Scalars['String']
+	service_name: Scalars['String']
+	source: Scalars['String']
+	span_id?: Maybe<Scalars['String']>
+	stack_trace: Scalars['String']
+	timestamp: Scalars['Timestamp']
+	trace_id?: Maybe<Scalars['String']>
+	type: Scalars['String']
+}
+
+export type IngestPreviewItem = {
+	__typename?: 'IngestPreviewItem'
+	dropped_by_pipeline: Scalars['Boolean']
+	error?: Maybe<IngestPreviewError>
+	exclusion_query?: Maybe<Scalars['String']>
+	ingested: Scalars['Boolean']
+	log?: Maybe<Log>
+	product: ProductType
+	quota_exceeded: Scalars['Boolean']
+	reason?: Maybe<IngestReason>
+	tail_sampled: Scalars['Boolean']
+	trace?: Maybe<Trace>
+}
+
 export enum IngestReason {
 	Filter = 'Filter',
+	Quota = 'Quota',
 	Rate = 'Rate',
 	Sample = 'Sample',
 }
@@ -1046,6 +1082,51 @@ export type LogLine = {
 	timestamp: Scalars['Timestamp']
 }
 
+export type LogPipelineStage = {
+	__typename?: 'LogPipelineStage'
+	level?: Maybe<LogLevel>
+	pattern: Scalars['String']
+	project_id?: Maybe<Scalars['ID']>
+	query: Scalars['String']
+	severity_mapping?: Maybe<Array<LogSeverityMapping>>
+	source: Scalars['String']
+	target: Scalars['String']
+	type: LogPipelineStageType
+}
+
+export type LogPipelineStageInput = {
+	level?: InputMaybe<LogLevel>
+	pattern?: InputMaybe<Scalars['String']>
+	project_id?: InputMaybe<Scalars['ID']>
+	query?: InputMaybe<Scalars['String']>
+	severity_mapping?: InputMaybe<Array<LogSeverityMappingInput>>
+	source?: InputMaybe<Scalars['String']>
+	target?: InputMaybe<Scalars['String']>
+	type: LogPipelineStageType
+}
+
+export enum LogPipelineStageType {
+	Copy = 'Copy',
+	Drop = 'Drop',
+	DropLog = 'DropLog',
+	Parse = 'Parse',
+	ParseJson = 'ParseJSON',
+	Rename = 'Rename',
+	Route = 'Route',
+	Severity = 'Severity',
+}
+
+export type LogSeverityMapping = {
+	__typename?: 'LogSeverityMapping'
+	from: Scalars['String']
+	level: LogLevel
+}
+
+export type LogSeverityMappingInput = {
+	from: Scalars['String']
+	level: LogLevel
+}
+
 export enum LogSource {
 	Backend = 'backend',
 	Frontend = 'frontend',
@@ -1099,6 +1180,7 @@ export enum MetricAggregator {
 	Count = 'Count',
 	CountDistinct = 'CountDistinct',
 	CountDistinctKey = 'CountDistinctKey',
+	Increase = 'Increase',
 	Max = 'Max',
 	Min = 'Min',
 	None = 'None',
@@ -1106,6 +1188,7 @@ export enum MetricAggregator {
 	P90 = 'P90',
 	P95 = 'P95',
 	P99 = 'P99',
+	Rate = 'Rate',
 	Sum = 'Sum',
 }
 
@@ -1580,11 +1663,13 @@ export type MutationEditProjectSettingsArgs = {
 	excluded_users?: InputMaybe<Scalars['StringArray']>
 	filterSessionsWithoutError?: InputMaybe<Scalars['Boolean']>
 	filter_chrome_extension?: InputMaybe<Scalars['Boolean']>
+	log_pipeline?: InputMaybe<Array<LogPipelineStageInput>>
 	name?: InputMaybe<Scalars['String']>
 	projectId: Scalars['ID']
 	rage_click_count?: InputMaybe<Scalars['Int']>
 	rage_click_radius_pixels?: InputMaybe<Scalars['Int']>
 	rage_click_window_seconds?: InputMaybe<Scalars['Int']>
+	redaction_rules?: InputMaybe<Array<RedactionRuleInput>>
 	sampling?: InputMaybe<SamplingInput>
 }
 
@@ -2026,6 +2111,7 @@ export enum PlanType {
 export enum ProductType {
 	Errors = 'Errors',
 	Events = 'Events',
+	IngestDrops = 'IngestDrops',
 	Logs = 'Logs',
 	Metrics = 'Metrics',
 	Sessions = 'Sessions',
@@ -2120,6 +2206,8 @@ export type Query = {
 	height_lists: Array<HeightList>
 	height_workspaces: Array<HeightWorkspace>
 	identifier_suggestion: Array<Scalars['String']>
+	ingestDrops: Array<IngestDrop>
+	ingest_preview: Array<IngestPreviewItem>
 	integration_project_mappings: Array<IntegrationProjectMapping>
 	isSessionPending?: Maybe<Scalars['Boolean']>
 	is_integrated_with: Scalars['Boolean']
@@ -2559,6 +2647,18 @@ export type QueryIdentifier_SuggestionArgs = {
 	query: Scalars['String']
 }
 
+export type QueryIngestDropsArgs = {
+	date_range: DateRangeRequiredInput
+	product_type: ProductType
+	workspace_id: Scalars['ID']
+}
+
+export type QueryIngest_PreviewArgs = {
+	payload: Scalars['String']
+	project_id: Scalars['ID']
+	sampling?: InputMaybe<SamplingInput>
+}
+
 export type QueryIntegration_Project_MappingsArgs = {
 	integration_type?: InputMaybe<IntegrationType>
 	workspace_id: Scalars['ID']
@@ -3109,6 +3209,33 @@ export type RageClickEventForProject = {
 	user_properties: Scalars['String']
 }
 
+export enum RedactionMode {
+	Hash = 'Hash',
+	Mask = 'Mask',
+}
+
+export type RedactionRule = {
+	__typename?: 'RedactionRule'
+	mode: RedactionMode
+	pattern: Scalars['String']
+	type: RedactionRuleType
+}
+
+export type RedactionRuleInput = {
+	mode: RedactionMode
+	pattern?: InputMaybe<Scalars['String']>
+	type: RedactionRuleType
+}
+
+export enum RedactionRuleType {
+	AttributeKey = 'AttributeKey',
+	AwsKey = 'AWSKey',
+	CreditCard = 'CreditCard',
+	Email = 'Email',
+	Jwt = 'JWT',
+	Regex = 'Regex',
+}
+
 export type ReferrerTablePayload = {
 	__typename?: 'ReferrerTablePayload'
 	count: Scalars['Int']
@@ -3268,31 +3395,61 @@ export type Sampling = {
 	__typename?: 'Sampling'
 	error_exclusion_query?: Maybe<Scalars['String']>
 	error_minute_rate_limit?: Maybe<Scalars['Int64']>
+	error_minute_rate_limit_burst?: Maybe<Scalars['Int64']>
+	error_minute_rate_limit_key?: Maybe<Scalars['String']>
 	error_sampling_rate: Scalars['Float']
+	log_adaptive_sampling_key?: Maybe<Scalars['String']>
+	log_adaptive_sampling_target?: Maybe<Scalars['Int64']>
 	log_exclusion_query?: Maybe<Scalars['String']>
 	log_minute_rate_limit?: Maybe<Scalars['Int64']>
+	log_minute_rate_limit_burst?: Maybe<Scalars['Int64']>
+	log_minute_rate_limit_key?: Maybe<Scalars['String']>
 	log_sampling_rate: Scalars['Float']
 	session_exclusion_query?: Maybe<Scalars['String']>
 	session_minute_rate_limit?: Maybe<Scalars['Int64']>
+	session_minute_rate_limit_burst?: Maybe<Scalars['Int64']>
+	session_minute_rate_limit_key?: Maybe<Scalars['String']>
 	session_sampling_rate: Scalars['Float']
+	trace_adaptive_sampling_key?: Maybe<Scalars['String']>
+	trace_adaptive_sampling_target?: Maybe<Scalars['Int64']>
 	trace_exclusion_query?: Maybe<Scalars['String']>
 	trace_minute_rate_limit?: Maybe<Scalars['Int64']>
+	trace_minute_rate_limit_burst?: Maybe<Scalars['Int64']>
+	trace_minute_rate_limit_key?: Maybe<Scalars['String']>
 	trace_sampling_rate: Scalars['Float']
+	trace_tail_sampling: Scalars['Boolean']
+	trace_tail_sampling_min_duration_ms?: Maybe<Scalars['Int64']>
+	trace_tail_sampling_query?: Maybe<Scalars['String']>
 }
 
 export type SamplingInput = {
 	error_exclusion_query?: InputMaybe<Scalars['String']>
 	error_minute_rate_limit?: InputMaybe<Scalars['Int64']>
+	error_minute_rate_limit_burst?: InputMaybe<Scalars['Int64']>
+	error_minute_rate_limit_key?: InputMaybe<Scalars['String']>
 	error_sampling_rate?: InputMaybe<Scalars['Float']>
+	log_adaptive_sampling_key?: InputMaybe<Scalars['String']>
+	log_adaptive_sampling_target?: InputMaybe<Scalars['Int64']>
 	log_exclusion_query?: InputMaybe<Scalars['String']>
 	log_minute_rate_limit?: InputMaybe<Scalars['Int64']>
+	log_minute_rate_limit_burst?: InputMaybe<Scalars['Int64']>
+	log_minute_rate_limit_key?: InputMaybe<Scalars['String']>
 	log_sampling_rate?: InputMaybe<Scalars['Float']>
 	session_exclusion_query?: InputMaybe<Scalars['String']>
 	session_minute_rate_limit?: InputMaybe<Scalars['Int64']>
+	session_minute_rate_limit_burst?: InputMaybe<Scalars['Int64']>
+	session_minute_rate_limit_key?: InputMaybe<Scalars['String']>
 	session_sampling_rate?: InputMaybe<Scalars['Float']>
+	trace_adaptive_sampling_key?: InputMaybe<Scalars['String']>
+	trace_adaptive_sampling_target?: InputMaybe<Scalars['Int64']>
 	trace_exclusion_query?: InputMaybe<Scalars['String']>
 	trace_minute_rate_limit?: InputMaybe<Scalars['Int64']>
+	trace_minute_rate_limit_burst?: InputMaybe<Scalars['Int64']>
+	trace_minute_rate_limit_key?: InputMaybe<Scalars['String']>
 	trace_sampling_rate?: InputMaybe<Scalars['Float']>
+	trace_tail_sampling?: InputMaybe<Scalars['Boolean']>
+	trace_tail_sampling_min_duration_ms?: InputMaybe<Scalars['Int64']>
+	trace_tail_sampling_query?: InputMaybe<Scalars['String']>
 }
 
 export type SanitizedAdmin = {
@@ -88,6 +88,7 @@ const ALERT_PRODUCT_INFO = {
 	[ProductType.Traces]: false,
 	[ProductType.Metrics]: false,
 	[ProductType.Events]: false,
+	[ProductType.IngestDrops]: false,
 }
 
 export const AlertForm: React.FC = () => {
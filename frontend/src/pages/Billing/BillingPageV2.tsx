@@ -36,6 +36,7 @@ import { LoadingRightPanel } from '@/components/Loading/Loading'
 import {
 	useGetBillingDetailsQuery,
 	useGetCustomerPortalUrlLazyQuery,
+	useGetWorkspaceIngestDropsQuery,
 	useGetWorkspaceUsageHistoryQuery,
 	useUpdateBillingDetailsMutation,
 } from '@/graph/generated/hooks'
@@ -140,6 +141,22 @@ const UsageCard = ({
 	})
 	const usageHistory = usageHistoryData?.usageHistory?.usage
 
+	const { data: ingestDropsData } = useGetWorkspaceIngestDropsQuery({
+		variables: {
+			workspace_id: workspace_id!,
+			product_type: productType,
+			date_range: {
+				start_date: usageRange.start.toISOString(),
+				end_date: usageRange.end.toISOString(),
+			},
+		},
+	})
+	const ingestDrops = ingestDropsData?.ingestDrops ?? []
+	const droppedAmount = ingestDrops.reduce(
+		(total, drop) => total + Number(drop.count),
+		0,
+	)
+
 	const costCents = isPaying
 		? getCostCents(
 				productType,
@@ -243,6 +260,43 @@ const UsageCard = ({
 							</Text>
 						</Box>
 					</Tooltip>
+					{droppedAmount > 0 ? (
+						<Tooltip
+							maxWidth={177}
+							delayed
+							trigger={
+								<Badge
+									size="medium"
+									shape="basic"
+									kind="secondary"
+									label={`${droppedAmount.toLocaleString()} dropped`}
+									iconEnd={
+										<IconSolidInformationCircle size={12} />
+									}
+								/>
+							}
+						>
+							<Box padding="4">
+								<Text size="xSmall" color="moderate">
+									<b>{productType}</b> not ingested since{' '}
+									{usageRange.start.format('MMM D')}, by
+									reason:
+								</Text>
+								{ingestDrops.map((drop) => (
+									<Text
+										key={drop.reason}
+										size="xSmall"
+										color="moderate"
+									>
+										{drop.reason}:{' '}
+										{formatNumberWithDelimiters(
+											Number(drop.count),
+										)}
+									</Text>
+								))}
+							</Box>
+						</Tooltip>
+					) : null}
 					{enableBillingLimits ? (
 						<Tooltip
 							maxWidth={177}
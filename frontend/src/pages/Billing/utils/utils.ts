@@ -142,6 +142,7 @@ export const getMeterAmounts = ({
 			[ProductType.Traces]: [0, undefined],
 			[ProductType.Metrics]: [0, undefined],
 			[ProductType.Events]: [0, undefined],
+			[ProductType.IngestDrops]: [0, undefined],
 		}
 	}
 	const trialActive = workspace?.trial_end_date
@@ -181,6 +182,8 @@ export const getMeterAmounts = ({
 		[ProductType.Metrics]: [0, undefined],
 		// TODO(spenny): better way to add new searches without needing to add a new billable product
 		[ProductType.Events]: [0, undefined],
+		// dropped items are not billed
+		[ProductType.IngestDrops]: [0, undefined],
 	}
 }
 
@@ -1,5 +1,6 @@
 import {
 	IconSolidChartBar,
+	IconSolidFilter,
 	IconSolidFire,
 	Box,
 	IconSolidLightningBolt,
@@ -38,6 +39,11 @@ export const PRODUCT_OPTIONS = [
 		value: ProductType.Errors,
 		icon: <IconSolidLightningBolt key="errors" />,
 	},
+	{
+		name: ProductType.IngestDrops,
+		value: ProductType.IngestDrops,
+		icon: <IconSolidFilter key="ingest-drops" />,
+	},
 	// TODO(vkorolik) metrics disabled in the frontend to avoid confusion
 	// {
 	// 	name: ProductType.Metrics,
@@ -65,6 +71,7 @@ export const PRODUCTS_TO_ICONS = {
 	[ProductType.Errors]: <IconSolidLightningBolt key="errors" />,
 	[ProductType.Metrics]: <IconSolidChartBar key="metrics" />,
 	[ProductType.Events]: <IconSolidFire key="events" />,
+	[ProductType.IngestDrops]: <IconSolidFilter key="ingest-drops" />,
 }
 
 export const NUMERIC_FUNCTION_TYPES: MetricAggregator[] = [
@@ -76,6 +83,8 @@ export const NUMERIC_FUNCTION_TYPES: MetricAggregator[] = [
 	MetricAggregator.P99,
 	MetricAggregator.Max,
 	MetricAggregator.Sum,
+	MetricAggregator.Rate,
+	MetricAggregator.Increase,
 ]
 
 export const FUNCTION_TYPES: MetricAggregator[] = [